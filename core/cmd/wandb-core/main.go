@@ -18,8 +18,11 @@ import (
 	"fmt"
 	"io"
 	"log/slog"
+	"maps"
 	"os"
 	"os/signal"
+	"path/filepath"
+	"slices"
 	"syscall"
 	"time"
 
@@ -255,6 +258,13 @@ type leetOptions struct {
 	symonInterval    time.Duration
 	wandbDir         string
 
+	// runGlob limits the workspace to run directories matching the pattern.
+	runGlob string
+
+	// inspectFile is a .wandb file to summarize on stdout instead of
+	// starting the TUI.
+	inspectFile string
+
 	// remoteURL is the W&B URL of the run to open
 	// (e.g. https://api.wandb.ai/<entity>/<project>/runs/<run-id>).
 	// Non-empty means we are in remote mode.
@@ -303,12 +313,24 @@ func bindLeetFlags(fs *flag.FlagSet, opts *leetOptions) {
 		"",
 		"Path to a .wandb file to open directly in single-run view.",
 	)
+	fs.StringVar(
+		&opts.runGlob,
+		"run-glob",
+		"",
+		"Only list run directories matching this glob (e.g. run-20260209_*).",
+	)
 	fs.StringVar(
 		&opts.pprofAddr,
 		"pprof",
 		"",
 		"If set, serves /debug/pprof/* on this address (e.g. 127.0.0.1:6060).",
 	)
+	fs.StringVar(
+		&opts.inspectFile,
+		"inspect",
+		"",
+		"Print a summary of the records in a .wandb file and exit.",
+	)
 	fs.BoolVar(&opts.editConfig, "config", false, "Open config editor.")
 	fs.BoolVar(&opts.symonMode, "symon", false, "Launch standalone system metrics mode.")
 	fs.DurationVar(
@@ -334,6 +356,7 @@ Usage:
   wandb-core leet [flags] <wandb-directory>
   wandb-core leet --run-file <wandb-file> <wandb-directory>
   wandb-core leet --remote-url <wandb-run-url>
+  wandb-core leet --inspect <wandb-file>
   wandb-core leet --config
   wandb-core leet --symon [flags]
 
@@ -364,6 +387,10 @@ func validateLeetOptions(fs *flag.FlagSet, opts *leetOptions) error {
 		fmt.Fprintln(os.Stderr, "Error: --interval must be > 0")
 		fs.Usage()
 		return fmt.Errorf("invalid interval %v", opts.symonInterval)
+	case opts.runGlob != "" && !validRunGlob(opts.runGlob):
+		fmt.Fprintln(os.Stderr, "Error: invalid --run-glob pattern")
+		fs.Usage()
+		return fmt.Errorf("invalid run glob %q", opts.runGlob)
 	case opts.remoteRun != nil && opts.runFile != "":
 		fmt.Fprintln(os.Stderr, "Error: --run-file cannot be used with --remote-url")
 		fs.Usage()
@@ -372,11 +399,16 @@ func validateLeetOptions(fs *flag.FlagSet, opts *leetOptions) error {
 		fmt.Fprintln(os.Stderr, "Error: --remote-url does not take a wandb directory")
 		fs.Usage()
 		return fmt.Errorf("unexpected wandb directory %q in remote mode", fs.Arg(0))
+	case opts.inspectFile != "" && (fs.NArg() != 0 || opts.remoteRun != nil):
+		fmt.Fprintln(os.Stderr, "Error: --inspect takes only a .wandb file")
+		fs.Usage()
+		return fmt.Errorf("--inspect takes only a .wandb file")
 	case opts.symonMode && fs.NArg() != 0:
 		fmt.Fprintln(os.Stderr, "Error: --symon does not take a wandb directory")
 		fs.Usage()
 		return fmt.Errorf("unexpected wandb directory %q in symon mode", fs.Arg(0))
-	case !opts.editConfig && !opts.symonMode && opts.wandbDir == "" && opts.remoteRun == nil:
+	case !opts.editConfig && !opts.symonMode && opts.inspectFile == "" &&
+		opts.wandbDir == "" && opts.remoteRun == nil:
 		fmt.Fprintln(os.Stderr, "Error: wandb directory path or --remote-url required")
 		fs.Usage()
 		return fmt.Errorf("wandb directory path or --remote-url required")
@@ -385,6 +417,11 @@ func validateLeetOptions(fs *flag.FlagSet, opts *leetOptions) error {
 	}
 }
 
+func validRunGlob(pattern string) bool {
+	_, err := filepath.Match(pattern, "")
+	return err == nil
+}
+
 func startLeetPprof(addr string) (func(context.Context) error, error) {
 	return pprof.StartServer(addr)
 }
@@ -427,6 +464,8 @@ func leetSentryMessage(opts *leetOptions) string {
 		return "wandb-leet-config"
 	case opts.symonMode:
 		return "wandb-symon"
+	case opts.inspectFile != "":
+		return "wandb-leet-inspect"
 	default:
 		return "wandb-leet"
 	}
@@ -461,6 +500,9 @@ func newLeetLogger(logLevel int) (*observability.CoreLogger, func(), error) {
 }
 
 func runLeetCommand(opts *leetOptions, logger *observability.CoreLogger) int {
+	if opts.inspectFile != "" {
+		return runLeetInspect(opts.inspectFile)
+	}
 	if opts.editConfig {
 		return runLeetConfigEditor(logger)
 	}
@@ -480,6 +522,25 @@ func runLeetConfigEditor(logger *observability.CoreLogger) int {
 	return exitCodeSuccess
 }
 
+// runLeetInspect prints the record counts of a .wandb file, followed by
+// any corruption found while reading it.
+func runLeetInspect(path string) int {
+	report, err := leet.InspectWandbFile(path)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "Error:", err)
+		return exitCodeErrorInternal
+	}
+
+	fmt.Printf("%s: %d bytes, %d records\n", report.Path, report.Bytes, report.Total)
+	for _, name := range slices.Sorted(maps.Keys(report.Records)) {
+		fmt.Printf("  %-16s %d\n", name, report.Records[name])
+	}
+	for _, warning := range report.Warnings {
+		fmt.Println("warning:", warning)
+	}
+	return exitCodeSuccess
+}
+
 func runSymon(opts *leetOptions, logger *observability.CoreLogger) int {
 	for {
 		m := leet.NewSymon(leet.SymonParams{
@@ -513,6 +574,7 @@ func runLeetWorkspace(opts *leetOptions, logger *observability.CoreLogger) int {
 	for {
 		m := leet.NewModel(leet.ModelParams{
 			WandbDir:  opts.wandbDir,
+			RunGlob:   opts.runGlob,
 			RunParams: runParams,
 			Logger:    logger,
 		})
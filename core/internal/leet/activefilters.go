@@ -0,0 +1,59 @@
+package leet
+
+import (
+	"fmt"
+	"strings"
+)
+
+// activeFilter is one active filter listed in the status bar.
+type activeFilter struct {
+	// region names what the filter applies to, e.g. "Metrics".
+	region string
+
+	// mode is the match mode, if the filter has one.
+	mode string
+
+	// query is the filter text; empty for toggles like recent runs.
+	query string
+
+	// matches summarizes what the filter lets through, e.g. "3/10".
+	matches string
+
+	// editKey and clearKey are the keys that change and clear the filter.
+	// editKey may be empty.
+	editKey, clearKey string
+}
+
+// String renders the filter as e.g.
+// `Metrics (regex): "loss" [3/10] (/ edit, ctrl+/ clear)`.
+func (f activeFilter) String() string {
+	var b strings.Builder
+	b.WriteString(f.region)
+	if f.mode != "" {
+		fmt.Fprintf(&b, " (%s)", f.mode)
+	}
+	if f.query != "" {
+		fmt.Fprintf(&b, ": %q", f.query)
+	}
+	fmt.Fprintf(&b, " [%s] (", f.matches)
+	if f.editKey != "" {
+		b.WriteString(f.editKey + " edit, ")
+	}
+	b.WriteString(f.clearKey + " clear)")
+	return b.String()
+}
+
+// activeFiltersStatus joins active filters into a single status bar
+// fragment, so that filters across regions read as one list.
+//
+// Returns "" if no filter is active.
+func activeFiltersStatus(filters []activeFilter) string {
+	if len(filters) == 0 {
+		return ""
+	}
+	items := make([]string, len(filters))
+	for i, f := range filters {
+		items[i] = f.String()
+	}
+	return "Active filters: " + strings.Join(items, "; ")
+}
@@ -3,9 +3,52 @@ package leet
 import (
 	"math"
 	"sync"
+	"sync/atomic"
 	"time"
+
+	tea "charm.land/bubbletea/v2"
 )
 
+// animationsFrozen holds every AnimatedValue at its current value when set.
+//
+// It is a runtime switch (e.g. for screen recordings) and is global so that
+// all panes freeze together.
+var animationsFrozen atomic.Bool
+
+// animationsResumedAt is the UnixNano time of the last unfreeze.
+//
+// Animation loops stop ticking while frozen, so values that were in flight
+// restart their remaining animation from this point rather than jumping
+// to the end.
+var animationsResumedAt atomic.Int64
+
+// SetAnimationsFrozen freezes or resumes all animations.
+//
+// Frozen values keep their current size; in-flight animations resume from
+// there once unfrozen.
+func SetAnimationsFrozen(frozen bool) {
+	if !frozen && animationsFrozen.Load() {
+		animationsResumedAt.Store(time.Now().UnixNano())
+	}
+	animationsFrozen.Store(frozen)
+}
+
+// AnimationsFrozen reports whether animations are globally frozen.
+func AnimationsFrozen() bool {
+	return animationsFrozen.Load()
+}
+
+// animationFrameCmd schedules msg one AnimationFrame from now.
+//
+// It returns nil while animations are frozen so that animation loops stop
+// instead of spinning; Model restarts them on unfreeze.
+func animationFrameCmd(msg tea.Msg) tea.Cmd {
+	if AnimationsFrozen() {
+		return nil
+	}
+	return tea.Tick(AnimationFrame, func(time.Time) tea.Msg { return msg })
+}
+
 // AnimatedValue manages a scalar (width, height, etc.) that animates
 // smoothly between a collapsed state (0) and an expanded state.
 type AnimatedValue struct {
@@ -80,7 +123,14 @@ func (a *AnimatedValue) advanceLocked(now time.Time) bool {
 		a.startTime = time.Time{}
 		return true
 	}
-	if a.startTime.IsZero() {
+	if animationsFrozen.Load() {
+		// Hold the current value and restart the remaining animation
+		// from here once unfrozen.
+		a.startValue = a.current
+		a.startTime = now
+		return false
+	}
+	if a.startTime.IsZero() || a.startTime.UnixNano() < animationsResumedAt.Load() {
 		a.startValue = a.current
 		a.startTime = now
 		return a.current == a.target
@@ -139,6 +189,13 @@ func (a *AnimatedValue) SetExpanded(size int) {
 	a.startTime = now
 }
 
+// Expanded returns the desired expanded size.
+func (a *AnimatedValue) Expanded() int {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+	return a.expanded
+}
+
 // Value returns the current animated value.
 func (a *AnimatedValue) Value() int {
 	a.mu.RLock()
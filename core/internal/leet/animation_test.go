@@ -119,3 +119,55 @@ func TestAnimatedValue_SetExpanded_DuringAnimation_RebasesSmoothly(t *testing.T)
 	require.Equal(t, 80, anim.Value())
 	require.True(t, anim.IsExpanded())
 }
+
+func TestAnimatedValue_FreezeHoldsValueUntilResumed(t *testing.T) {
+	t.Cleanup(func() { leet.SetAnimationsFrozen(false) })
+
+	anim := leet.NewAnimatedValue(false, 40)
+	anim.Toggle()
+	start := time.Now()
+
+	anim.Update(start.Add(leet.AnimationDuration / 4))
+	mid := anim.Value()
+	require.Greater(t, mid, 0)
+	require.Less(t, mid, 40)
+
+	leet.SetAnimationsFrozen(true)
+	require.True(t, leet.AnimationsFrozen())
+	for i := 1; i <= 5; i++ {
+		done := anim.Update(start.Add(time.Duration(i) * leet.AnimationDuration))
+		require.False(t, done)
+		require.Equal(t, mid, anim.Value(), "frozen value must not change")
+	}
+	require.True(t, anim.IsAnimating())
+
+	// Resuming continues from the frozen value rather than jumping.
+	leet.SetAnimationsFrozen(false)
+	resumedAt := start.Add(5 * leet.AnimationDuration)
+	anim.Update(resumedAt.Add(leet.AnimationDuration / 4))
+	require.Greater(t, anim.Value(), mid)
+	require.Less(t, anim.Value(), 40)
+
+	require.True(t, anim.Update(resumedAt.Add(leet.AnimationDuration)))
+	require.Equal(t, 40, anim.Value())
+}
+
+func TestAnimatedValue_ResumeAfterIdleFreezeDoesNotJump(t *testing.T) {
+	t.Cleanup(func() { leet.SetAnimationsFrozen(false) })
+
+	anim := leet.NewAnimatedValue(false, 40)
+	anim.Toggle()
+	time.Sleep(leet.AnimationDuration / 4)
+	anim.Update(time.Now())
+	mid := anim.Value()
+	require.Less(t, mid, 40)
+
+	// The last frame lands while frozen; no ticks arrive after that.
+	leet.SetAnimationsFrozen(true)
+	anim.Update(time.Now())
+	time.Sleep(2 * leet.AnimationDuration)
+	leet.SetAnimationsFrozen(false)
+
+	require.False(t, anim.Update(time.Now()))
+	require.Equal(t, mid, anim.Value(), "resume restarts from the frozen value")
+}
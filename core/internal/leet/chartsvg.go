@@ -0,0 +1,219 @@
+package leet
+
+import (
+	"fmt"
+	"html"
+	"image/color"
+	"math"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"charm.land/lipgloss/v2"
+)
+
+// SVG export layout, in user units.
+const (
+	svgWidth      = 640
+	svgHeight     = 400
+	svgMarginX    = 64
+	svgMarginTop  = 40
+	svgMarginBot  = 40
+	svgLegendStep = 16
+)
+
+// ExportChartSVG writes the chart's visible X range to path as an SVG
+// image with one polyline per series.
+func (c *EpochLineChart) ExportChartSVG(path string) error {
+	return os.WriteFile(path, c.svg(nil), 0o644)
+}
+
+// svg renders the chart's visible X range as a standalone SVG image.
+//
+// Series are drawn in draw order, each as a polyline of its finite
+// points in view; label maps series keys to legend names, or nil to show
+// the keys.
+func (c *EpochLineChart) svg(label func(string) string) []byte {
+	xMin, xMax := c.ViewMinX(), c.ViewMaxX()
+	logY, invertY := c.IsLogY(), c.IsInvertedY()
+
+	type svgSeries struct {
+		name   string
+		color  string
+		points [][2]float64
+	}
+	var series []svgSeries
+	yMin, yMax := math.Inf(1), math.Inf(-1)
+	for _, key := range c.order {
+		s, ok := c.data[key]
+		if !ok {
+			continue
+		}
+		name := key
+		if label != nil {
+			name = label(key)
+		}
+		ss := svgSeries{name: name, color: svgColor(s.style.Load().(lipgloss.Style).GetForeground())}
+		for i, x := range s.X {
+			y := s.Y[i]
+			if x < xMin || x > xMax || !isFinite(y) || (logY && y <= 0) {
+				continue
+			}
+			if logY {
+				y = math.Log10(y)
+			}
+			if invertY {
+				y = -y
+			}
+			ss.points = append(ss.points, [2]float64{x, y})
+			yMin, yMax = min(yMin, y), max(yMax, y)
+		}
+		series = append(series, ss)
+	}
+	if !isFinite(yMin) {
+		yMin, yMax = 0, 1
+	}
+	if yMax == yMin {
+		yMin, yMax = yMin-1, yMax+1
+	}
+	if xMax <= xMin {
+		xMax = xMin + 1
+	}
+
+	plotW := float64(svgWidth - 2*svgMarginX)
+	plotH := float64(svgHeight - svgMarginTop - svgMarginBot)
+	toSVG := func(x, y float64) (float64, float64) {
+		return svgMarginX + (x-xMin)/(xMax-xMin)*plotW,
+			svgMarginTop + (1-(y-yMin)/(yMax-yMin))*plotH
+	}
+	yLabel := func(y float64) string {
+		if invertY {
+			y = -y
+		}
+		if logY {
+			y = math.Pow(10, y)
+		}
+		return formatSigFigs(y, 4)
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, `<svg xmlns="http://www.w3.org/2000/svg" width="%d" height="%d" viewBox="0 0 %d %d" font-family="sans-serif" font-size="12">`+"\n",
+		svgWidth, svgHeight, svgWidth, svgHeight)
+	fmt.Fprintf(&b, `<rect width="%d" height="%d" fill="white"/>`+"\n", svgWidth, svgHeight)
+	fmt.Fprintf(&b, `<text x="%d" y="%d" font-size="14" font-weight="bold">%s</text>`+"\n",
+		svgMarginX, svgMarginTop/2+4, html.EscapeString(c.Title()))
+	fmt.Fprintf(&b, `<rect x="%d" y="%d" width="%g" height="%g" fill="none" stroke="#888888"/>`+"\n",
+		svgMarginX, svgMarginTop, plotW, plotH)
+
+	// Axis extremes.
+	bottom := svgMarginTop + plotH
+	fmt.Fprintf(&b, `<text x="%d" y="%g" text-anchor="end">%s</text>`+"\n",
+		svgMarginX-4, float64(svgMarginTop)+4, yLabel(yMax))
+	fmt.Fprintf(&b, `<text x="%d" y="%g" text-anchor="end">%s</text>`+"\n",
+		svgMarginX-4, bottom, yLabel(yMin))
+	fmt.Fprintf(&b, `<text x="%d" y="%g">%s</text>`+"\n",
+		svgMarginX, bottom+16, formatXValue(xMin))
+	fmt.Fprintf(&b, `<text x="%g" y="%g" text-anchor="end">%s</text>`+"\n",
+		svgMarginX+plotW, bottom+16, formatXValue(xMax))
+	fmt.Fprintf(&b, `<text x="%g" y="%g" text-anchor="middle">%s</text>`+"\n",
+		svgMarginX+plotW/2, bottom+16, html.EscapeString(c.xLabel))
+
+	for i, s := range series {
+		pts := make([]string, len(s.points))
+		for j, p := range s.points {
+			x, y := toSVG(p[0], p[1])
+			pts[j] = fmt.Sprintf("%.2f,%.2f", x, y)
+		}
+		fmt.Fprintf(&b, `<polyline fill="none" stroke="%s" stroke-width="1.5" points="%s"/>`+"\n",
+			s.color, strings.Join(pts, " "))
+		fmt.Fprintf(&b, `<text x="%g" y="%d" text-anchor="end" fill="%s">%s</text>`+"\n",
+			svgMarginX+plotW-4, svgMarginTop+svgLegendStep*(i+1), s.color, html.EscapeString(s.name))
+	}
+	b.WriteString("</svg>\n")
+	return []byte(b.String())
+}
+
+// svgColor formats a color as an SVG hex color, defaulting to black.
+func svgColor(c color.Color) string {
+	if c == nil {
+		return "#000000"
+	}
+	r, g, bl, _ := c.RGBA()
+	return fmt.Sprintf("#%02x%02x%02x", r>>8, g>>8, bl>>8)
+}
+
+// exportedChart is a chart rendered for export.
+type exportedChart struct {
+	title string
+	data  []byte
+}
+
+// visibleChartsSVG renders the focused chart, or else every chart on the
+// current page, as SVG images.
+func (mg *MetricsGrid) visibleChartsSVG() []exportedChart {
+	mg.mu.RLock()
+	defer mg.mu.RUnlock()
+
+	var charts []*EpochLineChart
+	if mg.focus.Type == FocusMainChart {
+		if ch := mg.focusedChartLocked(); ch != nil {
+			charts = append(charts, ch)
+		}
+	}
+	if len(charts) == 0 {
+		for _, row := range mg.currentPage {
+			for _, ch := range row {
+				if ch != nil {
+					charts = append(charts, ch)
+				}
+			}
+		}
+	}
+
+	out := make([]exportedChart, 0, len(charts))
+	for _, ch := range charts {
+		out = append(out, exportedChart{title: ch.Title(), data: ch.svg(mg.seriesLabelForKey)})
+	}
+	return out
+}
+
+// svgFileBase returns a file name base for a chart title.
+func svgFileBase(title string) string {
+	return "chart-" + strings.Map(func(r rune) rune {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9',
+			r == '-', r == '_', r == '.':
+			return r
+		default:
+			return '_'
+		}
+	}, title)
+}
+
+// exportChartsSVG writes the focused chart, or else every chart on the
+// current page, to new SVG files in the workspace's wandb directory.
+func (w *Workspace) exportChartsSVG() {
+	charts := w.metricsGrid.visibleChartsSVG()
+	if len(charts) == 0 {
+		w.setRunNotice("Export failed: no charts to export")
+		return
+	}
+
+	var last string
+	for _, ch := range charts {
+		path, err := writeNewFile(w.wandbDir, svgFileBase(ch.title), "svg", ch.data)
+		if err != nil {
+			w.setRunNotice("Export failed: %v", err)
+			return
+		}
+		last = path
+	}
+	if abs, err := filepath.Abs(last); err == nil {
+		last = abs
+	}
+	if len(charts) == 1 {
+		w.setRunNotice("Wrote chart to %s", last)
+		return
+	}
+	w.setRunNotice("Wrote %d charts to %s", len(charts), filepath.Dir(last))
+}
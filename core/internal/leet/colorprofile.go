@@ -0,0 +1,82 @@
+package leet
+
+import (
+	"os"
+	"sync/atomic"
+
+	"charm.land/lipgloss/v2"
+	"github.com/charmbracelet/colorprofile"
+)
+
+// paletteProfile is the terminal color profile that palettes are downgraded
+// to. Profiles with full color support (and Unknown, the zero value) leave
+// palettes untouched.
+var paletteProfile atomic.Uint32
+
+// SetColorProfile sets the color profile that palettes are downgraded to.
+func SetColorProfile(p colorprofile.Profile) { paletteProfile.Store(uint32(p)) }
+
+// ColorProfile returns the color profile that palettes are downgraded to.
+func ColorProfile() colorprofile.Profile { return colorprofile.Profile(paletteProfile.Load()) }
+
+// applyColorProfile resolves the configured color profile and sets it.
+func applyColorProfile(cfg *ConfigManager) {
+	SetColorProfile(resolveColorProfile(cfg.ColorProfile()))
+}
+
+// resolveColorProfile maps a ColorProfile setting to a profile, detecting
+// it from stdout and the environment for ColorProfileAuto.
+func resolveColorProfile(setting string) colorprofile.Profile {
+	switch setting {
+	case ColorProfileTrueColor:
+		return colorprofile.TrueColor
+	case ColorProfileANSI256:
+		return colorprofile.ANSI256
+	case ColorProfileANSI:
+		return colorprofile.ANSI
+	default:
+		return colorprofile.Detect(os.Stdout, os.Environ())
+	}
+}
+
+// ansiGraphColors replaces categorical palettes on 16-color terminals,
+// where converting each color would map neighbors to the same ANSI color.
+//
+// Bright variants lead on dark backgrounds and normal ones on light
+// backgrounds, where bright yellow and cyan are hard to read.
+var ansiGraphColors = []AdaptiveColor{
+	{Light: lipgloss.Color("4"), Dark: lipgloss.Color("12")},
+	{Light: lipgloss.Color("1"), Dark: lipgloss.Color("9")},
+	{Light: lipgloss.Color("2"), Dark: lipgloss.Color("10")},
+	{Light: lipgloss.Color("5"), Dark: lipgloss.Color("13")},
+	{Light: lipgloss.Color("6"), Dark: lipgloss.Color("14")},
+	{Light: lipgloss.Color("3"), Dark: lipgloss.Color("11")},
+	{Light: lipgloss.Color("12"), Dark: lipgloss.Color("4")},
+	{Light: lipgloss.Color("9"), Dark: lipgloss.Color("1")},
+	{Light: lipgloss.Color("10"), Dark: lipgloss.Color("2")},
+	{Light: lipgloss.Color("13"), Dark: lipgloss.Color("5")},
+	{Light: lipgloss.Color("14"), Dark: lipgloss.Color("6")},
+	{Light: lipgloss.Color("11"), Dark: lipgloss.Color("3")},
+}
+
+// categoricalPalette downgrades a palette whose colors tell series apart.
+func categoricalPalette(colors []AdaptiveColor) []AdaptiveColor {
+	if ColorProfile() == colorprofile.ANSI {
+		return ansiGraphColors
+	}
+	return convertPalette(colors)
+}
+
+// convertPalette maps each color of a palette to the nearest color
+// supported by the palette profile.
+func convertPalette(colors []AdaptiveColor) []AdaptiveColor {
+	p := ColorProfile()
+	if p != colorprofile.ANSI && p != colorprofile.ANSI256 {
+		return colors
+	}
+	out := make([]AdaptiveColor, len(colors))
+	for i, c := range colors {
+		out[i] = AdaptiveColor{Light: p.Convert(c.Light), Dark: p.Convert(c.Dark)}
+	}
+	return out
+}
@@ -0,0 +1,57 @@
+package leet_test
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/charmbracelet/colorprofile"
+	"github.com/stretchr/testify/require"
+
+	"github.com/wandb/wandb/core/internal/leet"
+	"github.com/wandb/wandb/core/internal/observability"
+)
+
+func TestColorProfile_ForcedLimitedProfileDowngradesPalettes(t *testing.T) {
+	t.Cleanup(func() { leet.SetColorProfile(colorprofile.Unknown) })
+
+	logger := observability.NewNoOpLogger()
+	cfg := leet.NewConfigManager(filepath.Join(t.TempDir(), "config.json"), logger)
+	require.Error(t, cfg.SetColorProfile("8bit"))
+
+	require.NoError(t, cfg.SetColorProfile(leet.ColorProfileTrueColor))
+	leet.NewModel(leet.ModelParams{Config: cfg, Logger: logger})
+	full := leet.GraphColors(leet.DefaultColorScheme)
+	fullHeatmap := leet.FrenchFriesColors(leet.DefaultFrenchFriesColorScheme)
+
+	// 256 colors: each color maps to its nearest indexed color.
+	require.NoError(t, cfg.SetColorProfile(leet.ColorProfileANSI256))
+	leet.NewModel(leet.ModelParams{Config: cfg, Logger: logger})
+	require.Equal(t, colorprofile.ANSI256, leet.ColorProfile())
+
+	palette := leet.GraphColors(leet.DefaultColorScheme)
+	require.Len(t, palette, len(full))
+	for i, c := range palette {
+		require.Equal(t, colorprofile.ANSI256.Convert(full[i].Dark), c.Dark)
+		require.Equal(t, colorprofile.ANSI256.Convert(full[i].Light), c.Light)
+		require.NotEqual(t, full[i].Dark, c.Dark)
+	}
+
+	// 16 colors: run colors switch to distinct ANSI colors, while gradients
+	// keep their order and are converted color by color.
+	require.NoError(t, cfg.SetColorProfile(leet.ColorProfileANSI))
+	leet.NewModel(leet.ModelParams{Config: cfg, Logger: logger})
+
+	palette = leet.GraphColors(leet.DefaultColorScheme)
+	seen := make(map[any]bool)
+	for _, c := range palette {
+		require.Equal(t, colorprofile.ANSI.Convert(c.Dark), c.Dark)
+		require.False(t, seen[c.Dark], "16-color run palette must not repeat colors")
+		seen[c.Dark] = true
+	}
+
+	heatmap := leet.FrenchFriesColors(leet.DefaultFrenchFriesColorScheme)
+	require.Len(t, heatmap, len(fullHeatmap))
+	for i, c := range heatmap {
+		require.Equal(t, colorprofile.ANSI.Convert(fullHeatmap[i].Dark), c.Dark)
+	}
+}
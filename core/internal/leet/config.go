@@ -4,8 +4,12 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"maps"
 	"os"
+	"path"
 	"path/filepath"
+	"slices"
+	"strconv"
 	"strings"
 	"sync"
 	"time"
@@ -53,21 +57,101 @@ const (
 	DefaultSystemColorMode        = ColorModePerSeries
 	DefaultSystemTailWindowMins   = 10
 
+	// DefaultSystemMetricsMaxPoints keeps about 40 hours of samples at
+	// the default 15 second stats interval.
+	DefaultSystemMetricsMaxPoints = 10_000
+
 	DefaultHeartbeatInterval = 15 // seconds
 
+	DefaultLiveRedrawSeconds = 1
+	maxLiveRedrawSeconds     = 60
+
+	DefaultRecentRunsLimit = 10
+
+	DefaultSelectionUndoSeconds = 30
+	maxSelectionUndoSeconds     = 600
+
+	maxIdleDimSeconds = 3600
+
+	DefaultOverviewValuePrecision = 6
+	DefaultOverviewSciExponent    = 5
+	maxOverviewValuePrecision     = 17 // enough to round-trip any float64
+	maxOverviewSciExponent        = 20
+
 	DefaultMediaGridRows          = 1
 	DefaultMediaGridCols          = 2
 	DefaultWorkspaceMediaGridRows = 1
 	DefaultWorkspaceMediaGridCols = 2
 
+	// Metrics sort orders control how main metrics charts are arranged.
+	MetricsSortAlphabetical = "alphabetical" // Sort charts by (aliased) title
+	MetricsSortLogged       = "logged"       // Keep charts in the order metrics were first logged
+	DefaultMetricsSortOrder = MetricsSortAlphabetical
+
+	DefaultMinMaxBandWindow = 20
+
+	// Default suffixes of the companion keys that log a metric's band,
+	// as in "loss_min" and "loss_max" next to "loss".
+	DefaultLoggedBandMinSuffix = "_min"
+	DefaultLoggedBandMaxSuffix = "_max"
+
+	// DefaultSampledRenderingThreshold is the total point count across a
+	// chart's series above which it is drawn from sampled data.
+	DefaultSampledRenderingThreshold = 50_000
+
+	// DefaultMaxMetricCharts is the number of distinct metrics charted
+	// before further metrics are held back until a filter selects them.
+	DefaultMaxMetricCharts = 2000
+
+	// DefaultStaleRunColor is the color of stale runs in the run list
+	// and of their state in the run overview.
+	DefaultStaleRunColor = "#D79921"
+
+	// DefaultConsoleLogSampleThreshold is the console output rate, in lines
+	// per second, above which intermediate lines are dropped.
+	//
+	// Sampling drops output, so it is off unless configured.
+	DefaultConsoleLogSampleThreshold = 0
+
+	// Color profiles control how palettes adapt to terminal color support.
+	ColorProfileAuto      = "auto"      // Detect from the terminal and environment
+	ColorProfileTrueColor = "truecolor" // 24-bit color
+	ColorProfileANSI256   = "ansi256"   // 256 colors
+	ColorProfileANSI      = "ansi"      // 16 colors
+	DefaultColorProfile   = ColorProfileAuto
+
+	// X axis modes control what main metrics charts are plotted against.
+	XAxisStep        = "step"  // Plot against _step
+	XAxisEpoch       = "epoch" // Plot against the run's logged "epoch" metric
+	DefaultXAxisMode = XAxisStep
+
 	// Startup modes control what LEET does when launched without a specified run path
 	// (i.e. `wandb leet` with no PATH).
 	StartupModeWorkspaceLatest = "workspace_latest"  // Load workspace view and select latest run
 	StartupModeSingleRunLatest = "single_run_latest" // Load latest run in the single-run view
 	DefaultStartupMode         = StartupModeWorkspaceLatest
+
+	// Run list Enter actions control what Enter does on the workspace runs list.
+	RunListEnterView    = "view"   // Open the highlighted run in the single-run view
+	RunListEnterSelect  = "select" // Select/deselect the highlighted run, like space
+	DefaultRunListEnter = RunListEnterView
+
+	// Console logs starts control where a newly shown run's console logs open.
+	ConsoleLogsStartTail    = "tail" // Open at the newest line and follow new output
+	ConsoleLogsStartTop     = "top"  // Open at the first line
+	DefaultConsoleLogsStart = ConsoleLogsStartTail
+
+	// Run list orders control how the workspace runs list is sorted.
+	RunListOrderRecent    = "recent"     // Newest run first
+	RunListOrderName      = "name"       // Alphabetical by run key
+	RunListOrderLiveFirst = "live_first" // Running runs first, then newest first
+	DefaultRunListOrder   = RunListOrderRecent
 )
 
 // Config stores the application configuration.
+//
+// Fields without a leet tag, or tagged leet:"-", have no UI in the config
+// editor and are set by editing the config file.
 type Config struct {
 	// StartupMode controls what happens when LEET is launched without --run-file.
 	//  - workspace_latest: open workspace and auto-select the latest run
@@ -94,6 +178,10 @@ type Config struct {
 	// ColorScheme is the color scheme to display the main metrics.
 	ColorScheme string `json:"color_scheme" leet:"desc=Palette for main run metrics charts (and run list colors).,options=colorSchemes"`
 
+	// ColorProfile overrides the detected terminal color support:
+	// "auto", "truecolor", "ansi256" or "ansi".
+	ColorProfile string `json:"color_profile" leet:"label=Color profile,desc=Terminal color support. Force ansi256 or ansi if palettes render poorly.,options=colorProfiles"`
+
 	// TagColorScheme is the color scheme for run tag badges in the overview sidebar.
 	TagColorScheme string `json:"tag_color_scheme" leet:"label=Tag color scheme,desc=Palette for run tags in the overview sidebar.,options=colorSchemes"`
 
@@ -117,6 +205,10 @@ type Config struct {
 	// Users can still zoom out to show the full history.
 	SystemTailWindowMinutes int `json:"system_tail_window_minutes" leet:"label=System tail window (min),desc=Default live tail window for system charts. Zooming out can show full history.,min=1"`
 
+	// SystemMetricsMaxPoints caps the points kept per system metric
+	// series; the oldest are dropped first. 0 keeps every point.
+	SystemMetricsMaxPoints int `json:"system_metrics_max_points" leet:"label=System metrics points,desc=Most points kept per system metric series. Older points are dropped. 0 keeps all.,min=0"`
+
 	// SingleRunColorMode controls how charts are colored in single-run view:
 	//  - per_series: stably-mapped run-id color for all charts
 	//  - per_plot: each chart gets the next color from the palette (nice with gradients)
@@ -128,6 +220,13 @@ type Config struct {
 	// events have been seen for a long time for a live file.
 	HeartbeatInterval int `json:"heartbeat_interval_seconds" leet:"label=Heartbeat interval (sec),desc=Polling heartbeat for live runs.,min=1"`
 
+	// LiveRedrawSeconds is the longest the workspace goes without redrawing
+	// while a selected run is live, so time-based status such as
+	// "updated 5s ago" stays fresh between record batches.
+	//
+	// 0 redraws only on heartbeats and new records.
+	LiveRedrawSeconds int `json:"live_redraw_seconds" leet:"label=Live redraw interval (sec),desc=Longest time between redraws while a run is live. 0 redraws only on heartbeats and new records.,min=0,max=60"`
+
 	// Single-run view sidebar visibility states.
 	LeftSidebarVisible  bool `json:"left_sidebar_visible"  leet:"desc=Show left sidebar in single run view by default."`
 	RightSidebarVisible bool `json:"right_sidebar_visible" leet:"desc=Show right sidebar in single run view by default."`
@@ -141,6 +240,246 @@ type Config struct {
 	WorkspaceSystemMetricsVisible bool `json:"workspace_system_metrics_visible" leet:"desc=Show system metrics pane in workspace mode by default."`
 	WorkspaceConsoleLogsVisible   bool `json:"workspace_console_logs_visible"   leet:"desc=Show console logs pane in workspace mode by default."`
 	WorkspaceMediaVisible         bool `json:"workspace_media_visible"          leet:"desc=Show media pane in workspace mode by default."`
+
+	// WorkspaceOverviewAutoExpand expands the collapsed overview sidebar
+	// when a run is selected, until the sidebar is collapsed by hand.
+	WorkspaceOverviewAutoExpand bool `json:"workspace_overview_auto_expand" leet:"label=Auto-expand overview,desc=Expand the collapsed run overview sidebar when a run is selected until you collapse it yourself."`
+
+	// ConsoleLogTimestamps shows the timestamp column in the console logs
+	// pane; hiding it gives the log text the full width.
+	ConsoleLogTimestamps bool `json:"console_log_timestamps" leet:"label=Console log timestamps,desc=Show the timestamp column in the console logs pane."`
+
+	// ConsoleLogCommand shows the run's launch command in the console
+	// logs pane header when the run's metadata includes it.
+	ConsoleLogCommand bool `json:"console_log_command" leet:"label=Console log command,desc=Show the run's launch command in the console logs header."`
+
+	// RawXAxisSteps shows exact step numbers on metrics chart X axes
+	// instead of abbreviating large values (1.2M, 450k).
+	RawXAxisSteps bool `json:"raw_x_axis_steps" leet:"label=Raw x-axis steps,desc=Show exact step numbers on chart x-axes instead of abbreviations like 1.2M."`
+
+	// WarnMixedProjects shows a status bar warning when the runs selected
+	// in the workspace belong to more than one project.
+	WarnMixedProjects bool `json:"warn_mixed_projects" leet:"label=Warn on mixed projects,desc=Warn in the status bar when selected runs span multiple projects."`
+
+	// SweepProgress shows in the workspace status bar how many of the
+	// listed runs have finished or failed, e.g. while a sweep runs.
+	SweepProgress bool `json:"sweep_progress" leet:"label=Sweep progress,desc=Show in the workspace status bar how many listed runs are done."`
+
+	// ShowChartLegend renders a compact color-to-run legend under metrics
+	// charts that overlay more than one run.
+	ShowChartLegend bool `json:"show_chart_legend" leet:"label=Chart legend,desc=Show which color is which run under overlaid workspace charts."`
+
+	// ShowChartFooter renders the last, min and max value of each metrics
+	// chart on a line under it.
+	ShowChartFooter bool `json:"show_chart_footer" leet:"label=Chart stats footer,desc=Show the last, min and max value under each metrics chart."`
+
+	// SharedXAxis labels the x-axis only under the bottom chart of each
+	// metrics grid column, giving the rows above the label row back.
+	SharedXAxis bool `json:"shared_x_axis" leet:"label=Shared x-axis,desc=Label the x-axis only under the bottom chart of each grid column."`
+
+	// HideEmptyOverviewSections leaves run overview sections without any
+	// items, such as the summary of a run that has not logged one, out of
+	// the sidebar. When false, they show as a header line.
+	HideEmptyOverviewSections bool `json:"hide_empty_overview_sections" leet:"label=Hide empty overview sections,desc=Leave overview sections with no items out of the sidebar."`
+
+	// ShowPointMarkers marks each logged sample on metrics lines with a
+	// dot, to check how often a metric is logged.
+	ShowPointMarkers bool `json:"show_point_markers" leet:"label=Point markers,desc=Mark each logged step on metrics lines with a dot."`
+
+	// IdleDimSeconds is how long without a key press or mouse event
+	// before charts other than the focused one are dimmed; 0 disables it.
+	IdleDimSeconds int `json:"idle_dim_seconds" leet:"label=Dim idle charts,desc=Seconds without input after which charts other than the focused one are dimmed. 0 disables dimming.,min=0,max=3600"`
+
+	// ShowMinMaxBand shades a rolling min/max band behind metrics lines.
+	ShowMinMaxBand bool `json:"show_min_max_band" leet:"label=Min/max band,desc=Shade the rolling min and max of each series behind its line."`
+
+	// MinMaxBandWindow is the number of samples in the rolling min/max band.
+	MinMaxBandWindow int `json:"min_max_band_window" leet:"label=Min/max band window,desc=Number of trailing samples covered by the min/max band.,min=2"`
+
+	// ShowLoggedBands shades the band a run logs around a metric as
+	// companion keys (e.g. "loss_min" and "loss_max" next to "loss")
+	// instead of charting the companions separately.
+	ShowLoggedBands bool `json:"show_logged_bands" leet:"label=Logged bands,desc=Shade companion min/max keys (loss_min and loss_max) as a band around their metric."`
+
+	// LoggedBandMinSuffix and LoggedBandMaxSuffix name the companion keys
+	// of a logged band.
+	LoggedBandMinSuffix string `json:"logged_band_min_suffix"`
+	LoggedBandMaxSuffix string `json:"logged_band_max_suffix"`
+
+	// SampledRenderingThreshold is the total number of points across a
+	// chart's series above which it draws a sampled view of its data.
+	// 0 disables sampling.
+	SampledRenderingThreshold int `json:"sampled_rendering_threshold" leet:"label=Sampled rendering threshold,desc=Points across a chart's series above which it draws sampled data to stay responsive. 0 disables."`
+
+	// MaxMetricCharts is the number of distinct metrics charted in a
+	// metrics grid. Further metrics are kept but only charted once a
+	// filter narrows them down to at most this many. 0 disables the cap.
+	MaxMetricCharts int `json:"max_metric_charts" leet:"label=Max metric charts,desc=Distinct metrics charted before the rest wait for a filter to select them. 0 disables."`
+
+	// ConsoleLogSampleThreshold is the number of console log lines per
+	// second above which further lines in that second are coalesced into
+	// the newest one. 0 disables sampling.
+	ConsoleLogSampleThreshold int `json:"console_log_sample_threshold" leet:"label=Console log sampling threshold,desc=Lines per second above which console logs keep only the newest line. 0 disables."`
+
+	// ConsoleLogsStart is "tail" or "top": whether a newly shown run's
+	// console logs open at the newest line, following new output, or at
+	// the first line.
+	ConsoleLogsStart string `json:"console_logs_start" leet:"label=Console logs start,desc=Open a newly shown run's console logs at the newest line (tail) or the first line (top).,options=consoleLogsStarts"`
+
+	// ConsoleLogsHeight is the expanded console logs pane height in rows,
+	// set with +/- while the pane is focused. 0 sizes the pane to the
+	// window.
+	ConsoleLogsHeight int `json:"console_logs_height" leet:"label=Console logs height,desc=Expanded console logs height in rows (+/- while focused). 0 sizes it to the window.,min=0"`
+
+	// MetricsSortOrder controls the order of main metrics charts:
+	// "alphabetical" or "logged".
+	MetricsSortOrder string `json:"metrics_sort_order" leet:"label=Metrics order,desc=Arrange metrics charts alphabetically or in the order they were first logged.,options=metricsSortOrders"`
+
+	// XAxisMode is "step" or "epoch". In epoch mode, runs that log an
+	// "epoch" metric are aligned by it; other runs keep their steps until
+	// they log one. Changing it re-keys charts that are already loaded.
+	XAxisMode string `json:"x_axis_mode" leet:"label=Metrics x-axis,desc=Plot metrics against _step or against a logged epoch metric so runs with different logging frequencies line up.,options=xAxisModes"`
+
+	// XAxisKey, when set, names a logged history key (e.g. "global_step")
+	// that metrics are plotted against instead of _step, taking precedence
+	// over XAxisMode. Runs keep their steps until they log the key.
+	XAxisKey string `json:"x_axis_key,omitempty"`
+
+	// RecentRunsLimit is how many of the most recently started runs the
+	// workspace runs list keeps when the recent-runs toggle is on.
+	RecentRunsLimit int `json:"recent_runs_limit" leet:"label=Recent runs limit,desc=Number of newest runs listed when the recent-runs toggle (F) is on.,min=1"`
+
+	// SelectionUndoSeconds is how long after a bulk deselect in the
+	// workspace the previous selection can be restored. 0 disables undo.
+	SelectionUndoSeconds int `json:"selection_undo_seconds" leet:"label=Selection undo window,desc=Seconds after a bulk deselect (X or D) during which u restores the previous selection. 0 disables undo.,min=0,max=600"`
+
+	// RunListEnter is "view" or "select": whether Enter on the workspace
+	// runs list opens the run or toggles its selection. alt+enter always
+	// opens the run.
+	RunListEnter string `json:"run_list_enter" leet:"label=Run list Enter,desc=Open the highlighted run (view) or select it (select). alt+enter always opens it.,options=runListEnterActions"`
+
+	// ObjectiveMetric is the (aliased) metric whose best point is marked
+	// on its chart. Set from the UI by pressing b on a focused chart.
+	ObjectiveMetric string `json:"objective_metric"`
+
+	// ObjectiveDirection is "min" or "max" for ObjectiveMetric. Set
+	// together with the metric by pressing b on a focused chart.
+	ObjectiveDirection string `json:"objective_direction" leet:"-"`
+
+	// HeroMetric is the (aliased) metric whose chart stays in the first
+	// cell of every metrics grid page. Set from the UI by pressing * on a
+	// focused chart.
+	HeroMetric string `json:"hero_metric"`
+
+	// PerfOverlay shows frame render time and Update latency in the
+	// top-right corner. Can also be enabled with WANDB_LEET_PERF_OVERLAY.
+	PerfOverlay bool `json:"perf_overlay" leet:"label=Performance overlay,desc=Show frame render time and input handling latency for diagnosing sluggishness."`
+
+	// CompactRunKeys shows runs in the workspace run list by display name
+	// (or run ID) instead of the full timestamped directory name.
+	CompactRunKeys bool `json:"compact_run_keys" leet:"label=Compact run names,desc=Show display names or run IDs instead of full run directory names in the runs list."`
+
+	// DisambiguateRunNames appends the run ID to runs list labels shared
+	// by more than one listed run, such as repeated display names.
+	DisambiguateRunNames bool `json:"disambiguate_run_names" leet:"label=Disambiguate run names,desc=Append the run ID to runs list names shared by more than one run."`
+
+	// RunListOrder is "recent", "name" or "live_first": whether the
+	// workspace runs list is sorted newest first, alphabetically by run
+	// key, or with running runs grouped on top.
+	RunListOrder string `json:"run_list_order" leet:"label=Run list order,desc=Sort the runs list newest first (recent) / by run key (name) / with running runs on top (live_first). Cycle with alt+s.,options=runListOrders"`
+
+	// RunListTemplate, when set, formats each run in the workspace run
+	// list, e.g. "{id} — {summary.loss}". It takes precedence over
+	// CompactRunKeys. Placeholders are key, id, name, project, entity,
+	// state, summary.<key> and config.<key>; missing values render blank.
+	RunListTemplate string `json:"run_list_template,omitempty"`
+
+	// RunListMetric, when set, is the summary metric whose value the
+	// workspace runs list shows next to each run. It is cycled with a key
+	// rather than edited in the config editor.
+	RunListMetric string `json:"run_list_metric,omitempty"`
+
+	// StaleRunColor is the color, as "#rrggbb" or an ANSI color number,
+	// of runs that are still running but have stopped writing records.
+	StaleRunColor string `json:"stale_run_color"`
+
+	// LiveUpdateSummary briefly shows in the workspace status bar which
+	// records arrived after each live read (e.g. "+12 history, +3 logs").
+	LiveUpdateSummary bool `json:"live_update_summary" leet:"label=Live update summary,desc=Briefly show what arrived after each live file change (e.g. +12 history)."`
+
+	// OverviewValuePrecision is the number of significant digits used for
+	// floating-point config and summary values in the run overview.
+	OverviewValuePrecision int `json:"overview_value_precision" leet:"label=Overview value precision,desc=Significant digits for float values in the run overview.,min=1,max=17"`
+
+	// OverviewSciExponent switches overview floats to scientific notation
+	// once the magnitude of their decimal exponent reaches this value.
+	OverviewSciExponent int `json:"overview_sci_exponent" leet:"label=Overview sci-notation exponent,desc=Use scientific notation for overview floats at or beyond 10^±N (5: 1e-05).,min=1,max=20"`
+
+	// MetricAliases maps a metric key to the canonical key it is charted under.
+	//
+	// Useful when a metric was renamed mid-project (e.g. "acc" -> "accuracy"):
+	// runs logging either key then overlay on a single chart.
+	MetricAliases map[string]string `json:"metric_aliases,omitempty" leet:"-"`
+
+	// MetricBlocklist lists glob patterns (path.Match syntax, where "*"
+	// does not cross "/") of metric keys that are never charted.
+	//
+	// A pattern matches either the logged key or its MetricAliases
+	// canonical name.
+	MetricBlocklist []string `json:"metric_blocklist,omitempty" leet:"-"`
+
+	// MetricUnits maps a metric name to the unit of its logged values
+	// ("bytes", "KiB", "MiB" or "GiB"), overriding the unit detected
+	// from the name itself.
+	//
+	// Names are matched after MetricAliases are applied, so an override
+	// for a renamed metric is keyed by its canonical name.
+	MetricUnits map[string]string `json:"metric_units,omitempty" leet:"-"`
+
+	// MetricTargets maps a metric name to a goal value, drawn as a
+	// horizontal target line on the metric's chart.
+	//
+	// Names are matched after MetricAliases are applied.
+	MetricTargets map[string]float64 `json:"metric_targets,omitempty" leet:"-"`
+
+	// InvertedMetrics lists the metrics whose charts draw the Y axis
+	// upside down, so lower-is-better values trend upward.
+	//
+	// Names are matched after MetricAliases are applied. Toggle a chart
+	// with U.
+	InvertedMetrics []string `json:"inverted_metrics,omitempty" leet:"-"`
+
+	// SecondaryAxes maps a metric name to another metric overlaid on a
+	// right-hand Y axis of its chart, with its own scale.
+	//
+	// Names are matched after MetricAliases are applied. Cycle a chart's
+	// overlay with A.
+	SecondaryAxes map[string]string `json:"secondary_axes,omitempty" leet:"-"`
+
+	// SummaryStripKeys lists the metric keys whose latest values the
+	// workspace shows in a strip above the charts, for the pinned run.
+	SummaryStripKeys []string `json:"summary_strip_keys,omitempty" leet:"-"`
+
+	// MetricsFilterPresets lists saved metrics filter queries, in the
+	// order N cycles through them. Each preset is named by its query.
+	//
+	// Save the applied filter with W.
+	MetricsFilterPresets []string `json:"metrics_filter_presets,omitempty" leet:"-"`
+
+	// CollapsedOverviewSections records which run overview sections
+	// (by title, e.g. "Config") are collapsed to their header line.
+	CollapsedOverviewSections map[string]bool `json:"collapsed_overview_sections,omitempty" leet:"-"`
+
+	// WorkspaceSelections maps an absolute wandb directory path to the
+	// runs that were selected in its workspace when LEET last quit, so
+	// that the comparison survives a restart.
+	WorkspaceSelections map[string]WorkspaceSelection `json:"workspace_selections,omitempty" leet:"-"`
+}
+
+// WorkspaceSelection is a workspace's saved run selection.
+type WorkspaceSelection struct {
+	Runs   []string `json:"runs"`
+	Pinned string   `json:"pinned,omitempty"`
 }
 
 // GridConfig represents grid dimensions.
@@ -195,7 +534,21 @@ func NewConfigManager(path string, logger *observability.CoreLogger) *ConfigMana
 				Cols: DefaultSymonGridCols,
 			},
 			StartupMode:                   DefaultStartupMode,
+			RunListEnter:                  DefaultRunListEnter,
+			ConsoleLogsStart:              DefaultConsoleLogsStart,
+			RunListOrder:                  DefaultRunListOrder,
+			MetricsSortOrder:              DefaultMetricsSortOrder,
+			XAxisMode:                     DefaultXAxisMode,
+			MinMaxBandWindow:              DefaultMinMaxBandWindow,
+			LoggedBandMinSuffix:           DefaultLoggedBandMinSuffix,
+			LoggedBandMaxSuffix:           DefaultLoggedBandMaxSuffix,
+			SampledRenderingThreshold:     DefaultSampledRenderingThreshold,
+			MaxMetricCharts:               DefaultMaxMetricCharts,
+			StaleRunColor:                 DefaultStaleRunColor,
+			ConsoleLogSampleThreshold:     DefaultConsoleLogSampleThreshold,
+			ObjectiveDirection:            ObjectiveMinimize,
 			ColorScheme:                   DefaultColorScheme,
+			ColorProfile:                  DefaultColorProfile,
 			PerPlotColorScheme:            DefaultPerPlotColorScheme,
 			TagColorScheme:                DefaultTagColorScheme,
 			SingleRunColorMode:            DefaultSingleRunColorMode,
@@ -203,7 +556,11 @@ func NewConfigManager(path string, logger *observability.CoreLogger) *ConfigMana
 			FrenchFriesColorScheme:        DefaultFrenchFriesColorScheme,
 			SystemColorMode:               DefaultSystemColorMode,
 			SystemTailWindowMinutes:       DefaultSystemTailWindowMins,
+			SystemMetricsMaxPoints:        DefaultSystemMetricsMaxPoints,
 			HeartbeatInterval:             DefaultHeartbeatInterval,
+			LiveRedrawSeconds:             DefaultLiveRedrawSeconds,
+			RecentRunsLimit:               DefaultRecentRunsLimit,
+			SelectionUndoSeconds:          DefaultSelectionUndoSeconds,
 			LeftSidebarVisible:            true,
 			RightSidebarVisible:           true,
 			MetricsGridVisible:            true,
@@ -214,6 +571,11 @@ func NewConfigManager(path string, logger *observability.CoreLogger) *ConfigMana
 			WorkspaceSystemMetricsVisible: false,
 			WorkspaceConsoleLogsVisible:   false,
 			WorkspaceMediaVisible:         false,
+			ConsoleLogTimestamps:          true,
+			ConsoleLogCommand:             true,
+			WarnMixedProjects:             true,
+			OverviewValuePrecision:        DefaultOverviewValuePrecision,
+			OverviewSciExponent:           DefaultOverviewSciExponent,
 		},
 		logger: logger,
 	}
@@ -308,15 +670,148 @@ func (cm *ConfigManager) normalizeConfig() {
 	if cm.config.HeartbeatInterval <= 0 {
 		cm.config.HeartbeatInterval = DefaultHeartbeatInterval
 	}
+	cm.config.LiveRedrawSeconds = max(0, min(cm.config.LiveRedrawSeconds, maxLiveRedrawSeconds))
+	if cm.config.RecentRunsLimit <= 0 {
+		cm.config.RecentRunsLimit = DefaultRecentRunsLimit
+	}
+	if cm.config.SelectionUndoSeconds < 0 {
+		cm.config.SelectionUndoSeconds = DefaultSelectionUndoSeconds
+	}
+	cm.config.SelectionUndoSeconds = min(cm.config.SelectionUndoSeconds, maxSelectionUndoSeconds)
+	cm.config.IdleDimSeconds = max(0, min(cm.config.IdleDimSeconds, maxIdleDimSeconds))
+	if cm.config.MinMaxBandWindow < 2 {
+		cm.config.MinMaxBandWindow = DefaultMinMaxBandWindow
+	}
+	if cm.config.LoggedBandMinSuffix == "" || cm.config.LoggedBandMaxSuffix == "" ||
+		cm.config.LoggedBandMinSuffix == cm.config.LoggedBandMaxSuffix {
+		cm.config.LoggedBandMinSuffix = DefaultLoggedBandMinSuffix
+		cm.config.LoggedBandMaxSuffix = DefaultLoggedBandMaxSuffix
+	}
+	if cm.config.SampledRenderingThreshold < 0 {
+		cm.config.SampledRenderingThreshold = DefaultSampledRenderingThreshold
+	}
+	if cm.config.ConsoleLogSampleThreshold < 0 {
+		cm.config.ConsoleLogSampleThreshold = DefaultConsoleLogSampleThreshold
+	}
+	if cm.config.MaxMetricCharts < 0 {
+		cm.config.MaxMetricCharts = DefaultMaxMetricCharts
+	}
+	if cm.config.ConsoleLogsHeight < 0 {
+		cm.config.ConsoleLogsHeight = 0
+	}
+	if !isValidColor(cm.config.StaleRunColor) {
+		cm.config.StaleRunColor = DefaultStaleRunColor
+	}
 
 	if cm.config.SystemTailWindowMinutes <= 0 {
 		cm.config.SystemTailWindowMinutes = DefaultSystemTailWindowMins
 	}
+	cm.config.SystemMetricsMaxPoints = max(cm.config.SystemMetricsMaxPoints, 0)
+
+	if cm.config.OverviewValuePrecision <= 0 {
+		cm.config.OverviewValuePrecision = DefaultOverviewValuePrecision
+	}
+	cm.config.OverviewValuePrecision = min(cm.config.OverviewValuePrecision, maxOverviewValuePrecision)
+	if cm.config.OverviewSciExponent <= 0 {
+		cm.config.OverviewSciExponent = DefaultOverviewSciExponent
+	}
+	cm.config.OverviewSciExponent = min(cm.config.OverviewSciExponent, maxOverviewSciExponent)
 
 	if cm.config.StartupMode != StartupModeWorkspaceLatest &&
 		cm.config.StartupMode != StartupModeSingleRunLatest {
 		cm.config.StartupMode = DefaultStartupMode
 	}
+
+	if cm.config.RunListEnter != RunListEnterView &&
+		cm.config.RunListEnter != RunListEnterSelect {
+		cm.config.RunListEnter = DefaultRunListEnter
+	}
+
+	if !slices.Contains(runListOrders(), cm.config.RunListOrder) {
+		cm.config.RunListOrder = DefaultRunListOrder
+	}
+
+	if cm.config.ConsoleLogsStart != ConsoleLogsStartTail &&
+		cm.config.ConsoleLogsStart != ConsoleLogsStartTop {
+		cm.config.ConsoleLogsStart = DefaultConsoleLogsStart
+	}
+
+	if cm.config.ObjectiveDirection != ObjectiveMinimize &&
+		cm.config.ObjectiveDirection != ObjectiveMaximize {
+		cm.config.ObjectiveDirection = ObjectiveMinimize
+	}
+
+	if cm.config.MetricsSortOrder != MetricsSortAlphabetical &&
+		cm.config.MetricsSortOrder != MetricsSortLogged {
+		cm.config.MetricsSortOrder = DefaultMetricsSortOrder
+	}
+
+	if !slices.Contains(colorProfiles(), cm.config.ColorProfile) {
+		cm.config.ColorProfile = DefaultColorProfile
+	}
+	if cm.config.XAxisMode != XAxisStep && cm.config.XAxisMode != XAxisEpoch {
+		cm.config.XAxisMode = DefaultXAxisMode
+	}
+	cm.config.XAxisKey = strings.TrimSpace(cm.config.XAxisKey)
+
+	// Drop empty and self-referential aliases.
+	for from, to := range cm.config.MetricAliases {
+		if from == "" || to == "" || from == to {
+			delete(cm.config.MetricAliases, from)
+		}
+	}
+
+	// Drop malformed blocklist patterns.
+	cm.config.MetricBlocklist = slices.DeleteFunc(cm.config.MetricBlocklist, func(p string) bool {
+		_, err := path.Match(p, "")
+		return p == "" || err != nil
+	})
+
+	// Drop unknown metric units.
+	for name, unit := range cm.config.MetricUnits {
+		if _, ok := ByteUnitFormatter(unit); name == "" || !ok {
+			delete(cm.config.MetricUnits, name)
+		}
+	}
+
+	// Drop unnamed and non-finite metric targets.
+	for name, target := range cm.config.MetricTargets {
+		if name == "" || !isFinite(target) {
+			delete(cm.config.MetricTargets, name)
+		}
+	}
+
+	// Drop unnamed and self-referencing secondary axes.
+	for name, secondary := range cm.config.SecondaryAxes {
+		if name == "" || secondary == "" || secondary == name {
+			delete(cm.config.SecondaryAxes, name)
+		}
+	}
+
+	// Drop empty workspace selections and pins of unselected runs.
+	for dir, sel := range cm.config.WorkspaceSelections {
+		if dir == "" || len(sel.Runs) == 0 {
+			delete(cm.config.WorkspaceSelections, dir)
+			continue
+		}
+		if sel.Pinned != "" && !slices.Contains(sel.Runs, sel.Pinned) {
+			sel.Pinned = ""
+			cm.config.WorkspaceSelections[dir] = sel
+		}
+	}
+
+	// Drop unnamed inverted metrics.
+	cm.config.InvertedMetrics = slices.DeleteFunc(cm.config.InvertedMetrics,
+		func(name string) bool { return name == "" })
+
+	// Drop empty summary strip keys.
+	cm.config.SummaryStripKeys = slices.DeleteFunc(cm.config.SummaryStripKeys, func(key string) bool {
+		return key == ""
+	})
+
+	// Drop empty metrics filter presets.
+	cm.config.MetricsFilterPresets = slices.DeleteFunc(cm.config.MetricsFilterPresets,
+		func(query string) bool { return query == "" })
 }
 
 func clamp(val, minimum, maximum int) int {
@@ -329,6 +824,17 @@ func clamp(val, minimum, maximum int) int {
 	return val
 }
 
+// isValidColor reports whether s is a "#rrggbb" hex color or an ANSI
+// color number from 0 to 255.
+func isValidColor(s string) bool {
+	if hex, ok := strings.CutPrefix(s, "#"); ok {
+		_, err := strconv.ParseUint(hex, 16, 32)
+		return len(hex) == 6 && err == nil
+	}
+	n, err := strconv.Atoi(s)
+	return err == nil && n >= 0 && n <= 255
+}
+
 // save writes the current configuration to disk.
 //
 // Must be called while holding the lock.
@@ -452,6 +958,7 @@ func (cm *ConfigManager) WorkspaceMetricsGrid() (rows, cols int) {
 	return cm.config.WorkspaceMetricsGrid.Rows, cm.config.WorkspaceMetricsGrid.Cols
 }
 
+// SetWorkspaceMetricsRows sets the workspace metrics grid rows.
 func (cm *ConfigManager) SetWorkspaceMetricsRows(rows int) error {
 	if rows < MinGridSize || rows > MaxGridSize {
 		return fmt.Errorf("rows must be between %d and %d, got %d", MinGridSize, MaxGridSize, rows)
@@ -463,6 +970,7 @@ func (cm *ConfigManager) SetWorkspaceMetricsRows(rows int) error {
 	return cm.save()
 }
 
+// SetWorkspaceMetricsCols sets the workspace metrics grid columns.
 func (cm *ConfigManager) SetWorkspaceMetricsCols(cols int) error {
 	if cols < MinGridSize || cols > MaxGridSize {
 		return fmt.Errorf("cols must be between %d and %d, got %d", MinGridSize, MaxGridSize, cols)
@@ -481,20 +989,7 @@ func (cm *ConfigManager) WorkspaceSystemGrid() (rows, cols int) {
 	return cm.config.WorkspaceSystemGrid.Rows, cm.config.WorkspaceSystemGrid.Cols
 }
 
-// WorkspaceMediaGrid returns the workspace media grid configuration.
-func (cm *ConfigManager) WorkspaceMediaGrid() (rows, cols int) {
-	cm.mu.RLock()
-	defer cm.mu.RUnlock()
-	return cm.config.WorkspaceMediaGrid.Rows, cm.config.WorkspaceMediaGrid.Cols
-}
-
-// SymonGrid returns the standalone system monitor grid configuration.
-func (cm *ConfigManager) SymonGrid() (rows, cols int) {
-	cm.mu.RLock()
-	defer cm.mu.RUnlock()
-	return cm.config.SymonGrid.Rows, cm.config.SymonGrid.Cols
-}
-
+// SetWorkspaceSystemRows sets the workspace system grid rows.
 func (cm *ConfigManager) SetWorkspaceSystemRows(rows int) error {
 	if rows < MinGridSize || rows > MaxGridSize {
 		return fmt.Errorf("rows must be between %d and %d, got %d", MinGridSize, MaxGridSize, rows)
@@ -506,6 +1001,7 @@ func (cm *ConfigManager) SetWorkspaceSystemRows(rows int) error {
 	return cm.save()
 }
 
+// SetWorkspaceSystemCols sets the workspace system grid columns.
 func (cm *ConfigManager) SetWorkspaceSystemCols(cols int) error {
 	if cols < MinGridSize || cols > MaxGridSize {
 		return fmt.Errorf("cols must be between %d and %d, got %d", MinGridSize, MaxGridSize, cols)
@@ -517,6 +1013,14 @@ func (cm *ConfigManager) SetWorkspaceSystemCols(cols int) error {
 	return cm.save()
 }
 
+// WorkspaceMediaGrid returns the workspace media grid configuration.
+func (cm *ConfigManager) WorkspaceMediaGrid() (rows, cols int) {
+	cm.mu.RLock()
+	defer cm.mu.RUnlock()
+	return cm.config.WorkspaceMediaGrid.Rows, cm.config.WorkspaceMediaGrid.Cols
+}
+
+// SetWorkspaceMediaRows sets the workspace media grid rows.
 func (cm *ConfigManager) SetWorkspaceMediaRows(rows int) error {
 	if rows < MinGridSize || rows > MaxGridSize {
 		return fmt.Errorf("rows must be between %d and %d, got %d", MinGridSize, MaxGridSize, rows)
@@ -528,6 +1032,7 @@ func (cm *ConfigManager) SetWorkspaceMediaRows(rows int) error {
 	return cm.save()
 }
 
+// SetWorkspaceMediaCols sets the workspace media grid columns.
 func (cm *ConfigManager) SetWorkspaceMediaCols(cols int) error {
 	if cols < MinGridSize || cols > MaxGridSize {
 		return fmt.Errorf("cols must be between %d and %d, got %d", MinGridSize, MaxGridSize, cols)
@@ -539,6 +1044,14 @@ func (cm *ConfigManager) SetWorkspaceMediaCols(cols int) error {
 	return cm.save()
 }
 
+// SymonGrid returns the standalone system monitor grid configuration.
+func (cm *ConfigManager) SymonGrid() (rows, cols int) {
+	cm.mu.RLock()
+	defer cm.mu.RUnlock()
+	return cm.config.SymonGrid.Rows, cm.config.SymonGrid.Cols
+}
+
+// SetSymonRows sets the standalone system monitor grid rows.
 func (cm *ConfigManager) SetSymonRows(rows int) error {
 	if rows < MinGridSize || rows > MaxGridSize {
 		return fmt.Errorf("rows must be between %d and %d, got %d", MinGridSize, MaxGridSize, rows)
@@ -550,6 +1063,7 @@ func (cm *ConfigManager) SetSymonRows(rows int) error {
 	return cm.save()
 }
 
+// SetSymonCols sets the standalone system monitor grid columns.
 func (cm *ConfigManager) SetSymonCols(cols int) error {
 	if cols < MinGridSize || cols > MaxGridSize {
 		return fmt.Errorf("cols must be between %d and %d, got %d", MinGridSize, MaxGridSize, cols)
@@ -572,7 +1086,18 @@ func (cm *ConfigManager) Path() string {
 func (cm *ConfigManager) Snapshot() Config {
 	cm.mu.RLock()
 	defer cm.mu.RUnlock()
-	return cm.config
+	cfg := cm.config
+	cfg.MetricAliases = maps.Clone(cm.config.MetricAliases)
+	cfg.MetricBlocklist = slices.Clone(cm.config.MetricBlocklist)
+	cfg.MetricUnits = maps.Clone(cm.config.MetricUnits)
+	cfg.MetricTargets = maps.Clone(cm.config.MetricTargets)
+	cfg.InvertedMetrics = slices.Clone(cm.config.InvertedMetrics)
+	cfg.SecondaryAxes = maps.Clone(cm.config.SecondaryAxes)
+	cfg.SummaryStripKeys = slices.Clone(cm.config.SummaryStripKeys)
+	cfg.MetricsFilterPresets = slices.Clone(cm.config.MetricsFilterPresets)
+	cfg.CollapsedOverviewSections = maps.Clone(cm.config.CollapsedOverviewSections)
+	cfg.WorkspaceSelections = maps.Clone(cm.config.WorkspaceSelections)
+	return cfg
 }
 
 // StartupMode returns the configured startup mode.
@@ -596,6 +1121,182 @@ func (cm *ConfigManager) SetStartupMode(mode string) error {
 	return cm.save()
 }
 
+// RunListEnter returns what Enter does on the workspace runs list.
+func (cm *ConfigManager) RunListEnter() string {
+	cm.mu.RLock()
+	defer cm.mu.RUnlock()
+	return cm.config.RunListEnter
+}
+
+// SetRunListEnter sets what Enter does on the workspace runs list and
+// persists it.
+func (cm *ConfigManager) SetRunListEnter(action string) error {
+	if action != RunListEnterView && action != RunListEnterSelect {
+		return fmt.Errorf(
+			"run_list_enter must be %q or %q, got %q",
+			RunListEnterView, RunListEnterSelect, action,
+		)
+	}
+	cm.mu.Lock()
+	defer cm.mu.Unlock()
+	cm.config.RunListEnter = action
+	return cm.save()
+}
+
+// ConsoleLogsStart returns where a newly shown run's console logs open.
+func (cm *ConfigManager) ConsoleLogsStart() string {
+	cm.mu.RLock()
+	defer cm.mu.RUnlock()
+	return cm.config.ConsoleLogsStart
+}
+
+// SetConsoleLogsStart sets where a newly shown run's console logs open
+// and persists it.
+func (cm *ConfigManager) SetConsoleLogsStart(start string) error {
+	if start != ConsoleLogsStartTail && start != ConsoleLogsStartTop {
+		return fmt.Errorf(
+			"console_logs_start must be %q or %q, got %q",
+			ConsoleLogsStartTail, ConsoleLogsStartTop, start,
+		)
+	}
+	cm.mu.Lock()
+	defer cm.mu.Unlock()
+	cm.config.ConsoleLogsStart = start
+	return cm.save()
+}
+
+// MetricsSortOrder returns the configured metrics chart order.
+func (cm *ConfigManager) MetricsSortOrder() string {
+	cm.mu.RLock()
+	defer cm.mu.RUnlock()
+	return cm.config.MetricsSortOrder
+}
+
+// SetMetricsSortOrder sets the metrics chart order and persists it.
+func (cm *ConfigManager) SetMetricsSortOrder(order string) error {
+	if order != MetricsSortAlphabetical && order != MetricsSortLogged {
+		return fmt.Errorf(
+			"metrics_sort_order must be %q or %q, got %q",
+			MetricsSortAlphabetical, MetricsSortLogged, order,
+		)
+	}
+	cm.mu.Lock()
+	defer cm.mu.Unlock()
+	cm.config.MetricsSortOrder = order
+	return cm.save()
+}
+
+// runListOrders returns the allowed RunListOrder values, in the order
+// the workspace cycles through them.
+func runListOrders() []string {
+	return []string{RunListOrderRecent, RunListOrderName, RunListOrderLiveFirst}
+}
+
+// colorProfiles returns the allowed ColorProfile values.
+func colorProfiles() []string {
+	return []string{ColorProfileAuto, ColorProfileTrueColor, ColorProfileANSI256, ColorProfileANSI}
+}
+
+// ColorProfile returns the configured terminal color profile.
+func (cm *ConfigManager) ColorProfile() string {
+	cm.mu.RLock()
+	defer cm.mu.RUnlock()
+	return cm.config.ColorProfile
+}
+
+// SetColorProfile sets the terminal color profile and persists it.
+//
+// Takes effect on the next start.
+func (cm *ConfigManager) SetColorProfile(profile string) error {
+	if !slices.Contains(colorProfiles(), profile) {
+		return fmt.Errorf("color_profile must be one of %v, got %q", colorProfiles(), profile)
+	}
+	cm.mu.Lock()
+	defer cm.mu.Unlock()
+	cm.config.ColorProfile = profile
+	return cm.save()
+}
+
+// XAxisMode returns what main metrics charts are plotted against.
+func (cm *ConfigManager) XAxisMode() string {
+	cm.mu.RLock()
+	defer cm.mu.RUnlock()
+	return cm.config.XAxisMode
+}
+
+// XAxisKey returns the history key metrics are plotted against, or ""
+// to follow XAxisMode.
+func (cm *ConfigManager) XAxisKey() string {
+	cm.mu.RLock()
+	defer cm.mu.RUnlock()
+	return cm.config.XAxisKey
+}
+
+// SetXAxisKey sets the history key metrics are plotted against and
+// persists it. An empty key restores XAxisMode.
+func (cm *ConfigManager) SetXAxisKey(key string) error {
+	cm.mu.Lock()
+	defer cm.mu.Unlock()
+	cm.config.XAxisKey = strings.TrimSpace(key)
+	return cm.save()
+}
+
+// SetXAxisMode sets the metrics x-axis mode and persists it.
+func (cm *ConfigManager) SetXAxisMode(mode string) error {
+	if mode != XAxisStep && mode != XAxisEpoch {
+		return fmt.Errorf(
+			"x_axis_mode must be %q or %q, got %q",
+			XAxisStep, XAxisEpoch, mode,
+		)
+	}
+	cm.mu.Lock()
+	defer cm.mu.Unlock()
+	cm.config.XAxisMode = mode
+	return cm.save()
+}
+
+// Objective returns the objective metric and its direction.
+//
+// The metric is "" when no objective is set.
+func (cm *ConfigManager) Objective() (metric, direction string) {
+	cm.mu.RLock()
+	defer cm.mu.RUnlock()
+	return cm.config.ObjectiveMetric, cm.config.ObjectiveDirection
+}
+
+// SetObjective sets the objective metric and direction and persists them.
+//
+// An empty metric clears the objective.
+func (cm *ConfigManager) SetObjective(metric, direction string) error {
+	if direction != ObjectiveMinimize && direction != ObjectiveMaximize {
+		return fmt.Errorf(
+			"objective_direction must be %q or %q, got %q",
+			ObjectiveMinimize, ObjectiveMaximize, direction,
+		)
+	}
+	cm.mu.Lock()
+	defer cm.mu.Unlock()
+	cm.config.ObjectiveMetric = metric
+	cm.config.ObjectiveDirection = direction
+	return cm.save()
+}
+
+// HeroMetric returns the metric pinned to the first grid cell, or "".
+func (cm *ConfigManager) HeroMetric() string {
+	cm.mu.RLock()
+	defer cm.mu.RUnlock()
+	return cm.config.HeroMetric
+}
+
+// SetHeroMetric sets the metric pinned to the first grid cell and persists
+// it. An empty name clears it.
+func (cm *ConfigManager) SetHeroMetric(name string) error {
+	cm.mu.Lock()
+	defer cm.mu.Unlock()
+	cm.config.HeroMetric = name
+	return cm.save()
+}
+
 // ColorScheme returns the current color scheme.
 func (cm *ConfigManager) ColorScheme() string {
 	cm.mu.RLock()
@@ -742,6 +1443,27 @@ func (cm *ConfigManager) SetSystemTailWindowMinutes(minutes int) error {
 	return cm.save()
 }
 
+// SystemMetricsMaxPoints returns the most points kept per system metric
+// series, or 0 for no limit.
+func (cm *ConfigManager) SystemMetricsMaxPoints() int {
+	cm.mu.RLock()
+	defer cm.mu.RUnlock()
+	return cm.config.SystemMetricsMaxPoints
+}
+
+// SetSystemMetricsMaxPoints sets the most points kept per system metric
+// series. 0 keeps every point.
+func (cm *ConfigManager) SetSystemMetricsMaxPoints(n int) error {
+	if n < 0 {
+		return fmt.Errorf("system metrics max points must be non-negative")
+	}
+
+	cm.mu.Lock()
+	defer cm.mu.Unlock()
+	cm.config.SystemMetricsMaxPoints = n
+	return cm.save()
+}
+
 // HeartbeatInterval returns the heartbeat interval as a Duration.
 func (cm *ConfigManager) HeartbeatInterval() time.Duration {
 	cm.mu.RLock()
@@ -762,60 +1484,158 @@ func (cm *ConfigManager) SetHeartbeatInterval(seconds int) error {
 	return cm.save()
 }
 
-// LeftSidebarVisible returns whether the left sidebar should be visible.
-func (cm *ConfigManager) LeftSidebarVisible() bool {
+// LiveRedrawInterval returns the longest time between redraws while a
+// run is live; 0 means redraws are driven by heartbeats and records only.
+func (cm *ConfigManager) LiveRedrawInterval() time.Duration {
 	cm.mu.RLock()
 	defer cm.mu.RUnlock()
-	return cm.config.LeftSidebarVisible
+
+	return time.Duration(cm.config.LiveRedrawSeconds) * time.Second
 }
 
-// SetLeftSidebarVisible sets the left sidebar visibility.
-func (cm *ConfigManager) SetLeftSidebarVisible(visible bool) error {
+// SetLiveRedrawSeconds sets the longest time between redraws while a run
+// is live; 0 disables the periodic redraw.
+func (cm *ConfigManager) SetLiveRedrawSeconds(seconds int) error {
+	if seconds < 0 || seconds > maxLiveRedrawSeconds {
+		return fmt.Errorf("live redraw interval must be between 0 and %d seconds",
+			maxLiveRedrawSeconds)
+	}
+
 	cm.mu.Lock()
 	defer cm.mu.Unlock()
-	cm.config.LeftSidebarVisible = visible
+	cm.config.LiveRedrawSeconds = seconds
 	return cm.save()
 }
 
-// RightSidebarVisible returns whether the right sidebar should be visible.
-func (cm *ConfigManager) RightSidebarVisible() bool {
+// RecentRunsLimit returns how many recent runs the recent-runs toggle keeps.
+func (cm *ConfigManager) RecentRunsLimit() int {
 	cm.mu.RLock()
 	defer cm.mu.RUnlock()
-	return cm.config.RightSidebarVisible
+	return cm.config.RecentRunsLimit
 }
 
-// SetRightSidebarVisible sets the right sidebar visibility.
-func (cm *ConfigManager) SetRightSidebarVisible(visible bool) error {
+// SetRecentRunsLimit sets how many recent runs the recent-runs toggle keeps.
+func (cm *ConfigManager) SetRecentRunsLimit(n int) error {
+	if n <= 0 {
+		return fmt.Errorf("recent runs limit must be a positive integer")
+	}
+
 	cm.mu.Lock()
 	defer cm.mu.Unlock()
-	cm.config.RightSidebarVisible = visible
+	cm.config.RecentRunsLimit = n
 	return cm.save()
 }
 
-// ConsoleLogsVisible returns whether the console logs pane
-// should be visible in single-run mode.
-func (cm *ConfigManager) ConsoleLogsVisible() bool {
+// SelectionUndoWindow returns how long after a bulk deselect the previous
+// selection can be restored, or 0 if undo is disabled.
+func (cm *ConfigManager) SelectionUndoWindow() time.Duration {
 	cm.mu.RLock()
 	defer cm.mu.RUnlock()
-	return cm.config.ConsoleLogsVisible
+	return time.Duration(cm.config.SelectionUndoSeconds) * time.Second
 }
 
-// SetConsoleLogsVisible sets the single-run console logs pane visibility.
-func (cm *ConfigManager) SetConsoleLogsVisible(visible bool) error {
+// SetSelectionUndoSeconds sets how many seconds after a bulk deselect the
+// previous selection can be restored; 0 disables undo.
+func (cm *ConfigManager) SetSelectionUndoSeconds(n int) error {
+	if n < 0 || n > maxSelectionUndoSeconds {
+		return fmt.Errorf("selection undo window must be between 0 and %d seconds",
+			maxSelectionUndoSeconds)
+	}
+
 	cm.mu.Lock()
 	defer cm.mu.Unlock()
-	cm.config.ConsoleLogsVisible = visible
+	cm.config.SelectionUndoSeconds = n
 	return cm.save()
 }
 
-// MetricsGridVisible returns whether the metrics grid should be visible in single-run mode.
-func (cm *ConfigManager) MetricsGridVisible() bool {
+// LeftSidebarVisible returns whether the left sidebar should be visible.
+func (cm *ConfigManager) LeftSidebarVisible() bool {
 	cm.mu.RLock()
 	defer cm.mu.RUnlock()
-	return cm.config.MetricsGridVisible
+	return cm.config.LeftSidebarVisible
 }
 
-// SetMetricsGridVisible sets the single-run metrics grid visibility.
+// SetLeftSidebarVisible sets the left sidebar visibility.
+func (cm *ConfigManager) SetLeftSidebarVisible(visible bool) error {
+	cm.mu.Lock()
+	defer cm.mu.Unlock()
+	cm.config.LeftSidebarVisible = visible
+	return cm.save()
+}
+
+// RightSidebarVisible returns whether the right sidebar should be visible.
+func (cm *ConfigManager) RightSidebarVisible() bool {
+	cm.mu.RLock()
+	defer cm.mu.RUnlock()
+	return cm.config.RightSidebarVisible
+}
+
+// SetRightSidebarVisible sets the right sidebar visibility.
+func (cm *ConfigManager) SetRightSidebarVisible(visible bool) error {
+	cm.mu.Lock()
+	defer cm.mu.Unlock()
+	cm.config.RightSidebarVisible = visible
+	return cm.save()
+}
+
+// ConsoleLogsVisible returns whether the console logs pane
+// should be visible in single-run mode.
+func (cm *ConfigManager) ConsoleLogsVisible() bool {
+	cm.mu.RLock()
+	defer cm.mu.RUnlock()
+	return cm.config.ConsoleLogsVisible
+}
+
+// SetConsoleLogsVisible sets the single-run console logs pane visibility.
+func (cm *ConfigManager) SetConsoleLogsVisible(visible bool) error {
+	cm.mu.Lock()
+	defer cm.mu.Unlock()
+	cm.config.ConsoleLogsVisible = visible
+	return cm.save()
+}
+
+// ConsoleLogTimestamps returns whether the console logs pane shows
+// its timestamp column.
+func (cm *ConfigManager) ConsoleLogTimestamps() bool {
+	cm.mu.RLock()
+	defer cm.mu.RUnlock()
+	return cm.config.ConsoleLogTimestamps
+}
+
+// SetConsoleLogTimestamps sets whether the console logs pane shows its
+// timestamp column.
+func (cm *ConfigManager) SetConsoleLogTimestamps(show bool) error {
+	cm.mu.Lock()
+	defer cm.mu.Unlock()
+	cm.config.ConsoleLogTimestamps = show
+	return cm.save()
+}
+
+// ConsoleLogCommand returns whether the console logs pane header shows
+// the run's launch command.
+func (cm *ConfigManager) ConsoleLogCommand() bool {
+	cm.mu.RLock()
+	defer cm.mu.RUnlock()
+	return cm.config.ConsoleLogCommand
+}
+
+// SetConsoleLogCommand sets whether the console logs pane header shows
+// the run's launch command.
+func (cm *ConfigManager) SetConsoleLogCommand(show bool) error {
+	cm.mu.Lock()
+	defer cm.mu.Unlock()
+	cm.config.ConsoleLogCommand = show
+	return cm.save()
+}
+
+// MetricsGridVisible returns whether the metrics grid should be visible in single-run mode.
+func (cm *ConfigManager) MetricsGridVisible() bool {
+	cm.mu.RLock()
+	defer cm.mu.RUnlock()
+	return cm.config.MetricsGridVisible
+}
+
+// SetMetricsGridVisible sets the single-run metrics grid visibility.
 func (cm *ConfigManager) SetMetricsGridVisible(visible bool) error {
 	cm.mu.Lock()
 	defer cm.mu.Unlock()
@@ -943,6 +1763,23 @@ func (cm *ConfigManager) WorkspaceOverviewVisible() bool {
 	return cm.config.WorkspaceOverviewVisible
 }
 
+// WorkspaceOverviewAutoExpand returns whether selecting a run expands
+// the collapsed workspace overview sidebar.
+func (cm *ConfigManager) WorkspaceOverviewAutoExpand() bool {
+	cm.mu.RLock()
+	defer cm.mu.RUnlock()
+	return cm.config.WorkspaceOverviewAutoExpand
+}
+
+// SetWorkspaceOverviewAutoExpand sets whether selecting a run expands
+// the collapsed workspace overview sidebar.
+func (cm *ConfigManager) SetWorkspaceOverviewAutoExpand(expand bool) error {
+	cm.mu.Lock()
+	defer cm.mu.Unlock()
+	cm.config.WorkspaceOverviewAutoExpand = expand
+	return cm.save()
+}
+
 // SetWorkspaceOverviewVisible sets the workspace overview sidebar visibility.
 func (cm *ConfigManager) SetWorkspaceOverviewVisible(visible bool) error {
 	cm.mu.Lock()
@@ -1013,6 +1850,874 @@ func (cm *ConfigManager) SetWorkspaceMediaVisible(visible bool) error {
 	return cm.save()
 }
 
+// RawXAxisSteps returns whether metrics charts show exact X axis steps.
+func (cm *ConfigManager) RawXAxisSteps() bool {
+	cm.mu.RLock()
+	defer cm.mu.RUnlock()
+	return cm.config.RawXAxisSteps
+}
+
+// SetRawXAxisSteps sets whether metrics charts show exact X axis steps.
+func (cm *ConfigManager) SetRawXAxisSteps(raw bool) error {
+	cm.mu.Lock()
+	defer cm.mu.Unlock()
+	cm.config.RawXAxisSteps = raw
+	return cm.save()
+}
+
+// MinMaxBand returns whether the rolling min/max band is shown and its
+// window in samples.
+func (cm *ConfigManager) MinMaxBand() (show bool, window int) {
+	cm.mu.RLock()
+	defer cm.mu.RUnlock()
+	return cm.config.ShowMinMaxBand, cm.config.MinMaxBandWindow
+}
+
+// LoggedBands returns whether logged min/max bands are shown and the
+// suffixes of their companion keys.
+func (cm *ConfigManager) LoggedBands() (show bool, minSuffix, maxSuffix string) {
+	cm.mu.RLock()
+	defer cm.mu.RUnlock()
+	return cm.config.ShowLoggedBands,
+		cm.config.LoggedBandMinSuffix, cm.config.LoggedBandMaxSuffix
+}
+
+// SetShowLoggedBands sets whether logged min/max bands are shown.
+func (cm *ConfigManager) SetShowLoggedBands(show bool) error {
+	cm.mu.Lock()
+	defer cm.mu.Unlock()
+	cm.config.ShowLoggedBands = show
+	return cm.save()
+}
+
+// SetLoggedBandSuffixes sets the suffixes of a logged band's companion
+// keys, e.g. "_lo" and "_hi".
+func (cm *ConfigManager) SetLoggedBandSuffixes(minSuffix, maxSuffix string) error {
+	if minSuffix == "" || maxSuffix == "" {
+		return fmt.Errorf("logged band suffixes must not be empty")
+	}
+	if minSuffix == maxSuffix {
+		return fmt.Errorf("logged band suffixes must differ, got %q twice", minSuffix)
+	}
+
+	cm.mu.Lock()
+	defer cm.mu.Unlock()
+	cm.config.LoggedBandMinSuffix = minSuffix
+	cm.config.LoggedBandMaxSuffix = maxSuffix
+	return cm.save()
+}
+
+// ShowPointMarkers returns whether metrics lines mark each logged step.
+func (cm *ConfigManager) ShowPointMarkers() bool {
+	cm.mu.RLock()
+	defer cm.mu.RUnlock()
+	return cm.config.ShowPointMarkers
+}
+
+// SetShowPointMarkers sets whether metrics lines mark each logged step.
+func (cm *ConfigManager) SetShowPointMarkers(show bool) error {
+	cm.mu.Lock()
+	defer cm.mu.Unlock()
+	cm.config.ShowPointMarkers = show
+	return cm.save()
+}
+
+// IdleDimDelay returns how long without input before unfocused charts
+// are dimmed, or 0 if dimming is disabled.
+func (cm *ConfigManager) IdleDimDelay() time.Duration {
+	cm.mu.RLock()
+	defer cm.mu.RUnlock()
+	return time.Duration(cm.config.IdleDimSeconds) * time.Second
+}
+
+// SetIdleDimSeconds sets how many seconds without input before unfocused
+// charts are dimmed; 0 disables dimming.
+func (cm *ConfigManager) SetIdleDimSeconds(n int) error {
+	if n < 0 || n > maxIdleDimSeconds {
+		return fmt.Errorf("idle dim delay must be between 0 and %d seconds", maxIdleDimSeconds)
+	}
+
+	cm.mu.Lock()
+	defer cm.mu.Unlock()
+	cm.config.IdleDimSeconds = n
+	return cm.save()
+}
+
+// SetShowMinMaxBand sets whether the rolling min/max band is shown.
+func (cm *ConfigManager) SetShowMinMaxBand(show bool) error {
+	cm.mu.Lock()
+	defer cm.mu.Unlock()
+	cm.config.ShowMinMaxBand = show
+	return cm.save()
+}
+
+// SetMinMaxBandWindow sets the rolling min/max band window in samples.
+func (cm *ConfigManager) SetMinMaxBandWindow(window int) error {
+	if window < 2 {
+		return fmt.Errorf("min/max band window must be at least 2")
+	}
+	cm.mu.Lock()
+	defer cm.mu.Unlock()
+	cm.config.MinMaxBandWindow = window
+	return cm.save()
+}
+
+// SampledRenderingThreshold returns the total point count above which
+// charts draw sampled data, or 0 if sampling is disabled.
+func (cm *ConfigManager) SampledRenderingThreshold() int {
+	cm.mu.RLock()
+	defer cm.mu.RUnlock()
+	return cm.config.SampledRenderingThreshold
+}
+
+// SetSampledRenderingThreshold sets the sampled rendering threshold.
+func (cm *ConfigManager) SetSampledRenderingThreshold(n int) error {
+	if n < 0 {
+		return fmt.Errorf("sampled rendering threshold must be non-negative")
+	}
+
+	cm.mu.Lock()
+	defer cm.mu.Unlock()
+	cm.config.SampledRenderingThreshold = n
+	return cm.save()
+}
+
+// MaxMetricCharts returns the number of distinct metrics charted in a
+// metrics grid, or 0 if there is no cap.
+func (cm *ConfigManager) MaxMetricCharts() int {
+	cm.mu.RLock()
+	defer cm.mu.RUnlock()
+	return cm.config.MaxMetricCharts
+}
+
+// SetMaxMetricCharts sets the metrics grid chart cap.
+func (cm *ConfigManager) SetMaxMetricCharts(n int) error {
+	if n < 0 {
+		return fmt.Errorf("max metric charts must be non-negative")
+	}
+
+	cm.mu.Lock()
+	defer cm.mu.Unlock()
+	cm.config.MaxMetricCharts = n
+	return cm.save()
+}
+
+// ConsoleLogsHeight returns the expanded console logs pane height in
+// rows, or 0 to size it to the window.
+func (cm *ConfigManager) ConsoleLogsHeight() int {
+	cm.mu.RLock()
+	defer cm.mu.RUnlock()
+	return cm.config.ConsoleLogsHeight
+}
+
+// SetConsoleLogsHeight sets the expanded console logs pane height.
+func (cm *ConfigManager) SetConsoleLogsHeight(h int) error {
+	if h < 0 {
+		return fmt.Errorf("console logs height must be non-negative")
+	}
+
+	cm.mu.Lock()
+	defer cm.mu.Unlock()
+	cm.config.ConsoleLogsHeight = h
+	return cm.save()
+}
+
+// ConsoleLogSampleThreshold returns the console log rate, in lines per
+// second, above which lines are sampled, or 0 if sampling is disabled.
+func (cm *ConfigManager) ConsoleLogSampleThreshold() int {
+	cm.mu.RLock()
+	defer cm.mu.RUnlock()
+	return cm.config.ConsoleLogSampleThreshold
+}
+
+// SetConsoleLogSampleThreshold sets the console log sampling threshold.
+func (cm *ConfigManager) SetConsoleLogSampleThreshold(n int) error {
+	if n < 0 {
+		return fmt.Errorf("console log sample threshold must be non-negative")
+	}
+
+	cm.mu.Lock()
+	defer cm.mu.Unlock()
+	cm.config.ConsoleLogSampleThreshold = n
+	return cm.save()
+}
+
+// ShowChartLegend returns whether overlaid charts show a run legend.
+func (cm *ConfigManager) ShowChartLegend() bool {
+	cm.mu.RLock()
+	defer cm.mu.RUnlock()
+	return cm.config.ShowChartLegend
+}
+
+// SetShowChartLegend sets whether overlaid charts show a run legend.
+func (cm *ConfigManager) SetShowChartLegend(show bool) error {
+	cm.mu.Lock()
+	defer cm.mu.Unlock()
+	cm.config.ShowChartLegend = show
+	return cm.save()
+}
+
+// ShowChartFooter returns whether metrics charts show a stats footer.
+func (cm *ConfigManager) ShowChartFooter() bool {
+	cm.mu.RLock()
+	defer cm.mu.RUnlock()
+	return cm.config.ShowChartFooter
+}
+
+// SetShowChartFooter sets whether metrics charts show a stats footer.
+func (cm *ConfigManager) SetShowChartFooter(show bool) error {
+	cm.mu.Lock()
+	defer cm.mu.Unlock()
+	cm.config.ShowChartFooter = show
+	return cm.save()
+}
+
+// SharedXAxis returns whether metrics grid columns share one x-axis.
+func (cm *ConfigManager) SharedXAxis() bool {
+	cm.mu.RLock()
+	defer cm.mu.RUnlock()
+	return cm.config.SharedXAxis
+}
+
+// SetSharedXAxis sets whether metrics grid columns share one x-axis.
+func (cm *ConfigManager) SetSharedXAxis(shared bool) error {
+	cm.mu.Lock()
+	defer cm.mu.Unlock()
+	cm.config.SharedXAxis = shared
+	return cm.save()
+}
+
+// HideEmptyOverviewSections returns whether run overview sections without
+// items are left out of the sidebar.
+func (cm *ConfigManager) HideEmptyOverviewSections() bool {
+	cm.mu.RLock()
+	defer cm.mu.RUnlock()
+	return cm.config.HideEmptyOverviewSections
+}
+
+// SetHideEmptyOverviewSections sets whether run overview sections without
+// items are left out of the sidebar.
+func (cm *ConfigManager) SetHideEmptyOverviewSections(hide bool) error {
+	cm.mu.Lock()
+	defer cm.mu.Unlock()
+	cm.config.HideEmptyOverviewSections = hide
+	return cm.save()
+}
+
+// PerfOverlay returns whether the performance overlay is enabled in config.
+func (cm *ConfigManager) PerfOverlay() bool {
+	cm.mu.RLock()
+	defer cm.mu.RUnlock()
+	return cm.config.PerfOverlay
+}
+
+// SetPerfOverlay sets whether the performance overlay is shown.
+func (cm *ConfigManager) SetPerfOverlay(show bool) error {
+	cm.mu.Lock()
+	defer cm.mu.Unlock()
+	cm.config.PerfOverlay = show
+	return cm.save()
+}
+
+// CompactRunKeys returns whether the runs list shows compact run names.
+func (cm *ConfigManager) CompactRunKeys() bool {
+	cm.mu.RLock()
+	defer cm.mu.RUnlock()
+	return cm.config.CompactRunKeys
+}
+
+// SetCompactRunKeys sets whether the runs list shows compact run names.
+func (cm *ConfigManager) SetCompactRunKeys(compact bool) error {
+	cm.mu.Lock()
+	defer cm.mu.Unlock()
+	cm.config.CompactRunKeys = compact
+	return cm.save()
+}
+
+// DisambiguateRunNames returns whether runs list labels shared by more
+// than one run get the run ID appended.
+func (cm *ConfigManager) DisambiguateRunNames() bool {
+	cm.mu.RLock()
+	defer cm.mu.RUnlock()
+	return cm.config.DisambiguateRunNames
+}
+
+// SetDisambiguateRunNames sets whether runs list labels shared by more
+// than one run get the run ID appended.
+func (cm *ConfigManager) SetDisambiguateRunNames(disambiguate bool) error {
+	cm.mu.Lock()
+	defer cm.mu.Unlock()
+	cm.config.DisambiguateRunNames = disambiguate
+	return cm.save()
+}
+
+// RunListOrder returns how the workspace runs list is sorted.
+func (cm *ConfigManager) RunListOrder() string {
+	cm.mu.RLock()
+	defer cm.mu.RUnlock()
+	return cm.config.RunListOrder
+}
+
+// SetRunListOrder sets how the workspace runs list is sorted and persists it.
+func (cm *ConfigManager) SetRunListOrder(order string) error {
+	if !slices.Contains(runListOrders(), order) {
+		return fmt.Errorf(
+			"run_list_order must be one of %q, got %q", runListOrders(), order)
+	}
+	cm.mu.Lock()
+	defer cm.mu.Unlock()
+	cm.config.RunListOrder = order
+	return cm.save()
+}
+
+// RunListTemplate returns the run list label template, or "" for none.
+func (cm *ConfigManager) RunListTemplate() string {
+	cm.mu.RLock()
+	defer cm.mu.RUnlock()
+	return cm.config.RunListTemplate
+}
+
+// SetRunListTemplate sets the run list label template and persists it.
+//
+// An empty template restores the default labels.
+func (cm *ConfigManager) SetRunListTemplate(template string) error {
+	cm.mu.Lock()
+	defer cm.mu.Unlock()
+	cm.config.RunListTemplate = template
+	return cm.save()
+}
+
+// RunListMetric returns the summary metric shown in the runs list,
+// or "" for none.
+func (cm *ConfigManager) RunListMetric() string {
+	cm.mu.RLock()
+	defer cm.mu.RUnlock()
+	return cm.config.RunListMetric
+}
+
+// SetRunListMetric sets the summary metric shown in the runs list and
+// persists it. An empty name hides the metric.
+func (cm *ConfigManager) SetRunListMetric(name string) error {
+	cm.mu.Lock()
+	defer cm.mu.Unlock()
+	cm.config.RunListMetric = name
+	return cm.save()
+}
+
+// StaleRunColor returns the color of stale runs.
+func (cm *ConfigManager) StaleRunColor() string {
+	cm.mu.RLock()
+	defer cm.mu.RUnlock()
+	return cm.config.StaleRunColor
+}
+
+// SetStaleRunColor sets the color of stale runs and persists it.
+func (cm *ConfigManager) SetStaleRunColor(color string) error {
+	if !isValidColor(color) {
+		return fmt.Errorf(
+			"stale run color must be \"#rrggbb\" or an ANSI color number, got %q", color)
+	}
+
+	cm.mu.Lock()
+	defer cm.mu.Unlock()
+	cm.config.StaleRunColor = color
+	return cm.save()
+}
+
+// LiveUpdateSummary returns whether live reads are summarized in the status bar.
+func (cm *ConfigManager) LiveUpdateSummary() bool {
+	cm.mu.RLock()
+	defer cm.mu.RUnlock()
+	return cm.config.LiveUpdateSummary
+}
+
+// SetLiveUpdateSummary sets whether live reads are summarized in the status bar.
+func (cm *ConfigManager) SetLiveUpdateSummary(show bool) error {
+	cm.mu.Lock()
+	defer cm.mu.Unlock()
+	cm.config.LiveUpdateSummary = show
+	return cm.save()
+}
+
+// WarnMixedProjects returns whether to warn when selected runs span
+// multiple projects.
+func (cm *ConfigManager) WarnMixedProjects() bool {
+	cm.mu.RLock()
+	defer cm.mu.RUnlock()
+	return cm.config.WarnMixedProjects
+}
+
+// SetWarnMixedProjects sets whether to warn about mixed-project selections.
+func (cm *ConfigManager) SetWarnMixedProjects(warn bool) error {
+	cm.mu.Lock()
+	defer cm.mu.Unlock()
+	cm.config.WarnMixedProjects = warn
+	return cm.save()
+}
+
+// SweepProgress returns whether the workspace status bar shows how many
+// listed runs are done.
+func (cm *ConfigManager) SweepProgress() bool {
+	cm.mu.RLock()
+	defer cm.mu.RUnlock()
+	return cm.config.SweepProgress
+}
+
+// SetSweepProgress sets whether the workspace status bar shows how many
+// listed runs are done.
+func (cm *ConfigManager) SetSweepProgress(show bool) error {
+	cm.mu.Lock()
+	defer cm.mu.Unlock()
+	cm.config.SweepProgress = show
+	return cm.save()
+}
+
+// OverviewValueFormat returns how numeric run overview values are rendered.
+func (cm *ConfigManager) OverviewValueFormat() ValueFormat {
+	cm.mu.RLock()
+	defer cm.mu.RUnlock()
+	return ValueFormat{
+		Precision:   cm.config.OverviewValuePrecision,
+		SciExponent: cm.config.OverviewSciExponent,
+	}
+}
+
+// SetOverviewValuePrecision sets the significant digits for overview floats.
+func (cm *ConfigManager) SetOverviewValuePrecision(digits int) error {
+	if digits < 1 || digits > maxOverviewValuePrecision {
+		return fmt.Errorf("precision must be between 1 and %d, got %d",
+			maxOverviewValuePrecision, digits)
+	}
+	cm.mu.Lock()
+	defer cm.mu.Unlock()
+	cm.config.OverviewValuePrecision = digits
+	return cm.save()
+}
+
+// SetOverviewSciExponent sets the exponent magnitude at which overview
+// floats switch to scientific notation.
+func (cm *ConfigManager) SetOverviewSciExponent(exp int) error {
+	if exp < 1 || exp > maxOverviewSciExponent {
+		return fmt.Errorf("sci-notation exponent must be between 1 and %d, got %d",
+			maxOverviewSciExponent, exp)
+	}
+	cm.mu.Lock()
+	defer cm.mu.Unlock()
+	cm.config.OverviewSciExponent = exp
+	return cm.save()
+}
+
+// MetricAliases returns a copy of the metric rename/merge map.
+func (cm *ConfigManager) MetricAliases() map[string]string {
+	cm.mu.RLock()
+	defer cm.mu.RUnlock()
+	return maps.Clone(cm.config.MetricAliases)
+}
+
+// ResolveMetricName returns the canonical chart key for a logged metric key.
+//
+// Keys without an alias are returned unchanged. Aliases are resolved
+// in a single hop so that a misconfigured cycle can't loop forever.
+func (cm *ConfigManager) ResolveMetricName(name string) string {
+	cm.mu.RLock()
+	defer cm.mu.RUnlock()
+	if to, ok := cm.config.MetricAliases[name]; ok {
+		return to
+	}
+	return name
+}
+
+// SetMetricAlias charts metric key from under the canonical key to.
+//
+// An empty to removes the alias for from.
+func (cm *ConfigManager) SetMetricAlias(from, to string) error {
+	if from == "" {
+		return fmt.Errorf("metric alias source key must not be empty")
+	}
+	if from == to {
+		return fmt.Errorf("metric %q cannot be aliased to itself", from)
+	}
+
+	cm.mu.Lock()
+	defer cm.mu.Unlock()
+
+	aliases := maps.Clone(cm.config.MetricAliases)
+	if to == "" {
+		delete(aliases, from)
+	} else {
+		if aliases == nil {
+			aliases = make(map[string]string)
+		}
+		aliases[from] = to
+	}
+	cm.config.MetricAliases = aliases
+	return cm.save()
+}
+
+// MetricBlocklist returns a copy of the metric blocklist patterns.
+func (cm *ConfigManager) MetricBlocklist() []string {
+	cm.mu.RLock()
+	defer cm.mu.RUnlock()
+	return slices.Clone(cm.config.MetricBlocklist)
+}
+
+// SetMetricBlocklist replaces the metric blocklist patterns.
+func (cm *ConfigManager) SetMetricBlocklist(patterns []string) error {
+	for _, p := range patterns {
+		if p == "" {
+			return fmt.Errorf("metric blocklist pattern must not be empty")
+		}
+		if _, err := path.Match(p, ""); err != nil {
+			return fmt.Errorf("invalid metric blocklist pattern %q: %v", p, err)
+		}
+	}
+
+	cm.mu.Lock()
+	defer cm.mu.Unlock()
+	cm.config.MetricBlocklist = slices.Clone(patterns)
+	return cm.save()
+}
+
+// MetricUnit returns the configured unit for a canonical metric name, or "".
+func (cm *ConfigManager) MetricUnit(name string) string {
+	cm.mu.RLock()
+	defer cm.mu.RUnlock()
+	return cm.config.MetricUnits[name]
+}
+
+// SetMetricUnit sets the unit of a canonical metric name's logged values.
+//
+// An empty unit removes the override.
+func (cm *ConfigManager) SetMetricUnit(name, unit string) error {
+	if name == "" {
+		return fmt.Errorf("metric unit name must not be empty")
+	}
+	if _, ok := ByteUnitFormatter(unit); unit != "" && !ok {
+		return fmt.Errorf("unknown metric unit %q", unit)
+	}
+
+	cm.mu.Lock()
+	defer cm.mu.Unlock()
+
+	units := maps.Clone(cm.config.MetricUnits)
+	if unit == "" {
+		delete(units, name)
+	} else {
+		if units == nil {
+			units = make(map[string]string)
+		}
+		units[name] = unit
+	}
+	cm.config.MetricUnits = units
+	return cm.save()
+}
+
+// MetricTarget returns the goal value of a canonical metric name.
+func (cm *ConfigManager) MetricTarget(name string) (float64, bool) {
+	cm.mu.RLock()
+	defer cm.mu.RUnlock()
+	target, ok := cm.config.MetricTargets[name]
+	return target, ok
+}
+
+// SetMetricTarget sets the goal value of a canonical metric name.
+//
+// A non-finite target removes the goal.
+func (cm *ConfigManager) SetMetricTarget(name string, target float64) error {
+	if name == "" {
+		return fmt.Errorf("metric target name must not be empty")
+	}
+
+	cm.mu.Lock()
+	defer cm.mu.Unlock()
+
+	targets := maps.Clone(cm.config.MetricTargets)
+	if !isFinite(target) {
+		delete(targets, name)
+	} else {
+		if targets == nil {
+			targets = make(map[string]float64)
+		}
+		targets[name] = target
+	}
+	cm.config.MetricTargets = targets
+	return cm.save()
+}
+
+// MetricInverted reports whether a canonical metric name's chart has
+// an inverted Y axis.
+func (cm *ConfigManager) MetricInverted(name string) bool {
+	cm.mu.RLock()
+	defer cm.mu.RUnlock()
+	return slices.Contains(cm.config.InvertedMetrics, name)
+}
+
+// SetMetricInverted sets whether a canonical metric name's chart has an
+// inverted Y axis.
+func (cm *ConfigManager) SetMetricInverted(name string, inverted bool) error {
+	if name == "" {
+		return fmt.Errorf("inverted metric name must not be empty")
+	}
+
+	cm.mu.Lock()
+	defer cm.mu.Unlock()
+
+	names := slices.DeleteFunc(slices.Clone(cm.config.InvertedMetrics),
+		func(n string) bool { return n == name })
+	if inverted {
+		names = append(names, name)
+	}
+	cm.config.InvertedMetrics = names
+	return cm.save()
+}
+
+// SecondaryAxis returns the metric overlaid on the right-hand Y axis of
+// a canonical metric name's chart, or "".
+func (cm *ConfigManager) SecondaryAxis(name string) string {
+	cm.mu.RLock()
+	defer cm.mu.RUnlock()
+	return cm.config.SecondaryAxes[name]
+}
+
+// SetSecondaryAxis overlays metric secondary on the right-hand Y axis of
+// a canonical metric name's chart.
+//
+// An empty secondary removes the overlay.
+func (cm *ConfigManager) SetSecondaryAxis(name, secondary string) error {
+	if name == "" {
+		return fmt.Errorf("secondary axis metric name must not be empty")
+	}
+	if secondary == name {
+		return fmt.Errorf("metric %q cannot be its own secondary axis", name)
+	}
+
+	cm.mu.Lock()
+	defer cm.mu.Unlock()
+
+	axes := maps.Clone(cm.config.SecondaryAxes)
+	if secondary == "" {
+		delete(axes, name)
+	} else {
+		if axes == nil {
+			axes = make(map[string]string)
+		}
+		axes[name] = secondary
+	}
+	cm.config.SecondaryAxes = axes
+	return cm.save()
+}
+
+// SummaryStripKeys returns a copy of the metric keys shown in the
+// workspace summary strip.
+func (cm *ConfigManager) SummaryStripKeys() []string {
+	cm.mu.RLock()
+	defer cm.mu.RUnlock()
+	return slices.Clone(cm.config.SummaryStripKeys)
+}
+
+// SetSummaryStripKeys replaces the metric keys shown in the workspace
+// summary strip.
+func (cm *ConfigManager) SetSummaryStripKeys(keys []string) error {
+	if slices.Contains(keys, "") {
+		return fmt.Errorf("summary strip key must not be empty")
+	}
+
+	cm.mu.Lock()
+	defer cm.mu.Unlock()
+	cm.config.SummaryStripKeys = slices.Clone(keys)
+	return cm.save()
+}
+
+// MetricsFilterPresets returns a copy of the saved metrics filter queries.
+func (cm *ConfigManager) MetricsFilterPresets() []string {
+	cm.mu.RLock()
+	defer cm.mu.RUnlock()
+	return slices.Clone(cm.config.MetricsFilterPresets)
+}
+
+// SetMetricsFilterPresets replaces the saved metrics filter queries.
+func (cm *ConfigManager) SetMetricsFilterPresets(presets []string) error {
+	if slices.Contains(presets, "") {
+		return fmt.Errorf("metrics filter preset must not be empty")
+	}
+
+	cm.mu.Lock()
+	defer cm.mu.Unlock()
+	cm.config.MetricsFilterPresets = slices.Clone(presets)
+	return cm.save()
+}
+
+// metricRules is a read-only snapshot of the per-metric settings consulted
+// for every key of every history record.
+//
+// Taking it once per record keeps the config lock off the per-key path.
+// The maps and slices are shared with the config, which replaces rather
+// than mutates them, so they must not be modified.
+type metricRules struct {
+	aliases            map[string]string
+	blocklist          []string
+	units              map[string]string
+	targets            map[string]float64
+	inverted           []string
+	secondaryAxes      map[string]string
+	objectiveMetric    string
+	objectiveDirection string
+
+	// bandMinSuffix and bandMaxSuffix name the companion keys of logged
+	// bands; both are "" when logged bands are off.
+	bandMinSuffix string
+	bandMaxSuffix string
+}
+
+// metricRules returns a snapshot of the per-metric settings.
+func (cm *ConfigManager) metricRules() metricRules {
+	cm.mu.RLock()
+	defer cm.mu.RUnlock()
+	rules := metricRules{
+		aliases:            cm.config.MetricAliases,
+		blocklist:          cm.config.MetricBlocklist,
+		units:              cm.config.MetricUnits,
+		targets:            cm.config.MetricTargets,
+		inverted:           cm.config.InvertedMetrics,
+		secondaryAxes:      cm.config.SecondaryAxes,
+		objectiveMetric:    cm.config.ObjectiveMetric,
+		objectiveDirection: cm.config.ObjectiveDirection,
+	}
+	if cm.config.ShowLoggedBands {
+		rules.bandMinSuffix = cm.config.LoggedBandMinSuffix
+		rules.bandMaxSuffix = cm.config.LoggedBandMaxSuffix
+	}
+	return rules
+}
+
+// resolve returns the canonical chart key for a logged metric key.
+func (r *metricRules) resolve(key string) string {
+	if to, ok := r.aliases[key]; ok {
+		return to
+	}
+	return key
+}
+
+// blocked reports whether a logged key or its canonical name matches
+// the blocklist.
+func (r *metricRules) blocked(key, name string) bool {
+	for _, p := range r.blocklist {
+		if ok, _ := path.Match(p, key); ok {
+			return true
+		}
+		if name != key {
+			if ok, _ := path.Match(p, name); ok {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// objectiveFor returns the objective direction if name is the
+// objective metric, or "".
+func (r *metricRules) objectiveFor(name string) string {
+	if r.objectiveMetric == "" || r.objectiveMetric != name {
+		return ""
+	}
+	return r.objectiveDirection
+}
+
+// targetFor returns the goal value of metric name, if one is set.
+func (r *metricRules) targetFor(name string) (float64, bool) {
+	target, ok := r.targets[name]
+	return target, ok
+}
+
+// secondaryFor returns the metric overlaid on the right-hand Y axis of
+// metric name's chart, or "".
+func (r *metricRules) secondaryFor(name string) string {
+	return r.secondaryAxes[name]
+}
+
+// invertedFor reports whether metric name's chart has an inverted Y axis.
+func (r *metricRules) invertedFor(name string) bool {
+	return slices.Contains(r.inverted, name)
+}
+
+// OverviewSectionCollapsed reports whether the named run overview
+// section is collapsed.
+func (cm *ConfigManager) OverviewSectionCollapsed(name string) bool {
+	cm.mu.RLock()
+	defer cm.mu.RUnlock()
+	return cm.config.CollapsedOverviewSections[name]
+}
+
+// SetOverviewSectionCollapsed collapses or expands the named run overview
+// section.
+func (cm *ConfigManager) SetOverviewSectionCollapsed(name string, collapsed bool) error {
+	cm.mu.Lock()
+	defer cm.mu.Unlock()
+	if !collapsed {
+		delete(cm.config.CollapsedOverviewSections, name)
+		return cm.save()
+	}
+	if cm.config.CollapsedOverviewSections == nil {
+		cm.config.CollapsedOverviewSections = make(map[string]bool)
+	}
+	cm.config.CollapsedOverviewSections[name] = true
+	return cm.save()
+}
+
+// WorkspaceSelection returns the runs selected and the run pinned in the
+// workspace for wandbDir when it was last saved.
+func (cm *ConfigManager) WorkspaceSelection(wandbDir string) (runKeys []string, pinned string) {
+	cm.mu.RLock()
+	defer cm.mu.RUnlock()
+	sel := cm.config.WorkspaceSelections[workspaceSelectionKey(wandbDir)]
+	return slices.Clone(sel.Runs), sel.Pinned
+}
+
+// SetWorkspaceSelection saves the runs selected and the run pinned in the
+// workspace for wandbDir.
+//
+// An empty runKeys forgets the directory's selection.
+func (cm *ConfigManager) SetWorkspaceSelection(
+	wandbDir string,
+	runKeys []string,
+	pinned string,
+) error {
+	if wandbDir == "" {
+		return fmt.Errorf("workspace selection directory must not be empty")
+	}
+	if pinned != "" && !slices.Contains(runKeys, pinned) {
+		return fmt.Errorf("pinned run %q is not selected", pinned)
+	}
+
+	cm.mu.Lock()
+	defer cm.mu.Unlock()
+
+	key := workspaceSelectionKey(wandbDir)
+	selections := maps.Clone(cm.config.WorkspaceSelections)
+	if len(runKeys) == 0 {
+		delete(selections, key)
+	} else {
+		if selections == nil {
+			selections = make(map[string]WorkspaceSelection)
+		}
+		selections[key] = WorkspaceSelection{
+			Runs:   slices.Clone(runKeys),
+			Pinned: pinned,
+		}
+	}
+	cm.config.WorkspaceSelections = selections
+	return cm.save()
+}
+
+// workspaceSelectionKey returns the WorkspaceSelections key of wandbDir,
+// so that relative and absolute paths to a directory share a selection.
+func workspaceSelectionKey(wandbDir string) string {
+	if abs, err := filepath.Abs(wandbDir); err == nil {
+		return abs
+	}
+	return filepath.Clean(wandbDir)
+}
+
 // leetConfigPath returns the path where the config should be stored.
 //
 // Matches the Python logic (same directory as the system "settings" file),
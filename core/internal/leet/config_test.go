@@ -1,6 +1,7 @@
 package leet_test
 
 import (
+	"os"
 	"path/filepath"
 	"testing"
 
@@ -115,6 +116,34 @@ func TestConfig_SetSymonGrid_Persists(t *testing.T) {
 	require.Equal(t, 2, cols)
 }
 
+func TestConfig_SystemGrids_AreIndependentPerView(t *testing.T) {
+	logger := observability.NewNoOpLogger()
+	path := filepath.Join(t.TempDir(), "config.json")
+	cfg := leet.NewConfigManager(path, logger)
+	runRows, runCols := cfg.SystemGrid()
+
+	require.NoError(t, cfg.SetWorkspaceSystemRows(4))
+	require.NoError(t, cfg.SetWorkspaceSystemCols(1))
+
+	rows, cols := cfg.SystemGrid()
+	require.Equal(t, runRows, rows, "workspace rows leaked into the run view")
+	require.Equal(t, runCols, cols, "workspace cols leaked into the run view")
+
+	require.NoError(t, cfg.SetSystemRows(2))
+	require.NoError(t, cfg.SetSystemCols(3))
+
+	cfg2 := leet.NewConfigManager(path, logger)
+	rows, cols = cfg2.WorkspaceSystemGrid()
+	require.Equal(t, 4, rows)
+	require.Equal(t, 1, cols)
+	rows, cols = cfg2.SystemGrid()
+	require.Equal(t, 2, rows)
+	require.Equal(t, 3, cols)
+	rows, cols = cfg2.SymonGrid()
+	require.Equal(t, leet.DefaultSymonGridRows, rows)
+	require.Equal(t, leet.DefaultSymonGridCols, cols)
+}
+
 func TestConfig_SetFrenchFriesColorScheme_Persists(t *testing.T) {
 	logger := observability.NewNoOpLogger()
 	path := filepath.Join(t.TempDir(), "config.json")
@@ -130,3 +159,29 @@ func TestConfig_SetFrenchFriesColorScheme_Persists(t *testing.T) {
 	cfg2 := leet.NewConfigManager(path, logger)
 	require.Equal(t, "cividis", cfg2.Snapshot().FrenchFriesColorScheme)
 }
+
+func TestConfigManager_StaleRunColor_RejectsInvalidColors(t *testing.T) {
+	cfg := leet.NewConfigManager(filepath.Join(t.TempDir(), "config.json"), observability.NewNoOpLogger())
+	require.Equal(t, leet.DefaultStaleRunColor, cfg.StaleRunColor())
+
+	require.NoError(t, cfg.SetStaleRunColor("#00ff00"))
+	require.NoError(t, cfg.SetStaleRunColor("208"))
+	require.Equal(t, "208", cfg.StaleRunColor())
+
+	require.Error(t, cfg.SetStaleRunColor("orange"))
+	require.Error(t, cfg.SetStaleRunColor("256"))
+	require.Error(t, cfg.SetStaleRunColor("#12345"))
+	require.Equal(t, "208", cfg.StaleRunColor())
+}
+
+func TestConfigManager_OverviewSciExponent_ClampedToRange(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.json")
+	require.NoError(t, os.WriteFile(path, []byte(`{"overview_sci_exponent": 99}`), 0o644))
+	cfg := leet.NewConfigManager(path, observability.NewNoOpLogger())
+	require.Equal(t, 20, cfg.OverviewValueFormat().SciExponent)
+
+	require.NoError(t, cfg.SetOverviewSciExponent(8))
+	require.Error(t, cfg.SetOverviewSciExponent(0))
+	require.Error(t, cfg.SetOverviewSciExponent(21))
+	require.Equal(t, 8, cfg.OverviewValueFormat().SciExponent)
+}
@@ -2,6 +2,7 @@ package leet
 
 import (
 	"fmt"
+	"reflect"
 	"sort"
 	"strconv"
 	"strings"
@@ -182,10 +183,16 @@ const (
 type enumProvider int
 
 const (
-	enumProviderUndefined    enumProvider = iota
-	enumProviderColorSchemes              // color palette names
-	enumProviderColorModes                // per_series | per_plot
-	enumProviderStartupModes              // workspace_latest | single_run_latest
+	enumProviderUndefined           enumProvider = iota
+	enumProviderColorSchemes                     // color palette names
+	enumProviderColorModes                       // per_series | per_plot
+	enumProviderStartupModes                     // workspace_latest | single_run_latest
+	enumProviderMetricsSortOrders                // alphabetical | logged
+	enumProviderXAxisModes                       // step | epoch
+	enumProviderColorProfiles                    // auto | truecolor | ansi256 | ansi
+	enumProviderRunListEnterActions              // view | select
+	enumProviderConsoleLogsStarts                // tail | top
+	enumProviderRunListOrders                    // recent | name | live_first
 )
 
 // options returns the allowed values for this provider.
@@ -200,6 +207,18 @@ func (p enumProvider) options() []string {
 		return []string{ColorModePerSeries, ColorModePerPlot}
 	case enumProviderStartupModes:
 		return []string{StartupModeWorkspaceLatest, StartupModeSingleRunLatest}
+	case enumProviderMetricsSortOrders:
+		return []string{MetricsSortAlphabetical, MetricsSortLogged}
+	case enumProviderXAxisModes:
+		return []string{XAxisStep, XAxisEpoch}
+	case enumProviderColorProfiles:
+		return colorProfiles()
+	case enumProviderRunListEnterActions:
+		return []string{RunListEnterView, RunListEnterSelect}
+	case enumProviderConsoleLogsStarts:
+		return []string{ConsoleLogsStartTail, ConsoleLogsStartTop}
+	case enumProviderRunListOrders:
+		return runListOrders()
 	default:
 		return nil
 	}
@@ -290,7 +309,7 @@ func (m *ConfigEditor) Init() tea.Cmd { return nil }
 
 // dirty reports whether the draft diverges from the on-disk snapshot.
 func (m *ConfigEditor) dirty() bool {
-	return m.draft != m.original
+	return !reflect.DeepEqual(m.draft, m.original)
 }
 
 // Update implements [tea.Model].
@@ -136,6 +136,18 @@ func parseEnumProvider(s string) enumProvider {
 		return enumProviderColorModes
 	case "startupModes":
 		return enumProviderStartupModes
+	case "metricsSortOrders":
+		return enumProviderMetricsSortOrders
+	case "xAxisModes":
+		return enumProviderXAxisModes
+	case "colorProfiles":
+		return enumProviderColorProfiles
+	case "runListEnterActions":
+		return enumProviderRunListEnterActions
+	case "consoleLogsStarts":
+		return enumProviderConsoleLogsStarts
+	case "runListOrders":
+		return enumProviderRunListOrders
 	default:
 		return enumProviderUndefined
 	}
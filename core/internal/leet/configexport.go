@@ -0,0 +1,347 @@
+package leet
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"math"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+
+	tea "charm.land/bubbletea/v2"
+	"gopkg.in/yaml.v3"
+)
+
+// ConfigExportFormat selects the serialization used to export a run config.
+type ConfigExportFormat int
+
+const (
+	ConfigExportPython ConfigExportFormat = iota
+	ConfigExportYAML
+)
+
+// Ext returns the file extension for exports in this format.
+func (f ConfigExportFormat) Ext() string {
+	if f == ConfigExportYAML {
+		return "yaml"
+	}
+	return "py"
+}
+
+func (f ConfigExportFormat) String() string {
+	if f == ConfigExportYAML {
+		return "YAML"
+	}
+	return "Python dict"
+}
+
+// ExportConfig serializes the run config tree for reproducing the run.
+//
+// The internal "_wandb" key is omitted: it is written by the SDK and is
+// not something a user passes to wandb.init.
+func (ro *RunOverview) ExportConfig(format ConfigExportFormat) (string, error) {
+	tree := map[string]any{}
+	if ro.runConfig != nil {
+		tree = ro.runConfig.CloneTree()
+	}
+	delete(tree, "_wandb")
+
+	switch format {
+	case ConfigExportPython:
+		return formatPythonDict(tree), nil
+	case ConfigExportYAML:
+		if len(tree) == 0 {
+			return "{}\n", nil
+		}
+		data, err := yaml.Marshal(tree)
+		if err != nil {
+			return "", err
+		}
+		return string(data), nil
+	default:
+		return "", fmt.Errorf("unsupported config export format: %v", format)
+	}
+}
+
+// formatPythonDict renders a config tree as a Python dict literal.
+//
+// Keys are sorted for deterministic output and the result can be
+// evaluated with ast.literal_eval.
+func formatPythonDict(tree map[string]any) string {
+	var b strings.Builder
+	writePythonValue(&b, tree, 0)
+	b.WriteByte('\n')
+	return b.String()
+}
+
+func writePythonValue(b *strings.Builder, v any, depth int) {
+	pad := strings.Repeat("    ", depth+1)
+	closePad := strings.Repeat("    ", depth)
+
+	switch val := v.(type) {
+	case nil:
+		b.WriteString("None")
+	case bool:
+		if val {
+			b.WriteString("True")
+		} else {
+			b.WriteString("False")
+		}
+	case string:
+		b.WriteString(pythonString(val))
+	case float64:
+		b.WriteString(pythonFloat(val))
+	case float32:
+		b.WriteString(pythonFloat(float64(val)))
+	case int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64:
+		fmt.Fprint(b, val)
+	case map[string]any:
+		if len(val) == 0 {
+			b.WriteString("{}")
+			return
+		}
+		keys := make([]string, 0, len(val))
+		for k := range val {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+
+		b.WriteString("{\n")
+		for _, k := range keys {
+			b.WriteString(pad)
+			b.WriteString(pythonString(k))
+			b.WriteString(": ")
+			writePythonValue(b, val[k], depth+1)
+			b.WriteString(",\n")
+		}
+		b.WriteString(closePad)
+		b.WriteByte('}')
+	case []any:
+		if len(val) == 0 {
+			b.WriteString("[]")
+			return
+		}
+		b.WriteString("[\n")
+		for _, elem := range val {
+			b.WriteString(pad)
+			writePythonValue(b, elem, depth+1)
+			b.WriteString(",\n")
+		}
+		b.WriteString(closePad)
+		b.WriteByte(']')
+	default:
+		// Unknown leaf types are exported by their string form.
+		b.WriteString(pythonString(fmt.Sprint(val)))
+	}
+}
+
+// pythonString renders s as a double-quoted Python string literal.
+//
+// Only the escapes Python and Go agree on are used: non-ASCII printable
+// runes are kept as is, and other control runes use \x, \u or \U forms.
+func pythonString(s string) string {
+	var b strings.Builder
+	b.WriteByte('"')
+	for _, r := range s {
+		switch r {
+		case '"':
+			b.WriteString(`\"`)
+		case '\\':
+			b.WriteString(`\\`)
+		case '\n':
+			b.WriteString(`\n`)
+		case '\r':
+			b.WriteString(`\r`)
+		case '\t':
+			b.WriteString(`\t`)
+		default:
+			switch {
+			case unicode.IsPrint(r):
+				b.WriteRune(r)
+			case r < 0x100:
+				fmt.Fprintf(&b, `\x%02x`, r)
+			case r < 0x10000:
+				fmt.Fprintf(&b, `\u%04x`, r)
+			default:
+				fmt.Fprintf(&b, `\U%08x`, r)
+			}
+		}
+	}
+	b.WriteByte('"')
+	return b.String()
+}
+
+// pythonFloat formats f so that Python reads it back as a float.
+func pythonFloat(f float64) string {
+	switch {
+	case math.IsNaN(f):
+		return `float("nan")`
+	case math.IsInf(f, 1):
+		return `float("inf")`
+	case math.IsInf(f, -1):
+		return `float("-inf")`
+	}
+	s := strconv.FormatFloat(f, 'g', -1, 64)
+	if !strings.ContainsAny(s, ".e") {
+		s += ".0"
+	}
+	return s
+}
+
+// configExportStage tracks progress through the export submenu.
+type configExportStage int
+
+const (
+	configExportIdle configExportStage = iota
+	configExportPickFormat
+	configExportPickTarget
+)
+
+// configExportMenu is the two-step status bar submenu for exporting
+// a run config: first the format, then clipboard or file.
+type configExportMenu struct {
+	stage  configExportStage
+	format ConfigExportFormat
+
+	// result is the outcome of the last export, shown in the status bar
+	// until the next key press.
+	result string
+}
+
+// Open starts the submenu at the format choice.
+func (m *configExportMenu) Open() {
+	m.stage = configExportPickFormat
+	m.result = ""
+}
+
+// IsActive reports whether the submenu is capturing keys.
+func (m *configExportMenu) IsActive() bool {
+	return m.stage != configExportIdle
+}
+
+// Status returns the prompt for the current stage.
+func (m *configExportMenu) Status() string {
+	switch m.stage {
+	case configExportPickFormat:
+		return "Export config as: [p] Python dict • [y] YAML (Esc to cancel)"
+	case configExportPickTarget:
+		return fmt.Sprintf(
+			"Export %s to: [c] clipboard • [f] file (Esc to cancel)", m.format)
+	default:
+		return ""
+	}
+}
+
+// HandleKey advances the submenu.
+//
+// When the user completes both choices, it runs export against ro and
+// returns the resulting command (if any). File exports are written to
+// dir, the run's directory, or to the working directory if dir is "".
+func (m *configExportMenu) HandleKey(
+	msg tea.KeyPressMsg,
+	ro *RunOverview,
+	runID string,
+	dir string,
+) tea.Cmd {
+	if msg.Code == tea.KeyEsc {
+		m.stage = configExportIdle
+		return nil
+	}
+
+	switch m.stage {
+	case configExportPickFormat:
+		switch msg.String() {
+		case "p":
+			m.format = ConfigExportPython
+			m.stage = configExportPickTarget
+		case "y":
+			m.format = ConfigExportYAML
+			m.stage = configExportPickTarget
+		}
+	case configExportPickTarget:
+		switch msg.String() {
+		case "c":
+			m.stage = configExportIdle
+			return m.exportToClipboard(ro)
+		case "f":
+			m.stage = configExportIdle
+			m.exportToFile(ro, runID, dir)
+		}
+	}
+	return nil
+}
+
+func (m *configExportMenu) exportToClipboard(ro *RunOverview) tea.Cmd {
+	if ro == nil {
+		m.result = "Export failed: no run selected"
+		return nil
+	}
+	out, err := ro.ExportConfig(m.format)
+	if err != nil {
+		m.result = fmt.Sprintf("Export failed: %v", err)
+		return nil
+	}
+	m.result = fmt.Sprintf("Copied config as %s to clipboard", m.format)
+	return tea.SetClipboard(out)
+}
+
+func (m *configExportMenu) exportToFile(ro *RunOverview, runID, dir string) {
+	if ro == nil {
+		m.result = "Export failed: no run selected"
+		return
+	}
+	out, err := ro.ExportConfig(m.format)
+	if err != nil {
+		m.result = fmt.Sprintf("Export failed: %v", err)
+		return
+	}
+	if runID == "" {
+		runID = "run"
+	}
+	if dir == "" {
+		dir = "."
+	}
+	path, err := writeNewFile(
+		dir, fmt.Sprintf("config-%s", runID), m.format.Ext(), []byte(out))
+	if err != nil {
+		m.result = fmt.Sprintf("Export failed: %v", err)
+		return
+	}
+	if abs, err := filepath.Abs(path); err == nil {
+		path = abs
+	}
+	m.result = fmt.Sprintf("Wrote config as %s to %s", m.format, path)
+}
+
+// maxExportSuffix bounds the "-N" suffixes tried to avoid overwriting.
+const maxExportSuffix = 100
+
+// writeNewFile writes data to dir/base.ext, or to dir/base-N.ext if that
+// exists, and returns the path written. Existing files are never replaced.
+func writeNewFile(dir, base, ext string, data []byte) (string, error) {
+	for n := range maxExportSuffix {
+		name := fmt.Sprintf("%s.%s", base, ext)
+		if n > 0 {
+			name = fmt.Sprintf("%s-%d.%s", base, n, ext)
+		}
+		path := filepath.Join(dir, name)
+
+		f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o644)
+		if errors.Is(err, fs.ErrExist) {
+			continue
+		}
+		if err != nil {
+			return "", err
+		}
+		_, err = f.Write(data)
+		if closeErr := f.Close(); err == nil {
+			err = closeErr
+		}
+		return path, err
+	}
+	return "", fmt.Errorf("too many existing %s-*.%s files in %s", base, ext, dir)
+}
@@ -0,0 +1,144 @@
+package leet_test
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	tea "charm.land/bubbletea/v2"
+
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+
+	"github.com/wandb/wandb/core/internal/leet"
+	"github.com/wandb/wandb/core/internal/observability"
+	spb "github.com/wandb/wandb/core/pkg/service_go_proto"
+)
+
+func newNestedConfigOverview() *leet.RunOverview {
+	ro := leet.NewRunOverview()
+	ro.ProcessRunMsg(leet.RunMsg{
+		ID: "abc123",
+		Config: &spb.ConfigRecord{
+			Update: []*spb.ConfigItem{
+				{NestedKey: []string{"trainer", "lr"}, ValueJson: "0.001"},
+				{NestedKey: []string{"trainer", "epochs"}, ValueJson: "10"},
+				{NestedKey: []string{"model", "layers"}, ValueJson: `[64, {"act": "relu"}]`},
+				{NestedKey: []string{"model", "name"}, ValueJson: `"resnet \"v2\""`},
+				{NestedKey: []string{"debug"}, ValueJson: "false"},
+				{NestedKey: []string{"seed"}, ValueJson: "null"},
+				{NestedKey: []string{"_wandb", "cli_version"}, ValueJson: `"0.20.0"`},
+			},
+		},
+	})
+	return ro
+}
+
+func TestRunOverview_ExportConfig_YAMLRoundTrips(t *testing.T) {
+	ro := newNestedConfigOverview()
+
+	out, err := ro.ExportConfig(leet.ConfigExportYAML)
+	require.NoError(t, err)
+
+	var parsed map[string]any
+	require.NoError(t, yaml.Unmarshal([]byte(out), &parsed))
+
+	require.NotContains(t, parsed, "_wandb")
+	require.Equal(t, false, parsed["debug"])
+	require.Nil(t, parsed["seed"])
+
+	trainer := parsed["trainer"].(map[string]any)
+	require.Equal(t, 0.001, trainer["lr"])
+	require.Equal(t, 10, trainer["epochs"])
+
+	model := parsed["model"].(map[string]any)
+	require.Equal(t, `resnet "v2"`, model["name"])
+	require.Equal(t, []any{64, map[string]any{"act": "relu"}}, model["layers"])
+}
+
+func TestRunOverview_ExportConfig_PythonDict(t *testing.T) {
+	ro := newNestedConfigOverview()
+
+	out, err := ro.ExportConfig(leet.ConfigExportPython)
+	require.NoError(t, err)
+
+	want := strings.Join([]string{
+		`{`,
+		`    "debug": False,`,
+		`    "model": {`,
+		`        "layers": [`,
+		`            64,`,
+		`            {`,
+		`                "act": "relu",`,
+		`            },`,
+		`        ],`,
+		`        "name": "resnet \"v2\"",`,
+		`    },`,
+		`    "seed": None,`,
+		`    "trainer": {`,
+		`        "epochs": 10,`,
+		`        "lr": 0.001,`,
+		`    },`,
+		`}`,
+		``,
+	}, "\n")
+	require.Equal(t, want, out)
+}
+
+func TestRunOverview_ExportConfig_PythonStringEscapes(t *testing.T) {
+	ro := leet.NewRunOverview()
+	ro.ProcessRunMsg(leet.RunMsg{
+		Config: &spb.ConfigRecord{
+			Update: []*spb.ConfigItem{
+				{NestedKey: []string{"s"}, ValueJson: `"tab\there\u0007 é ✓"`},
+			},
+		},
+	})
+
+	out, err := ro.ExportConfig(leet.ConfigExportPython)
+	require.NoError(t, err)
+
+	// Control runes use \x escapes, which Python reads the same way;
+	// printable non-ASCII runes are kept as is.
+	require.Equal(t, "{\n    \"s\": \"tab\\there\\x07 é ✓\",\n}\n", out)
+}
+
+func TestRun_ConfigExportToFile_WritesUnderRunDirWithoutOverwriting(t *testing.T) {
+	logger := observability.NewNoOpLogger()
+	cfg := leet.NewConfigManager(filepath.Join(t.TempDir(), "config.json"), logger)
+	runDir := t.TempDir()
+	run := leet.NewRun(&leet.RunParams{
+		RunFile: filepath.Join(runDir, "run-abc123.wandb"),
+	}, cfg, logger)
+	run.TestHandleRecordMsg(leet.RunMsg{
+		ID: "abc123",
+		Config: &spb.ConfigRecord{
+			Update: []*spb.ConfigItem{
+				{NestedKey: []string{"lr"}, ValueJson: "0.001"},
+			},
+		},
+	})
+
+	export := func() {
+		for _, key := range []string{"x", "y", "f"} {
+			run.Update(tea.KeyPressMsg{Code: rune(key[0]), Text: key})
+		}
+		require.False(t, run.IsExportingConfig())
+	}
+
+	export()
+	data, err := os.ReadFile(filepath.Join(runDir, "config-abc123.yaml"))
+	require.NoError(t, err)
+	require.Equal(t, "lr: 0.001\n", string(data))
+
+	// A second export must not replace the first file.
+	require.NoError(t, os.WriteFile(
+		filepath.Join(runDir, "config-abc123.yaml"), []byte("edited\n"), 0o644))
+	export()
+	data, err = os.ReadFile(filepath.Join(runDir, "config-abc123.yaml"))
+	require.NoError(t, err)
+	require.Equal(t, "edited\n", string(data))
+	_, err = os.Stat(filepath.Join(runDir, "config-abc123-1.yaml"))
+	require.NoError(t, err)
+}
@@ -5,6 +5,7 @@ import (
 	"strings"
 	"time"
 
+	tea "charm.land/bubbletea/v2"
 	"charm.land/lipgloss/v2"
 
 	"github.com/mattn/go-runewidth"
@@ -20,6 +21,13 @@ const (
 	// ConsoleLogsPaneMinHeight is the minimum total height for the bottom bar.
 	ConsoleLogsPaneMinHeight = consoleLogsPaddingLines + consoleLogsHeaderLines + 1
 
+	// ConsoleLogsPaneMaxHeightRatio caps a user-set bottom bar height as a
+	// fraction of the main content height, leaving the rest to the charts.
+	ConsoleLogsPaneMaxHeightRatio = 1 - LowerTierRatio
+
+	// consoleLogsResizeStep is how many rows +/- grow or shrink the bar.
+	consoleLogsResizeStep = 2
+
 	consoleLogsPaneHeader   = "Console Logs"
 	consoleLogsPaddingLines = 1
 	consoleLogsHeaderLines  = 1
@@ -75,6 +83,18 @@ type ConsoleLogsPane struct {
 	active     bool
 	autoScroll bool
 
+	// followTail is whether a newly shown run's logs open at the tail
+	// with auto-scroll on, rather than at the first line.
+	followTail bool
+
+	// hideTimestamps drops the timestamp column, giving its width to the
+	// log text.
+	hideTimestamps bool
+
+	// command is the run's launch command, shown after the run label in
+	// the header when non-empty.
+	command string
+
 	// Cached layout params from the most recent [View] call, used by
 	// navigation methods (PageUp/PageDown) to compute page boundaries
 	// without re-deriving the layout.
@@ -87,9 +107,28 @@ func NewConsoleLogsPane(animState *AnimatedValue) *ConsoleLogsPane {
 	return &ConsoleLogsPane{
 		animState:  animState,
 		autoScroll: true,
+		followTail: true,
 	}
 }
 
+// SetCommand sets the launch command shown in the header; "" hides it.
+func (c *ConsoleLogsPane) SetCommand(command string) { c.command = command }
+
+// SetTimestampsHidden sets whether the timestamp column is hidden.
+func (c *ConsoleLogsPane) SetTimestampsHidden(hidden bool) { c.hideTimestamps = hidden }
+
+// TimestampsHidden reports whether the timestamp column is hidden.
+func (c *ConsoleLogsPane) TimestampsHidden() bool { return c.hideTimestamps }
+
+// ResetScroll positions the pane for a newly shown run's logs: at the
+// tail with auto-scroll on if followTail, else at the first line.
+func (c *ConsoleLogsPane) ResetScroll(followTail bool) {
+	c.followTail = followTail
+	c.cursor = 0
+	c.top = 0
+	c.autoScroll = followTail
+}
+
 // Height returns the current rendered height (may be mid-animation).
 func (c *ConsoleLogsPane) Height() int { return c.animState.Value() }
 
@@ -119,6 +158,9 @@ func (c *ConsoleLogsPane) SetExpandedHeight(h int) {
 	c.animState.SetExpanded(max(h, ConsoleLogsPaneMinHeight))
 }
 
+// ExpandedHeight returns the height of the bottom bar when expanded.
+func (c *ConsoleLogsPane) ExpandedHeight() int { return c.animState.Expanded() }
+
 // UpdateExpandedHeight recalculates the expanded height from the terminal
 // height using [ConsoleLogsPaneHeightRatio].
 func (c *ConsoleLogsPane) UpdateExpandedHeight(maxTerminalHeight int) {
@@ -126,6 +168,28 @@ func (c *ConsoleLogsPane) UpdateExpandedHeight(maxTerminalHeight int) {
 	c.SetExpandedHeight(maxHeight)
 }
 
+// consoleLogsHeight returns the expanded bottom bar height: the configured
+// height clamped to fit maxH rows of main content, or auto if none is set.
+func consoleLogsHeight(configured, auto, maxH int) int {
+	if configured <= 0 {
+		return auto
+	}
+	maxHeight := max(int(float64(maxH)*ConsoleLogsPaneMaxHeightRatio), ConsoleLogsPaneMinHeight)
+	return clamp(configured, ConsoleLogsPaneMinHeight, maxHeight)
+}
+
+// consoleLogsResizeDelta returns the height change requested by msg,
+// if it is a resize key.
+func consoleLogsResizeDelta(msg tea.KeyPressMsg) (int, bool) {
+	switch msg.String() {
+	case "+", "=":
+		return consoleLogsResizeStep, true
+	case "-":
+		return -consoleLogsResizeStep, true
+	}
+	return 0, false
+}
+
 // SetConsoleLogs replaces the displayed log entries and adjusts the
 // viewport. If auto-scroll is enabled, the view snaps to the tail.
 func (c *ConsoleLogsPane) SetConsoleLogs(items []KeyValuePair) {
@@ -134,7 +198,7 @@ func (c *ConsoleLogsPane) SetConsoleLogs(items []KeyValuePair) {
 	if len(c.logs) == 0 {
 		c.cursor = 0
 		c.top = 0
-		c.autoScroll = true
+		c.autoScroll = c.followTail
 		return
 	}
 
@@ -167,6 +231,10 @@ func (c *ConsoleLogsPane) View(width int, runLabel, hint string) string {
 	contentW := max(width-ContentPadding, 0)
 	maxKeyWidth := max(int(float64(contentW)*consoleLogsKeyWidthRatio), 1)
 	maxKeyWidth = min(maxKeyWidth, max(contentW-2, 1))
+	if c.hideTimestamps {
+		// Keep only the timestamp column's left inset.
+		maxKeyWidth = 0
+	}
 	maxValueWidth := max(contentW-maxKeyWidth-1, 1)
 
 	c.lastValueWidth = maxValueWidth
@@ -190,12 +258,19 @@ func (c *ConsoleLogsPane) View(width int, runLabel, hint string) string {
 // HasData reports whether the pane has any log entries to display.
 func (c *ConsoleLogsPane) HasData() bool { return len(c.logs) > 0 }
 
-// renderHeader returns the "Console Logs • <runLabel>     [X-Y of N]" line,
+// renderHeader returns the "Console Logs • <runLabel> • $ <command>     [X-Y of N]" line,
 func (c *ConsoleLogsPane) renderHeader(
 	width int, runLabel string, startIdx, endIdx, total int) string {
 	title := consoleLogsPaneHeaderStyle.Render(consoleLogsPaneHeader)
 	navInfo := navInfoStyle.Render(c.buildNavigationInfo(startIdx, endIdx, total))
 
+	if c.command != "" {
+		if runLabel != "" {
+			runLabel += " • "
+		}
+		runLabel += "$ " + c.command
+	}
+
 	left := title
 	if runLabel != "" {
 		sep := " • "
@@ -291,9 +366,12 @@ func (c *ConsoleLogsPane) renderEntry(
 	var rendered []string
 	for i, v := range lines {
 		k := ""
-		if i == 0 {
+		switch {
+		case maxKeyWidth == 0:
+			// The timestamp column is hidden; the gap below is the inset.
+		case i == 0:
 			k = keyStyle.Width(maxKeyWidth).Render(key)
-		} else {
+		default:
 			k = keyStyle.Width(maxKeyWidth).Render("")
 		}
 
@@ -306,7 +384,10 @@ func (c *ConsoleLogsPane) renderEntry(
 	}
 
 	if len(rendered) == 0 {
-		k := keyStyle.Width(maxKeyWidth).Render(key)
+		k := ""
+		if maxKeyWidth > 0 {
+			k = keyStyle.Width(maxKeyWidth).Render(key)
+		}
 		rendered = []string{k + " " + valueStyle.Width(maxValueWidth).Render("")}
 	}
 
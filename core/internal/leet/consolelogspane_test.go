@@ -2,12 +2,17 @@ package leet_test
 
 import (
 	"fmt"
+	"path/filepath"
+	"strings"
 	"testing"
 	"time"
 
+	tea "charm.land/bubbletea/v2"
 	"github.com/stretchr/testify/require"
 
 	"github.com/wandb/wandb/core/internal/leet"
+	"github.com/wandb/wandb/core/internal/observability"
+	spb "github.com/wandb/wandb/core/pkg/service_go_proto"
 )
 
 func expandConsoleLogsPane(t *testing.T, clp *leet.ConsoleLogsPane, height int) {
@@ -276,3 +281,95 @@ func TestConsoleLogsPane_TimestampAdaptsToAvailableWidth(t *testing.T) {
 		})
 	}
 }
+
+func TestConsoleLogsPane_ResetScroll_StartsAtTopWhenConfigured(t *testing.T) {
+	cfg := leet.NewConfigManager(filepath.Join(t.TempDir(), "config.json"), observability.NewNoOpLogger())
+	require.NoError(t, cfg.SetConsoleLogsStart(leet.ConsoleLogsStartTop))
+	require.Error(t, cfg.SetConsoleLogsStart("middle"))
+
+	clp := leet.NewConsoleLogsPane(leet.NewAnimatedValue(false, leet.ConsoleLogsPaneMinHeight))
+	expandConsoleLogsPane(t, clp, 5) // header + padding + 3 content lines
+
+	clp.ResetScroll(cfg.ConsoleLogsStart() == leet.ConsoleLogsStartTail)
+	clp.SetConsoleLogs(makeLogs(10))
+	out := stripANSI(clp.View(80, "", ""))
+	require.Contains(t, out, "[1-3 of 10]", "should open at the first line")
+	require.Contains(t, out, "log 01")
+
+	// More output arrives: the view stays at the top.
+	clp.SetConsoleLogs(makeLogs(12))
+	out = stripANSI(clp.View(80, "", ""))
+	require.Contains(t, out, "[1-3 of 12]")
+}
+
+func TestConsoleLogsPane_HiddenTimestamps_WidenValueColumn(t *testing.T) {
+	clp := leet.NewConsoleLogsPane(
+		leet.NewAnimatedValue(false, leet.ConsoleLogsPaneMinHeight))
+	expandConsoleLogsPane(t, clp, 5)
+	clp.SetConsoleLogs([]leet.KeyValuePair{
+		{Key: "10:11:12", Value: strings.Repeat("x", 200)},
+	})
+
+	longestRun := func(out string) int {
+		longest := 0
+		for line := range strings.SplitSeq(out, "\n") {
+			longest = max(longest, strings.Count(line, "x"))
+		}
+		return longest
+	}
+
+	withTimestamps := stripANSI(clp.View(80, "", ""))
+	require.Contains(t, withTimestamps, "10:11:12")
+
+	clp.SetTimestampsHidden(true)
+	withoutTimestamps := stripANSI(clp.View(80, "", ""))
+	require.NotContains(t, withoutTimestamps, "10:11")
+	require.Greater(t, longestRun(withoutTimestamps), longestRun(withTimestamps))
+	// Only the one-column inset remains in front of the text.
+	require.Contains(t, withoutTimestamps, "\n "+strings.Repeat("x", 78))
+}
+
+func TestWorkspace_ToggleConsoleLogTimestamps_PersistsSetting(t *testing.T) {
+	logger := observability.NewNoOpLogger()
+	cfgPath := filepath.Join(t.TempDir(), "config.json")
+	cfg := leet.NewConfigManager(cfgPath, logger)
+	w := leet.NewWorkspace(t.TempDir(), cfg, logger)
+	require.True(t, cfg.ConsoleLogTimestamps())
+
+	w.Update(keyRune('L'))
+	require.False(t, cfg.ConsoleLogTimestamps())
+	require.False(t, leet.NewConfigManager(cfgPath, logger).ConsoleLogTimestamps())
+
+	w.Update(keyRune('L'))
+	require.True(t, cfg.ConsoleLogTimestamps())
+}
+
+func TestRun_ConsoleLogsHeader_ShowsLaunchCommand(t *testing.T) {
+	logger := observability.NewNoOpLogger()
+	cfg := leet.NewConfigManager(filepath.Join(t.TempDir(), "config.json"), logger)
+	require.NoError(t, cfg.SetConsoleLogsVisible(true))
+
+	r := leet.NewRun(&leet.RunParams{
+		RunFile: "testdata/fake.wandb",
+	}, cfg, logger)
+	r.Update(tea.WindowSizeMsg{Width: 200, Height: 60})
+	r.TestHandleRecordMsg(leet.RunMsg{ID: "abc123"})
+	r.TestHandleRecordMsg(leet.SystemInfoMsg{
+		Record: &spb.EnvironmentRecord{
+			WriterId: "w1",
+			Program:  "train.py",
+			Args:     []string{"--lr", "0.1"},
+		},
+	})
+
+	var header string
+	for line := range strings.SplitSeq(stripANSI(r.View().Content), "\n") {
+		if strings.Contains(line, "Console Logs") {
+			header = line
+		}
+	}
+	require.Contains(t, header, "$ train.py --lr 0.1")
+
+	require.NoError(t, cfg.SetConsoleLogCommand(false))
+	require.NotContains(t, stripANSI(r.View().Content), "$ train.py")
+}
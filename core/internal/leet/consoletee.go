@@ -0,0 +1,99 @@
+package leet
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// consoleTeeFileName is the file in a run's directory that receives the
+// run's console output while it is teed.
+const consoleTeeFileName = "leet-console.log"
+
+// consoleTee appends a run's raw console output to a local text file as
+// it streams, so that it can be followed with tail -f outside the TUI.
+type consoleTee struct {
+	path string
+	file *os.File
+}
+
+// openConsoleTee opens the tee file in dir for appending, creating it if
+// needed.
+func openConsoleTee(dir string) (*consoleTee, error) {
+	path := filepath.Join(dir, consoleTeeFileName)
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_APPEND, 0o644)
+	if err != nil {
+		return nil, err
+	}
+	return &consoleTee{path: path, file: f}, nil
+}
+
+// Write appends raw console output as received.
+func (t *consoleTee) Write(text string) error {
+	_, err := t.file.WriteString(text)
+	return err
+}
+
+// Close closes the tee file.
+func (t *consoleTee) Close() error {
+	return t.file.Close()
+}
+
+// toggleConsoleTee starts or stops teeing the console output of the run
+// under the runs list cursor.
+//
+// Only output that arrives after the tee starts is written.
+func (w *Workspace) toggleConsoleTee() {
+	cur, ok := w.runs.CurrentItem()
+	if !ok {
+		w.setRunNotice("Tee failed: no run selected")
+		return
+	}
+	run := w.runsByKey[cur.Key]
+	if run == nil || !w.selectedRuns[cur.Key] {
+		w.setRunNotice("Tee failed: run %s is not selected", cur.Key)
+		return
+	}
+
+	if run.tee != nil {
+		path := run.tee.path
+		w.closeConsoleTee(run)
+		w.setRunNotice("Stopped teeing console logs to %s", path)
+		return
+	}
+
+	tee, err := openConsoleTee(filepath.Join(w.wandbDir, cur.Key))
+	if err != nil {
+		w.setRunNotice("Tee failed: %v", err)
+		return
+	}
+	run.tee = tee
+	w.setRunNotice("Teeing console logs to %s", tee.path)
+}
+
+// teeConsoleLog appends console output to the run's tee file, if any.
+//
+// A failed write stops the tee rather than retrying on every record.
+func (w *Workspace) teeConsoleLog(run *WorkspaceRun, text string) {
+	if run.tee == nil {
+		return
+	}
+	if err := run.tee.Write(text); err != nil {
+		w.logger.Error(fmt.Sprintf(
+			"workspace: tee console logs to %s: %v", run.tee.path, err))
+		w.setRunNotice("Stopped teeing console logs: %v", err)
+		w.closeConsoleTee(run)
+	}
+}
+
+// closeConsoleTee stops teeing the run's console output.
+func (w *Workspace) closeConsoleTee(run *WorkspaceRun) {
+	if run.tee == nil {
+		return
+	}
+	if err := run.tee.Close(); err != nil {
+		w.logger.Error(fmt.Sprintf(
+			"workspace: close console tee %s: %v", run.tee.path, err))
+	}
+	run.tee = nil
+}
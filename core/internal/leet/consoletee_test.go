@@ -0,0 +1,46 @@
+package leet_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/wandb/wandb/core/internal/leet"
+	"github.com/wandb/wandb/core/internal/observability"
+)
+
+func TestWorkspace_ConsoleTee_AppendsLinesInOrder(t *testing.T) {
+	logger := observability.NewNoOpLogger()
+	cfg := leet.NewConfigManager(filepath.Join(t.TempDir(), "config.json"), logger)
+	wandbDir := t.TempDir()
+	runKey := "run-20260101_000001-alpha"
+	require.NoError(t, os.MkdirAll(filepath.Join(wandbDir, runKey), 0o755))
+
+	w := leet.NewWorkspace(wandbDir, cfg, logger)
+	w.TestApplyRunKeys([]string{runKey})
+	run := leet.TestNewWorkspaceRun(runKey)
+	w.TestAttachRun(run, true)
+
+	w.TestHandleWorkspaceRecord(run, leet.ConsoleLogMsg{Text: "before tee\n"})
+	w.Update(keyRune('T'))
+	w.TestHandleWorkspaceRecord(run, leet.ConsoleLogMsg{Text: "epoch 1\n"})
+	w.TestHandleWorkspaceRecord(run, leet.ConsoleLogMsg{Text: "epoch 2\n"})
+	w.TestHandleWorkspaceRecord(run, leet.ConsoleLogMsg{
+		Text:     "warning: slow step\n",
+		IsStderr: true,
+	})
+
+	path := filepath.Join(wandbDir, runKey, "leet-console.log")
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	require.Equal(t, "epoch 1\nepoch 2\nwarning: slow step\n", string(data))
+
+	// Toggling off stops appending.
+	w.Update(keyRune('T'))
+	w.TestHandleWorkspaceRecord(run, leet.ConsoleLogMsg{Text: "after tee\n"})
+	data, err = os.ReadFile(path)
+	require.NoError(t, err)
+	require.Equal(t, "epoch 1\nepoch 2\nwarning: slow step\n", string(data))
+}
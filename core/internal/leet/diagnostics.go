@@ -0,0 +1,171 @@
+package leet
+
+import (
+	"fmt"
+	"runtime"
+	"strings"
+	"time"
+
+	tea "charm.land/bubbletea/v2"
+	"charm.land/lipgloss/v2"
+)
+
+// diagnosticsKey toggles the diagnostics overlay.
+//
+// It is intentionally not listed on the help screen: the overlay is meant
+// for debugging reports about large workspaces, not for everyday use.
+const diagnosticsKey = "alt+m"
+
+// diagnosticsRefreshInterval is how often runtime memory stats are sampled
+// while the overlay is shown.
+//
+// runtime.ReadMemStats stops the world, so it runs on a tick rather than
+// on every render.
+const diagnosticsRefreshInterval = time.Second
+
+// DiagnosticsTickMsg refreshes the diagnostics overlay's runtime stats.
+type DiagnosticsTickMsg struct{}
+
+// diagnosticsStats is a point-in-time snapshot of process and model sizes.
+type diagnosticsStats struct {
+	HeapAlloc  uint64 // bytes of allocated heap objects
+	Sys        uint64 // bytes obtained from the OS
+	NumGC      uint32
+	Goroutines int
+
+	LoadedRuns      int
+	ChartSeries     int
+	ConsoleLogLines int
+}
+
+// sampleRuntimeDiagnostics returns the runtime part of diagnosticsStats.
+func sampleRuntimeDiagnostics() diagnosticsStats {
+	var ms runtime.MemStats
+	runtime.ReadMemStats(&ms)
+
+	return diagnosticsStats{
+		HeapAlloc:  ms.HeapAlloc,
+		Sys:        ms.Sys,
+		NumGC:      ms.NumGC,
+		Goroutines: runtime.NumGoroutine(),
+	}
+}
+
+// collectDiagnostics combines the last runtime sample with internal
+// counters across the workspace and the single-run view, if open.
+func (m *Model) collectDiagnostics() diagnosticsStats {
+	stats := m.diagnosticsRuntime
+
+	if m.workspace != nil {
+		stats.LoadedRuns += len(m.workspace.runsByKey)
+		stats.ChartSeries += m.workspace.metricsGrid.seriesCount()
+		for _, cl := range m.workspace.consoleLogs {
+			stats.ConsoleLogLines += cl.Len()
+		}
+	}
+	if m.run != nil {
+		stats.LoadedRuns++
+		stats.ChartSeries += m.run.metricsGrid.seriesCount()
+		stats.ConsoleLogLines += m.run.consoleLogs.Len()
+	}
+	return stats
+}
+
+// handleDiagnostics toggles the diagnostics overlay and, while it is shown,
+// swallows user input so keys don't act on the hidden view.
+//
+// Quit keys close the overlay and pass through, as on the help screen.
+func (m *Model) handleDiagnostics(msg tea.Msg) (bool, tea.Cmd) {
+	switch msg := msg.(type) {
+	case DiagnosticsTickMsg:
+		m.diagnosticsTicking = false
+		if !m.showDiagnostics {
+			return true, nil
+		}
+		m.diagnosticsRuntime = sampleRuntimeDiagnostics()
+		return true, m.diagnosticsTick()
+	case tea.KeyPressMsg:
+		if msg.String() == diagnosticsKey && !m.isAwaitingUserInput() {
+			m.showDiagnostics = !m.showDiagnostics
+			if !m.showDiagnostics {
+				return true, nil
+			}
+			m.diagnosticsRuntime = sampleRuntimeDiagnostics()
+			return true, m.diagnosticsTick()
+		}
+		if !m.showDiagnostics {
+			return false, nil
+		}
+		switch msg.String() {
+		case "esc":
+			m.showDiagnostics = false
+			return true, nil
+		case "q", "ctrl+c":
+			m.showDiagnostics = false
+			return false, nil
+		}
+		return true, nil
+	case tea.MouseMsg:
+		return m.showDiagnostics, nil
+	}
+	return false, nil
+}
+
+// diagnosticsTick schedules the next runtime stats refresh unless one is
+// already pending.
+func (m *Model) diagnosticsTick() tea.Cmd {
+	if m.diagnosticsTicking {
+		return nil
+	}
+	m.diagnosticsTicking = true
+	return tea.Tick(diagnosticsRefreshInterval, func(time.Time) tea.Msg {
+		return DiagnosticsTickMsg{}
+	})
+}
+
+// renderDiagnostics renders the diagnostics box centered on screen.
+func (m *Model) renderDiagnostics() string {
+	s := m.collectDiagnostics()
+
+	rows := []struct{ label, value string }{
+		{"Heap in use", UnitBytes.Format(float64(s.HeapAlloc))},
+		{"Memory from OS", UnitBytes.Format(float64(s.Sys))},
+		{"GC cycles", fmt.Sprint(s.NumGC)},
+		{"Goroutines", fmt.Sprint(s.Goroutines)},
+		{"Loaded runs", fmt.Sprint(s.LoadedRuns)},
+		{"Chart series", fmt.Sprint(s.ChartSeries)},
+		{"Console log lines", fmt.Sprint(s.ConsoleLogLines)},
+	}
+
+	lines := []string{headerStyle.Render("Diagnostics"), ""}
+	for _, r := range rows {
+		lines = append(lines,
+			runOverviewSidebarKeyStyle.Width(20).Render(r.label)+
+				runOverviewSidebarValueStyle.Render(r.value))
+	}
+	lines = append(lines, "", navInfoStyle.Render("Esc / "+diagnosticsKey+": close"))
+
+	box := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		Padding(1, 2).
+		Render(strings.Join(lines, "\n"))
+
+	return lipgloss.Place(m.width, m.height, lipgloss.Center, lipgloss.Center, box)
+}
+
+// seriesCount returns the total number of series across all charts.
+func (mg *MetricsGrid) seriesCount() int {
+	mg.mu.RLock()
+	defer mg.mu.RUnlock()
+
+	n := 0
+	for _, ch := range mg.all {
+		n += ch.SeriesCount()
+	}
+	return n
+}
+
+// Len returns the number of assembled console log lines.
+func (cl *RunConsoleLogs) Len() int {
+	return len(cl.lines)
+}
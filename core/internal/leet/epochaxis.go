@@ -0,0 +1,179 @@
+package leet
+
+import "sort"
+
+// epochMetric is the history key whose values drive the epoch x-axis mode.
+const epochMetric = "epoch"
+
+// epochAxis maps one run's steps to the epochs it logged, or to the values
+// of another history key used as the x-axis.
+//
+// It keeps every (step, epoch) pair the run logged so that a step maps to
+// the same epoch no matter which batch it arrived in.
+type epochAxis struct {
+	steps  []float64
+	epochs []float64
+}
+
+// record appends the finite epoch samples from one history batch.
+//
+// Samples at steps before the last recorded one are ignored to keep
+// steps sorted for epochAt.
+func (a *epochAxis) record(data MetricData) {
+	if len(data.X) != len(data.Y) {
+		return
+	}
+	for i, y := range data.Y {
+		step := data.X[i]
+		if !isFinite(step) || !isFinite(y) {
+			continue
+		}
+		if n := len(a.steps); n > 0 && step < a.steps[n-1] {
+			continue
+		}
+		a.steps = append(a.steps, step)
+		a.epochs = append(a.epochs, y)
+	}
+}
+
+// hasEpochs reports whether the run has logged an epoch.
+func (a *epochAxis) hasEpochs() bool {
+	return len(a.epochs) > 0
+}
+
+// epochAt returns the epoch logged at step, else the nearest earlier one.
+//
+// Steps before the run's first epoch map to that first epoch, so that the
+// run's series stay non-decreasing in X. The caller must check hasEpochs.
+func (a *epochAxis) epochAt(step float64) float64 {
+	i := sort.SearchFloat64s(a.steps, step)
+	switch {
+	case i < len(a.steps) && a.steps[i] == step:
+		return a.epochs[i]
+	case i > 0:
+		return a.epochs[i-1]
+	default:
+		return a.epochs[0]
+	}
+}
+
+// xAxisKeyOf returns the history key that config plots metrics against,
+// or "" for _step.
+func xAxisKeyOf(config *ConfigManager) string {
+	if key := config.XAxisKey(); key != "" {
+		return key
+	}
+	if config.XAxisMode() == XAxisEpoch {
+		return epochMetric
+	}
+	return ""
+}
+
+// xAxisLabelNoLock returns the unit of the grid's X values.
+func (mg *MetricsGrid) xAxisLabelNoLock() string {
+	if mg.xAxisKey == "" {
+		return "step"
+	}
+	return mg.xAxisKey
+}
+
+// axisNoLock returns runPath's axis for the history key, or nil.
+func (mg *MetricsGrid) axisNoLock(key, runPath string) *epochAxis {
+	return mg.xAxes[key][runPath]
+}
+
+// recordXAxesNoLock records the run's samples of the history keys that
+// can serve as the x-axis: the epoch metric, so that switching to epoch
+// mode needs no reload, and the configured custom key.
+func (mg *MetricsGrid) recordXAxesNoLock(runPath string, metrics map[string]MetricData) {
+	for _, key := range []string{epochMetric, mg.config.XAxisKey()} {
+		if key == "" {
+			continue
+		}
+		axes, ok := mg.xAxes[key]
+		if !ok {
+			axes = make(map[string]*epochAxis)
+			mg.xAxes[key] = axes
+		}
+		axis, ok := axes[runPath]
+		if !ok {
+			axis = &epochAxis{}
+			axes[runPath] = axis
+		}
+		axis.record(metrics[key])
+	}
+}
+
+// alignXAxisNoLock records the run's x-axis keys and returns how to map
+// the batch's steps to X values, or nil to plot against steps.
+//
+// When plotting against a logged key the decision is made per run rather
+// than per batch: a run plots steps until it logs the key, at which point
+// every series it already has is re-keyed to it.
+func (mg *MetricsGrid) alignXAxisNoLock(
+	runPath string,
+	metrics map[string]MetricData,
+) func(float64) float64 {
+	var hadKey bool
+	if axis := mg.axisNoLock(mg.xAxisKey, runPath); axis != nil {
+		hadKey = axis.hasEpochs()
+	}
+	mg.recordXAxesNoLock(runPath, metrics)
+
+	if mg.xAxisKey == "" {
+		return nil
+	}
+	axis := mg.axisNoLock(mg.xAxisKey, runPath)
+	if axis == nil || !axis.hasEpochs() {
+		return identityX
+	}
+	if !hadKey {
+		for _, ch := range mg.all {
+			ch.RekeyX(runPath, axis.epochAt)
+		}
+	}
+	return axis.epochAt
+}
+
+// xOfNoLock returns how to map runPath's steps to X values for the grid's
+// x-axis, or nil to plot against steps.
+func (mg *MetricsGrid) xOfNoLock(runPath string) func(float64) float64 {
+	if mg.xAxisKey == "" {
+		return nil
+	}
+	if axis := mg.axisNoLock(mg.xAxisKey, runPath); axis != nil && axis.hasEpochs() {
+		return axis.epochAt
+	}
+	return identityX
+}
+
+// syncXAxisModeNoLock re-keys all series when the configured x-axis has
+// changed since data was loaded.
+//
+// Runs loaded before a custom key was configured have not recorded it
+// and keep their steps until they log it again.
+func (mg *MetricsGrid) syncXAxisModeNoLock() {
+	key := xAxisKeyOf(mg.config)
+	if key == mg.xAxisKey {
+		return
+	}
+	mg.xAxisKey = key
+	label := mg.xAxisLabelNoLock()
+
+	for _, ch := range mg.all {
+		ch.SetXLabel(label)
+		for _, runPath := range ch.order {
+			ch.RekeyX(runPath, mg.xOfNoLock(runPath))
+		}
+	}
+}
+
+// removeRunXAxesNoLock forgets the run's recorded x-axis keys.
+func (mg *MetricsGrid) removeRunXAxesNoLock(runPath string) {
+	for _, axes := range mg.xAxes {
+		delete(axes, runPath)
+	}
+}
+
+// identityX plots a step as itself.
+func identityX(step float64) float64 { return step }
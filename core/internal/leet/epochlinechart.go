@@ -6,6 +6,7 @@ import (
 	"math"
 	"slices"
 	"sort"
+	"strconv"
 	"strings"
 	"sync/atomic"
 
@@ -69,6 +70,10 @@ type Series struct {
 	// non-decreasing), enabling efficient binary search during rendering.
 	MetricData
 
+	// steps holds the step of each point while X is re-keyed to another
+	// unit (e.g. epochs); nil when X holds the steps themselves.
+	steps []float64
+
 	// style is the foreground style used to render the series line/dots.
 	// Stored atomically because Draw may run concurrently with style updates.
 	style atomic.Value // stores lipgloss.Style
@@ -78,6 +83,13 @@ type Series struct {
 	xMin, xMax   float64
 	yMin, yMax   float64
 	yMinPositive float64
+
+	// bandLo and bandHi hold the band logged around the metric as
+	// companion min/max keys, keyed by step; nil when the metric logs no
+	// band. bandMin/bandMax are their bounds, kept apart from the series
+	// bounds shown in the stats footer.
+	bandLo, bandHi   map[float64]float64
+	bandMin, bandMax float64
 }
 
 func NewSeries(name string, palette []AdaptiveColor) *Series {
@@ -93,6 +105,8 @@ func NewSeries(name string, palette []AdaptiveColor) *Series {
 		yMin:         math.Inf(1),
 		yMax:         math.Inf(-1),
 		yMinPositive: math.Inf(1),
+		bandMin:      math.Inf(1),
+		bandMax:      math.Inf(-1),
 	}
 
 	if len(palette) == 0 {
@@ -131,6 +145,35 @@ func (s *Series) updateBounds(xs, ys []float64) {
 	}
 }
 
+// resetBounds recomputes the series bounds from all of its points.
+func (s *Series) resetBounds() {
+	s.xMin, s.xMax = math.Inf(1), math.Inf(-1)
+	s.yMin, s.yMax = math.Inf(1), math.Inf(-1)
+	s.yMinPositive = math.Inf(1)
+	s.updateBounds(s.X, s.Y)
+	s.bandMin, s.bandMax = math.Inf(1), math.Inf(-1)
+	s.updateBandBounds(s.bandLo)
+	s.updateBandBounds(s.bandHi)
+}
+
+// stepData returns the series points keyed by step.
+func (s *Series) stepData() MetricData {
+	if s.steps == nil {
+		return s.MetricData
+	}
+	return MetricData{X: s.steps, Y: s.Y}
+}
+
+// firstFiniteY returns the series' first finite value.
+func (s *Series) firstFiniteY() (float64, bool) {
+	for _, y := range s.Y {
+		if isFinite(y) {
+			return y, true
+		}
+	}
+	return 0, false
+}
+
 // Bounds returns the series' precomputed bounds.
 func (s *Series) Bounds() (xMin, xMax, yMin, yMax float64) {
 	return s.xMin, s.xMax, s.yMin, s.yMax
@@ -186,7 +229,8 @@ type EpochLineChart struct {
 	// dirty marks the chart as needing a redraw on the next DrawIfNeeded call.
 	dirty bool
 
-	// isZoomed is set after user adjusts the X view via HandleZoom.
+	// isZoomed is set after user adjusts the X view via HandleZoom or
+	// CenterOnX.
 	// When true, updateRanges preserves the user's X view instead of auto-fitting.
 	isZoomed bool
 
@@ -205,17 +249,76 @@ type EpochLineChart struct {
 	// yScale controls how Y values are projected for rendering.
 	yScale AxisScaleMode
 
+	// invertY flips the Y axis so lower values are drawn at the top.
+	invertY bool
+
+	// secondary, when set, is another metric's chart whose series are
+	// overlaid on a right-hand Y axis with their own scale.
+	secondary *EpochLineChart
+
+	// secondaryPoints is the secondary chart's point count at the last
+	// draw, so new secondary data triggers a redraw.
+	secondaryPoints int
+
 	// yTickFormatter formats raw, unscaled Y values for axis labels.
 	yTickFormatter func(float64) string
 
+	// yUnit, when set, formats Y values in axis labels and the
+	// default inspection legend.
+	yUnit UnitFormatter
+
 	// inspection holds crosshair overlay state for data inspection mode.
 	inspection ChartInspection
 
 	// inspectionLabelFormatter customizes legend labels for inspection mode.
 	// When nil, a default numeric formatter is used.
 	inspectionLabelFormatter func(seriesKey string, x, y float64) string
+
+	// rawXTicks renders X axis ticks as exact values
+	// instead of SI-abbreviated values (e.g. 1.2M).
+	rawXTicks bool
+
+	// xLabel names the unit of X values ("step" or "epoch").
+	xLabel string
+
+	// hideXLabels drops the x-axis tick labels and their row, for charts
+	// that share the axis of the chart below them.
+	hideXLabels bool
+
+	// objective is "min" or "max" when this chart tracks its best point.
+	objective string
+
+	// best is the best point so far across all series for objective.
+	best bestPoint
+
+	// target is the metric's goal value, drawn as a horizontal line
+	// when hasTarget is set.
+	target    float64
+	hasTarget bool
+
+	// pointMarkers marks each drawn sample with pointMarkerRune.
+	pointMarkers bool
+
+	// bandWindow is the rolling min/max band window in samples (0: off).
+	bandWindow int
+
+	// sampleThreshold is the total point count above which the chart draws
+	// downsampled data (0: off); sampled reports whether the last draw
+	// actually left any points out.
+	sampleThreshold int
+	sampled         bool
+
+	// drawnPoints counts the points plotted by the last draw.
+	drawnPoints int
+
+	// states detects metrics that only take a few discrete values, which
+	// are drawn as step plots.
+	states stateTracker
 }
 
+// legendSwatch is the colored marker drawn before each legend label.
+const legendSwatch = "▬"
+
 func NewEpochLineChart(title string) *EpochLineChart {
 	chart := &EpochLineChart{
 		Model: linechart.New(parkedCanvasSize, parkedCanvasSize, 0, defaultMaxX, 0, defaultMaxY,
@@ -235,7 +338,7 @@ func NewEpochLineChart(title string) *EpochLineChart {
 	chart.yTickFormatter = UnitScalar.Format
 
 	chart.XLabelFormatter = func(_ int, v float64) string {
-		return FormatXAxisTick(v, chart.maxXLabelWidth())
+		return chart.formatXTick(v)
 	}
 	chart.YLabelFormatter = func(_ int, v float64) string {
 		return chart.formatYTick(v)
@@ -244,14 +347,78 @@ func NewEpochLineChart(title string) *EpochLineChart {
 	return chart
 }
 
+func (c *EpochLineChart) formatXTick(v float64) string {
+	if c.rawXTicks {
+		return formatXValue(v)
+	}
+	return FormatXAxisTick(v, c.maxXLabelWidth())
+}
+
+// SetRawXTicks switches X axis ticks between exact values (raw)
+// and SI-abbreviated values.
+func (c *EpochLineChart) SetRawXTicks(raw bool) {
+	if c.rawXTicks == raw {
+		return
+	}
+	c.rawXTicks = raw
+	c.dirty = true
+}
+
+// SetXLabelsHidden sets whether the x-axis tick labels are omitted.
+//
+// The label row is then left out of View, so a chart resized one row
+// taller occupies the same height as a labeled one.
+func (c *EpochLineChart) SetXLabelsHidden(hidden bool) {
+	if c.hideXLabels == hidden {
+		return
+	}
+	c.hideXLabels = hidden
+	c.dirty = true
+}
+
+// XLabelsHidden reports whether the x-axis tick labels are omitted.
+func (c *EpochLineChart) XLabelsHidden() bool { return c.hideXLabels }
+
+// View renders the chart, without the x-axis label row when hidden.
+func (c *EpochLineChart) View() string {
+	view := c.Model.View()
+	if !c.hideXLabels {
+		return view
+	}
+	if i := strings.LastIndexByte(view, '\n'); i >= 0 {
+		return view[:i]
+	}
+	return view
+}
+
+// SetXLabel sets the unit X values are reported in, e.g. "epoch".
+func (c *EpochLineChart) SetXLabel(label string) {
+	if c.xLabel == label {
+		return
+	}
+	c.xLabel = label
+	c.dirty = true
+}
+
+// formatXValue formats an X value exactly, without exponent notation.
+func formatXValue(v float64) string {
+	if !isFinite(v) {
+		return ""
+	}
+	return strconv.FormatFloat(v, 'f', -1, 64)
+}
+
 func (c *EpochLineChart) formatYTick(v float64) string {
 	if !isFinite(v) {
 		return ""
 	}
 
 	rawValue := v
+	if c.invertY {
+		rawValue = -rawValue
+	}
 	if c.IsLogY() {
-		rawValue = math.Pow(10, v)
+		rawValue = math.Pow(10, rawValue)
 		if !isFinite(rawValue) {
 			return ""
 		}
@@ -375,13 +542,73 @@ func (c *EpochLineChart) AddData(key string, data MetricData) {
 	sxMin, sxMax, syMin, syMax := s.Bounds()
 	c.xMin = min(c.xMin, sxMin)
 	c.xMax = max(c.xMax, sxMax)
-	c.yMin = min(c.yMin, syMin)
-	c.yMax = max(c.yMax, syMax)
+	c.yMin = min(c.yMin, syMin, s.bandMin)
+	c.yMax = max(c.yMax, syMax, s.bandMax)
+	c.trackBest(data.X, data.Y)
 
 	c.updateRanges()
 	c.dirty = true
 }
 
+// AddSteppedData appends points logged at data.X steps, plotting each
+// at xOf(step) while keeping its step so the series can be re-keyed.
+func (c *EpochLineChart) AddSteppedData(
+	key string,
+	data MetricData,
+	xOf func(step float64) float64,
+) {
+	if len(data.X) != len(data.Y) || len(data.X) == 0 {
+		return
+	}
+	if s, ok := c.data[key]; ok && s.steps == nil {
+		s.steps = slices.Clone(s.X)
+	}
+
+	xs := make([]float64, len(data.X))
+	for i, step := range data.X {
+		xs[i] = xOf(step)
+	}
+	c.AddData(key, MetricData{X: xs, Y: data.Y})
+
+	s := c.data[key]
+	s.steps = append(s.steps, data.X...)
+}
+
+// RekeyX recomputes the X values of key's series from the steps its
+// points were logged at, mapping each through xOf. A nil xOf plots the
+// steps themselves.
+//
+// The X view is reset, since a zoom range in the old units is meaningless.
+func (c *EpochLineChart) RekeyX(key string, xOf func(step float64) float64) {
+	s, ok := c.data[key]
+	if !ok {
+		return
+	}
+	if s.steps == nil {
+		if xOf == nil {
+			return
+		}
+		s.steps = slices.Clone(s.X)
+	}
+	for i, step := range s.steps {
+		if xOf == nil {
+			s.X[i] = step
+		} else {
+			s.X[i] = xOf(step)
+		}
+	}
+	if xOf == nil {
+		s.steps = nil
+	}
+
+	s.resetBounds()
+	c.recomputeBounds()
+	c.recomputeBest()
+	c.isZoomed = false
+	c.updateRanges()
+	c.dirty = true
+}
+
 // updateRanges recomputes axis ranges from current bounds.
 func (c *EpochLineChart) updateRanges() {
 	if c.SeriesCount() == 0 {
@@ -430,11 +657,24 @@ func (c *EpochLineChart) updateRanges() {
 }
 
 func (c *EpochLineChart) computeYRange() (minY, maxY float64, ok bool) {
+	minY, maxY, ok = c.computeScaledYRange()
+	if ok && c.invertY {
+		minY, maxY = -maxY, -minY
+	}
+	return minY, maxY, ok
+}
+
+// computeScaledYRange returns the padded Y range in linear or log space,
+// before any axis inversion.
+func (c *EpochLineChart) computeScaledYRange() (minY, maxY float64, ok bool) {
 	if c.IsLogY() {
 		minPositive, maxPositive, ok := c.positiveYBounds()
 		if !ok {
 			return 0, 0, false
 		}
+		if c.target > 0 {
+			minPositive, maxPositive = c.withTarget(minPositive, maxPositive)
+		}
 		minY, maxY = c.calculateLogRange(minPositive, maxPositive)
 		return minY, maxY, true
 	}
@@ -449,14 +689,15 @@ func (c *EpochLineChart) computeYRange() (minY, maxY float64, ok bool) {
 }
 
 func (c *EpochLineChart) calculateLinearRange() (float64, float64) {
-	valueRange := c.yMax - c.yMin
+	yMin, yMax := c.withTarget(c.yMin, c.yMax)
+	valueRange := yMax - yMin
 	padding := c.calculatePadding(valueRange)
 
-	newYMin := c.yMin - padding
-	newYMax := c.yMax + padding
+	newYMin := yMin - padding
+	newYMax := yMax + padding
 
 	// Don't go negative for non-negative data.
-	if c.yMin >= 0 && newYMin < 0 {
+	if yMin >= 0 && newYMin < 0 {
 		newYMin = 0
 	}
 
@@ -578,10 +819,13 @@ func (c *EpochLineChart) Draw() {
 	// draw our own. ntcharts v2.0.1 forces a label at graphHeight, which
 	// stacks on top of the previous tick when graphHeight is just above a
 	// multiple of yStep (e.g. "4.86" sitting on row y=6 and "4.05" on y=5).
-	origYFmter := c.YLabelFormatter
+	origXFmter, origYFmter := c.XLabelFormatter, c.YLabelFormatter
+	if c.hideXLabels {
+		c.XLabelFormatter = func(int, float64) string { return "" }
+	}
 	c.YLabelFormatter = func(int, float64) string { return "" }
 	c.DrawXYAxisAndLabel()
-	c.YLabelFormatter = origYFmter
+	c.XLabelFormatter, c.YLabelFormatter = origXFmter, origYFmter
 	c.drawYLabels()
 
 	if c.GraphWidth() <= 0 || c.GraphHeight() <= 0 {
@@ -594,10 +838,21 @@ func (c *EpochLineChart) Draw() {
 		startX = c.Origin().X + 1
 	}
 
+	sample := c.sampleThreshold > 0 && c.totalPoints() > c.sampleThreshold
+	c.sampled = false
+	c.drawnPoints = 0
+
+	c.drawBands(startX)
+	c.drawLoggedBands(startX)
+	c.drawTargetLine(startX)
+	axis := c.primaryYAxis()
 	for _, key := range c.order {
-		c.drawSeries(c.data[key], startX)
+		s := c.data[key]
+		c.drawSeries(s, s.style.Load().(lipgloss.Style), axis, startX, sample)
 	}
+	c.drawSecondary(startX, sample)
 
+	c.drawBestMarker(startX)
 	c.drawInspectionOverlay(startX)
 	c.dirty = false
 }
@@ -643,8 +898,29 @@ func (c *EpochLineChart) drawYLabels() {
 	}
 }
 
-// drawSeries renders a single series onto the canvas.
-func (c *EpochLineChart) drawSeries(s *Series, startX int) {
+// yAxis maps series values onto the chart's vertical graph space.
+type yAxis struct {
+	// scale projects a raw value into axis space, reporting false for
+	// values the axis cannot show.
+	scale func(float64) (float64, bool)
+
+	// min and max bound the visible axis range, in axis space.
+	min, max float64
+}
+
+// primaryYAxis returns the left-hand axis the chart's own series use.
+func (c *EpochLineChart) primaryYAxis() yAxis {
+	return yAxis{scale: c.scaleYValue, min: c.ViewMinY(), max: c.ViewMaxY()}
+}
+
+// drawSeries renders a single series onto the canvas against axis.
+func (c *EpochLineChart) drawSeries(
+	s *Series,
+	style lipgloss.Style,
+	axis yAxis,
+	startX int,
+	sample bool,
+) {
 	if len(s.X) == 0 {
 		return
 	}
@@ -666,7 +942,7 @@ func (c *EpochLineChart) drawSeries(s *Series, startX int) {
 	)
 
 	xScale := float64(c.GraphWidth()) / (c.ViewMaxX() - c.ViewMinX())
-	yScale := float64(c.GraphHeight()) / (c.ViewMaxY() - c.ViewMinY())
+	yScale := float64(c.GraphHeight()) / (axis.max - axis.min)
 
 	segments := make([][]canvas.Float64Point, 0, 1)
 	current := make([]canvas.Float64Point, 0, ub-lb)
@@ -678,22 +954,38 @@ func (c *EpochLineChart) drawSeries(s *Series, startX int) {
 		current = make([]canvas.Float64Point, 0, ub-lb)
 	}
 
-	for i := lb; i < ub; i++ {
-		yValue, ok := c.scaleYValue(s.Y[i])
+	plot := func(i int) {
+		yValue, ok := axis.scale(s.Y[i])
 		if !ok {
 			flush()
-			continue
+			return
 		}
 
 		x := (s.X[i] - c.ViewMinX()) * xScale
-		y := (yValue - c.ViewMinY()) * yScale
+		y := (yValue - axis.min) * yScale
 
 		if x < 0 || x > float64(c.GraphWidth()) || y < 0 || y > float64(c.GraphHeight()) {
 			flush()
-			continue
+			return
 		}
 
 		current = append(current, canvas.Float64Point{X: x, Y: y})
+		c.drawnPoints++
+	}
+
+	var indices []int
+	if sample {
+		indices = c.sampleIndices(s, lb, ub)
+	}
+	if indices != nil {
+		c.sampled = c.sampled || len(indices) < ub-lb
+		for _, i := range indices {
+			plot(i)
+		}
+	} else {
+		for i := lb; i < ub; i++ {
+			plot(i)
+		}
 	}
 	flush()
 
@@ -702,6 +994,10 @@ func (c *EpochLineChart) drawSeries(s *Series, startX int) {
 			bGrid.Set(bGrid.GridPoint(points[0]))
 			continue
 		}
+		if c.IsStepPlot() {
+			drawSteps(bGrid, points)
+			continue
+		}
 		for i := range len(points) - 1 {
 			gp1 := bGrid.GridPoint(points[i])
 			gp2 := bGrid.GridPoint(points[i+1])
@@ -710,9 +1006,14 @@ func (c *EpochLineChart) drawSeries(s *Series, startX int) {
 	}
 
 	patterns := bGrid.BraillePatterns()
-	style := s.style.Load().(lipgloss.Style)
 
 	drawBraillePatternsOccluded(&c.Canvas, canvas.Point{X: startX, Y: 0}, patterns, &style)
+
+	if c.pointMarkers {
+		for _, points := range segments {
+			c.drawPointMarkers(bGrid, points, startX, style)
+		}
+	}
 }
 
 // drawBraillePatternsOccluded draws braille runes with opaque compositing.
@@ -786,6 +1087,13 @@ func (c *EpochLineChart) drawInspectionOverlay(graphStartX int) {
 
 		yVal := s.Y[idx]
 		label := c.formatInspectionLabel(key, s.X[idx], yVal)
+		if c.inspectionLabelFormatter == nil {
+			if first, ok := s.firstFiniteY(); ok {
+				if change, ok := percentChange(first, yVal); ok {
+					label += " (" + change + ")"
+				}
+			}
+		}
 		labelRunes := []rune(label)
 		if lw := len(labelRunes); lw > maxLabelWidth {
 			maxLabelWidth = lw
@@ -865,7 +1173,27 @@ func (c *EpochLineChart) formatInspectionLabel(seriesKey string, x, y float64) s
 	if c.inspectionLabelFormatter != nil {
 		return c.inspectionLabelFormatter(seriesKey, x, y)
 	}
-	return fmt.Sprintf("%v: %v", x, formatSigFigs(y, 4))
+	if c.yUnit != nil {
+		return fmt.Sprintf("%s: %s", formatXValue(x), c.yUnit.Format(y))
+	}
+	return fmt.Sprintf("%s: %v", formatXValue(x), formatSigFigs(y, 4))
+}
+
+// percentChange formats the change from first to y as a signed
+// percentage of first, such as "-42.5%".
+//
+// It reports false when the change is undefined: a zero first value or
+// a non-finite y.
+func percentChange(first, y float64) (string, bool) {
+	if first == 0 || !isFinite(y) {
+		return "", false
+	}
+	pct := (y - first) / math.Abs(first) * 100
+	s := formatSigFigs(pct, 3)
+	if pct >= 0 {
+		s = "+" + s
+	}
+	return s + "%", true
 }
 
 // findNearestDataPoint returns the data point nearest to mouseX in the topmost series.
@@ -937,18 +1265,21 @@ func (c *EpochLineChart) scaleYValue(y float64) (float64, bool) {
 	if !isFinite(y) {
 		return 0, false
 	}
-	if !c.IsLogY() {
-		return y, true
+	if c.IsLogY() {
+		if y <= 0 {
+			return 0, false
+		}
+		y = math.Log10(y)
 	}
-	if y <= 0 {
-		return 0, false
+	if c.invertY {
+		y = -y
 	}
-	return math.Log10(y), true
+	return y, true
 }
 
 // DrawIfNeeded draws only if the chart is marked dirty.
 func (c *EpochLineChart) DrawIfNeeded() {
-	if c.dirty {
+	if c.dirty || c.secondaryChanged() {
 		c.Draw()
 	}
 }
@@ -1043,6 +1374,18 @@ func (c *EpochLineChart) SetSeriesStyle(key string, style *lipgloss.Style) {
 	}
 }
 
+// SetYUnit formats Y axis labels and inspection values in the given unit.
+//
+// A nil unit restores plain scalar formatting.
+func (c *EpochLineChart) SetYUnit(unit UnitFormatter) {
+	c.yUnit = unit
+	if unit == nil {
+		unit = UnitScalar
+	}
+	c.yTickFormatter = unit.Format
+	c.dirty = true
+}
+
 // SetInspectionLabelFormatter customizes inspection legend labels.
 func (c *EpochLineChart) SetInspectionLabelFormatter(
 	formatter func(seriesKey string, x, y float64) string,
@@ -1051,6 +1394,94 @@ func (c *EpochLineChart) SetInspectionLabelFormatter(
 	c.dirty = true
 }
 
+// Legend renders a single-line legend mapping each series color to a label,
+// in draw order, fitted to width.
+//
+// Entries that don't fit are summarized as "+N". label maps series keys to
+// display names; when nil, keys are shown as is.
+func (c *EpochLineChart) Legend(width int, label func(string) string) string {
+	if width <= 0 || len(c.order) == 0 {
+		return ""
+	}
+
+	const sep = "  "
+	var b strings.Builder
+	used := 0
+	for i, key := range c.order {
+		s, ok := c.data[key]
+		if !ok {
+			continue
+		}
+		name := key
+		if label != nil {
+			name = label(key)
+		}
+
+		// Reserve room for a "+N" marker unless this is the last entry.
+		reserve := 0
+		if i < len(c.order)-1 {
+			reserve = len(sep) + len(fmt.Sprintf("+%d", len(c.order)-i))
+		}
+		entryW := lipgloss.Width(legendSwatch) + 1 + lipgloss.Width(name)
+		if used > 0 {
+			entryW += len(sep)
+		}
+		if used+entryW+reserve > width {
+			if used == 0 {
+				// Always show at least a truncated first entry, leaving
+				// room for the "+N" marker.
+				name = TruncateTitle(name, max(width-lipgloss.Width(legendSwatch)-1-reserve, 1))
+				entryW = lipgloss.Width(legendSwatch) + 1 + lipgloss.Width(name)
+			} else {
+				more := fmt.Sprintf("+%d", len(c.order)-i)
+				if used+len(sep)+len(more) <= width {
+					b.WriteString(sep)
+					b.WriteString(navInfoStyle.Render(more))
+				}
+				break
+			}
+		}
+
+		if used > 0 {
+			b.WriteString(sep)
+		}
+		style := s.style.Load().(lipgloss.Style)
+		b.WriteString(style.Render(legendSwatch))
+		b.WriteString(" ")
+		b.WriteString(navInfoStyle.Render(name))
+		used += entryW
+	}
+	return b.String()
+}
+
+// StatsFooter returns the last, min and max value of the topmost series,
+// truncated to width, or "" if it has no finite values.
+func (c *EpochLineChart) StatsFooter(width int) string {
+	s := c.topSeries()
+	if s == nil || width <= 0 || !isFinite(s.yMin) {
+		return ""
+	}
+	last := math.NaN()
+	for i := len(s.Y) - 1; i >= 0; i-- {
+		if isFinite(s.Y[i]) {
+			last = s.Y[i]
+			break
+		}
+	}
+
+	footer := fmt.Sprintf("last %s  min %s  max %s",
+		c.formatYValue(last), c.formatYValue(s.yMin), c.formatYValue(s.yMax))
+	return truncateRight(footer, width)
+}
+
+// formatYValue formats a raw (unscaled) Y value like the chart's Y ticks.
+func (c *EpochLineChart) formatYValue(v float64) string {
+	if c.yTickFormatter != nil {
+		return c.yTickFormatter(v)
+	}
+	return UnitScalar.Format(v)
+}
+
 // SeriesCount returns the number of series in the chart.
 func (c *EpochLineChart) SeriesCount() int {
 	return len(c.data)
@@ -1071,6 +1502,7 @@ func (c *EpochLineChart) RemoveSeries(key string) {
 	}
 
 	c.recomputeBounds()
+	c.recomputeBest()
 	c.updateRanges()
 	c.dirty = true
 }
@@ -1090,8 +1522,8 @@ func (c *EpochLineChart) recomputeBounds() {
 		xMin, xMax, yMin, yMax := s.Bounds()
 		c.xMin = min(c.xMin, xMin)
 		c.xMax = max(c.xMax, xMax)
-		c.yMin = min(c.yMin, yMin)
-		c.yMax = max(c.yMax, yMax)
+		c.yMin = min(c.yMin, yMin, s.bandMin)
+		c.yMax = max(c.yMax, yMax, s.bandMax)
 	}
 }
 
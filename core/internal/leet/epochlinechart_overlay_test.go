@@ -232,3 +232,22 @@ func TestEpochLineChart_MultiSeries_InspectionAfterPromotion(t *testing.T) {
 	// Now A is topmost, so Y should be 100.
 	require.InDelta(t, 100.0, y, 1e-9)
 }
+
+func TestEpochLineChart_InspectionShowsPercentChangeFromFirstValue(t *testing.T) {
+	m := "loss"
+	c := leet.NewEpochLineChart(m)
+	c.Resize(80, 12)
+	c.AddData(m, leet.MetricData{
+		X: []float64{0, 5, 10},
+		Y: []float64{2, 1.5, 3},
+	})
+	c.Draw()
+
+	c.InspectAtDataX(5)
+	c.Draw()
+	require.Contains(t, stripANSI(c.View()), "5: 1.5 (-25%)")
+
+	c.InspectAtDataX(10)
+	c.Draw()
+	require.Contains(t, stripANSI(c.View()), "10: 3 (+50%)")
+}
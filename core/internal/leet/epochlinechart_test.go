@@ -350,6 +350,32 @@ func TestFormatXAxisTick(t *testing.T) {
 	}
 }
 
+func TestEpochLineChart_RawXTicks(t *testing.T) {
+	c := leet.NewEpochLineChart("loss")
+	c.Resize(80, 12)
+
+	tests := []struct {
+		value       float64
+		abbreviated string
+		raw         string
+	}{
+		{450, "450", "450"},
+		{450_000, "450k", "450000"},
+		{1_234_567, "1.23M", "1234567"},
+	}
+
+	for _, tt := range tests {
+		c.SetRawXTicks(false)
+		require.Equal(t, tt.abbreviated, c.TestFormatXTick(tt.value))
+		c.SetRawXTicks(true)
+		require.Equal(t, tt.raw, c.TestFormatXTick(tt.value))
+	}
+
+	// Inspection reports the exact step regardless of tick formatting.
+	c.SetRawXTicks(false)
+	require.Equal(t, "1234567: 0.5", c.TestInspectionLabel(1_234_567, 0.5))
+}
+
 func TestEpochLineChart_ToggleYScale_RejectsNonPositiveOnlyData(t *testing.T) {
 	c := leet.NewEpochLineChart("loss")
 	c.Resize(80, 12)
@@ -407,3 +433,130 @@ func TestTimeSeriesLineChart_LogY_FormatsTicksWithMetricUnits(t *testing.T) {
 	require.True(t, ch.TestIsLogY())
 	require.Equal(t, "10%", ch.TestFormatYTick(1))
 }
+
+func TestEpochLineChart_MinMaxBand_TracksTrailingWindow(t *testing.T) {
+	c := leet.NewEpochLineChart("loss")
+	c.Resize(80, 12)
+	c.AddData("run", leet.MetricData{
+		X: []float64{0, 1, 2, 3, 4, 5, 6, 7},
+		Y: []float64{5, 1, 4, 2, 8, 3, 7, 6},
+	})
+
+	lo, hi := c.Band("run")
+	require.Nil(t, lo)
+	require.Nil(t, hi)
+	c.Draw()
+	require.NotContains(t, c.View(), "░")
+
+	c.SetBandWindow(3)
+	lo, hi = c.Band("run")
+	require.Equal(t, []float64{5, 1, 1, 1, 2, 2, 3, 3}, lo)
+	require.Equal(t, []float64{5, 5, 5, 4, 8, 8, 8, 7}, hi)
+	c.Draw()
+	require.Contains(t, c.View(), "░")
+}
+
+func TestEpochLineChart_PointMarkers_MarkEachStepAndKeepLine(t *testing.T) {
+	c := leet.NewEpochLineChart("loss")
+	c.Resize(80, 12)
+	c.AddData("run", leet.MetricData{
+		X: []float64{0, 5, 10, 15},
+		Y: []float64{1, 3, 2, 4},
+	})
+
+	brailleCells := func(view string) int {
+		n := 0
+		for _, r := range view {
+			if r > 0x2800 && r <= 0x28FF {
+				n++
+			}
+		}
+		return n
+	}
+
+	c.Draw()
+	require.NotContains(t, c.View(), "•")
+	lineCells := brailleCells(stripANSI(c.View()))
+
+	c.SetPointMarkers(true)
+	c.Draw()
+	view := stripANSI(c.View())
+	require.Equal(t, 4, strings.Count(view, "•"), "one marker per logged step")
+	require.Equal(t, lineCells-4, brailleCells(view),
+		"markers replace only the cells of the logged points")
+}
+
+func TestEpochLineChart_Legend_TruncatedFirstEntryLeavesRoomForMore(t *testing.T) {
+	c := leet.NewEpochLineChart("loss")
+	c.AddData("a-very-long-run-name.wandb", leet.MetricData{X: []float64{1}, Y: []float64{1}})
+	c.AddData("another-long-run-name.wandb", leet.MetricData{X: []float64{1}, Y: []float64{2}})
+
+	legend := c.Legend(20, nil)
+
+	require.LessOrEqual(t, lipgloss.Width(legend), 20)
+	require.Contains(t, legend, "+1")
+}
+
+func TestEpochLineChart_InvertY_DrawsMinimumAtTop(t *testing.T) {
+	c := leet.NewEpochLineChart("loss")
+	c.Resize(40, 10)
+	c.AddData("loss", leet.MetricData{
+		X: []float64{0, 1, 2, 3, 4},
+		Y: []float64{10, 30, 50, 70, 90},
+	})
+
+	c.SetInvertY(true)
+	c.Draw()
+
+	require.True(t, c.IsInvertedY())
+	lines := strings.Split(stripANSI(c.View()), "\n")
+	top := strings.TrimSpace(lines[0])
+	bottom := strings.TrimSpace(lines[c.GraphHeight()])
+	require.True(t, strings.HasPrefix(top, "2│"), "top row should be labeled with the minimum: %q", top)
+	require.True(t, strings.HasPrefix(bottom, "98└"), "axis row should be labeled with the maximum: %q", bottom)
+	// The first, lowest sample sits on the top row.
+	_, plot, _ := strings.Cut(lines[0], "│")
+	require.False(t, strings.HasPrefix(plot, " "), "top row should start with the first sample: %q", plot)
+}
+
+func TestEpochLineChart_SecondaryAxis_DrawsBothSeriesWithIndependentBounds(t *testing.T) {
+	loss := leet.NewEpochLineChart("loss")
+	loss.Resize(60, 12)
+	loss.AddData("run", leet.MetricData{
+		X: []float64{0, 1, 2, 3, 4},
+		Y: []float64{2.5, 1.8, 1.2, 0.8, 0.5},
+	})
+	lr := leet.NewEpochLineChart("lr")
+	lr.AddData("run", leet.MetricData{
+		X: []float64{0, 1, 2, 3, 4},
+		Y: []float64{0.001, 0.0008, 0.0006, 0.0004, 0.0002},
+	})
+
+	loss.SetSecondary(lr)
+	loss.Draw()
+
+	require.Same(t, lr, loss.Secondary())
+	_, _, yMin, yMax := loss.TestBounds()
+	require.Equal(t, 0.5, yMin)
+	require.Equal(t, 2.5, yMax)
+	secMin, secMax, ok := loss.TestSecondaryBounds()
+	require.True(t, ok)
+	require.Less(t, secMin, 0.0002)
+	require.Greater(t, secMax, 0.001)
+	require.Less(t, secMax, 0.01, "the right-hand axis keeps its own scale")
+	// The primary axis is not stretched to fit the learning rate.
+	require.Greater(t, loss.ViewMinY(), 0.0)
+
+	require.Equal(t, 10, loss.TestDrawnPoints(), "both series are plotted")
+	view := stripANSI(loss.View())
+	require.Contains(t, view, "0.001", "the right-hand axis is labeled")
+
+	// New secondary data redraws the chart.
+	lr.AddData("run", leet.MetricData{X: []float64{5}, Y: []float64{0.0001}})
+	loss.DrawIfNeeded()
+	require.Equal(t, 11, loss.TestDrawnPoints())
+
+	loss.SetSecondary(nil)
+	loss.Draw()
+	require.Equal(t, 5, loss.TestDrawnPoints())
+}
@@ -0,0 +1,87 @@
+package leet
+
+import "slices"
+
+// sampledTitleSuffix marks charts drawn from a downsampled view of their data.
+const sampledTitleSuffix = " [sampled]"
+
+// SetSampleThreshold sets the total point count across series above which
+// the chart draws a downsampled view of its data. 0 disables sampling.
+func (c *EpochLineChart) SetSampleThreshold(threshold int) {
+	threshold = max(threshold, 0)
+	if c.sampleThreshold == threshold {
+		return
+	}
+	c.sampleThreshold = threshold
+	c.dirty = true
+}
+
+// IsSampled reports whether the last draw used downsampled data.
+func (c *EpochLineChart) IsSampled() bool { return c.sampled }
+
+// totalPoints returns the number of samples across all series.
+func (c *EpochLineChart) totalPoints() int {
+	n := 0
+	for _, s := range c.data {
+		n += len(s.X)
+	}
+	return n
+}
+
+// sampleIndices picks the samples of s[lb:ub] to draw when sampling is on.
+//
+// Samples are bucketed by braille column; each bucket keeps its first, min,
+// max and last finite sample so that spikes and the line's envelope survive.
+// A non-finite sample is kept too, so gaps in the series still break the line.
+func (c *EpochLineChart) sampleIndices(s *Series, lb, ub int) []int {
+	columns := max(c.GraphWidth()*2, 1)
+	xRange := c.ViewMaxX() - c.ViewMinX()
+	if ub-lb <= columns*4 || xRange <= 0 {
+		return nil
+	}
+
+	indices := make([]int, 0, columns*4)
+	bucket := -1
+	var first, last, lo, hi, gap int
+	flush := func() {
+		if bucket < 0 {
+			return
+		}
+		picked := []int{first, lo, hi, last, gap}
+		slices.Sort(picked)
+		prev := -1
+		for _, idx := range picked {
+			if idx >= 0 && idx != prev {
+				indices = append(indices, idx)
+				prev = idx
+			}
+		}
+	}
+
+	for i := lb; i < ub; i++ {
+		b := min(int((s.X[i]-c.ViewMinX())/xRange*float64(columns)), columns-1)
+		if b != bucket {
+			flush()
+			bucket = b
+			first, last, lo, hi, gap = -1, -1, -1, -1, -1
+		}
+		if !isFinite(s.Y[i]) {
+			if gap < 0 {
+				gap = i
+			}
+			continue
+		}
+		if first < 0 {
+			first = i
+		}
+		last = i
+		if lo < 0 || s.Y[i] < s.Y[lo] {
+			lo = i
+		}
+		if hi < 0 || s.Y[i] > s.Y[hi] {
+			hi = i
+		}
+	}
+	flush()
+	return indices
+}
@@ -66,6 +66,13 @@ func (f *Filter) Clear() {
 	f.inputActive = false
 }
 
+// Set applies pattern directly and exits input mode.
+func (f *Filter) Set(pattern string) {
+	f.applied = pattern
+	f.draft = ""
+	f.inputActive = false
+}
+
 func (f *Filter) ToggleMode() {
 	if f.mode == FilterModeRegex {
 		f.mode = FilterModeGlob
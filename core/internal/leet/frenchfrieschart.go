@@ -81,6 +81,10 @@ type FrenchFriesChart struct {
 	// windowing and zoom semantics as the underlying line chart.
 	samples []frenchFriesSample
 
+	// maxSamples caps the retained samples, dropping the oldest;
+	// 0 keeps every sample.
+	maxSamples int
+
 	series        map[string]struct{}
 	orderedSeries []string
 	seriesDirty   bool
@@ -164,6 +168,12 @@ func (c *FrenchFriesChart) DrawIfNeeded() {
 	}
 }
 
+// SetMaxSamples caps the retained samples, dropping the oldest first.
+// 0 keeps every sample.
+func (c *FrenchFriesChart) SetMaxSamples(n int) {
+	c.maxSamples = max(n, 0)
+}
+
 func (c *FrenchFriesChart) AddDataPoint(seriesName string, timestamp int64, value float64) {
 	if seriesName == "" {
 		seriesName = DefaultSystemMetricSeriesName
@@ -178,6 +188,9 @@ func (c *FrenchFriesChart) AddDataPoint(seriesName string, timestamp int64, valu
 			timestamp: timestamp,
 			values:    make(map[string]float64),
 		})
+		if excess := len(c.samples) - c.maxSamples; c.maxSamples > 0 && excess > 0 {
+			c.samples = c.samples[excess:]
+		}
 	}
 	c.samples[len(c.samples)-1].values[seriesName] = value
 	c.lastUpdate = time.Unix(timestamp, 0)
@@ -216,6 +229,9 @@ func (c *FrenchFriesChart) ToggleHeatmapMode() bool { return false }
 
 func (c *FrenchFriesChart) IsHeatmapMode() bool { return true }
 
+// SetAggregated is a no-op: the heatmap already summarizes all series.
+func (c *FrenchFriesChart) SetAggregated(bool) {}
+
 func (c *FrenchFriesChart) ViewModeLabel() string {
 	viewMinX, viewMaxX := c.effectiveViewWindow()
 	span := viewMaxX - viewMinX
@@ -117,6 +117,12 @@ func (c *frenchFriesToggleChart) ToggleHeatmapMode() bool {
 
 func (c *frenchFriesToggleChart) IsHeatmapMode() bool { return c.heatmapMode }
 
+// SetAggregated switches the line chart between per-device series and
+// their mean; the heatmap always shows the distribution across devices.
+func (c *frenchFriesToggleChart) SetAggregated(on bool) {
+	c.line.SetAggregated(on)
+}
+
 func (c *frenchFriesToggleChart) ViewModeLabel() string {
 	return c.line.ViewModeLabel()
 }
@@ -3,6 +3,9 @@ package leet_test
 import (
 	"path/filepath"
 	"testing"
+	"time"
+
+	tea "charm.land/bubbletea/v2"
 
 	"github.com/stretchr/testify/require"
 
@@ -83,3 +86,38 @@ func TestWorkspaceHandleWorkspaceRecord_ArmsHeartbeatAfterWatcherStarts(t *testi
 	require.True(t, workspace.TestHeartbeatTimerArmed())
 	workspace.TestStopHeartbeat()
 }
+
+func TestWorkspaceHeartbeat_AdvancesLastUpdateWithoutNewRecords(t *testing.T) {
+	logger := observability.NewNoOpLogger()
+	cfg := leet.NewConfigManager(filepath.Join(t.TempDir(), "config.json"), logger)
+	workspace := leet.NewWorkspace(t.TempDir(), cfg, logger)
+	_ = workspace.Update(tea.WindowSizeMsg{Width: 200, Height: 40})
+
+	now := time.Date(2026, time.March, 1, 12, 0, 0, 0, time.UTC)
+	workspace.TestSetClock(func() time.Time { return now })
+
+	runKey := "run-20260301_120000-live"
+	workspace.TestApplyRunKeys([]string{runKey})
+	run := leet.TestNewWorkspaceRun(runKey)
+	run.TestSetWatcherStarted(true)
+	workspace.TestAttachRun(run, true)
+	workspace.TestHandleWorkspaceRecord(run, leet.RunMsg{ID: "live"})
+	workspace.TestHandleWorkspaceRecord(run, leet.HistoryMsg{
+		Metrics: map[string]leet.MetricData{
+			"loss": {X: []float64{1}, Y: []float64{0.5}},
+		},
+	})
+	defer workspace.TestStopHeartbeat()
+	require.Contains(t, stripANSI(workspace.View().Content), "updated 0s ago")
+
+	now = now.Add(5 * time.Second)
+	require.NotNil(t, workspace.Update(leet.HeartbeatMsg{}))
+	require.Contains(t, stripANSI(workspace.View().Content), "updated 5s ago")
+
+	now = now.Add(7 * time.Second)
+	require.NotNil(t, workspace.Update(leet.HeartbeatMsg{}))
+	require.Contains(t, stripANSI(workspace.View().Content), "updated 12s ago")
+
+	// The periodic redraw keeps itself scheduled while the run is live.
+	require.NotNil(t, workspace.Update(leet.WorkspaceLiveRedrawMsg{}))
+}
@@ -0,0 +1,130 @@
+package leet
+
+import (
+	"fmt"
+	"maps"
+	"math"
+	"slices"
+)
+
+// histogramType is the "_type" of a histogram logged with wandb.Histogram,
+// as wandb.watch does for each layer's gradients and parameters.
+const histogramType = "histogram"
+
+// HistogramStats summarizes a histogram logged at a step.
+type HistogramStats struct {
+	Step float64
+	Mean float64
+	Std  float64
+}
+
+// isHistogramObject reports whether a logged object is a histogram.
+func isHistogramObject(obj map[string]any) bool {
+	return obj["_type"] == histogramType
+}
+
+// histogramStats returns the mean and standard deviation of a histogram
+// object, placing each bin's count at the bin's center.
+//
+// Bins are either explicit edges ("bins") or evenly sized ("packedBins").
+func histogramStats(obj map[string]any) (mean, std float64, ok bool) {
+	counts, ok := floatList(obj["values"])
+	if !ok || len(counts) == 0 {
+		return 0, 0, false
+	}
+
+	centers := make([]float64, len(counts))
+	if edges, ok := floatList(obj["bins"]); ok && len(edges) == len(counts)+1 {
+		for i := range counts {
+			centers[i] = (edges[i] + edges[i+1]) / 2
+		}
+	} else if packed, ok := obj["packedBins"].(map[string]any); ok {
+		lo, okLo := toFloat(packed["min"])
+		size, okSize := toFloat(packed["size"])
+		if !okLo || !okSize {
+			return 0, 0, false
+		}
+		for i := range counts {
+			centers[i] = lo + (float64(i)+0.5)*size
+		}
+	} else {
+		return 0, 0, false
+	}
+
+	var total, sum float64
+	for i, c := range counts {
+		total += c
+		sum += c * centers[i]
+	}
+	if total <= 0 {
+		return 0, 0, false
+	}
+	mean = sum / total
+
+	var sq float64
+	for i, c := range counts {
+		d := centers[i] - mean
+		sq += c * d * d
+	}
+	return mean, math.Sqrt(sq / total), isFinite(mean)
+}
+
+// floatList converts a decoded JSON array of numbers.
+func floatList(v any) ([]float64, bool) {
+	list, ok := v.([]any)
+	if !ok {
+		return nil, false
+	}
+	out := make([]float64, len(list))
+	for i, x := range list {
+		if out[i], ok = toFloat(x); !ok {
+			return nil, false
+		}
+	}
+	return out, true
+}
+
+// toFloat converts a decoded JSON number.
+func toFloat(v any) (float64, bool) {
+	switch x := v.(type) {
+	case int64:
+		return float64(x), true
+	case float64:
+		return x, true
+	default:
+		return 0, false
+	}
+}
+
+// formatHistogramStats renders a histogram's mean and standard deviation.
+func formatHistogramStats(mean, std float64) string {
+	return fmt.Sprintf("μ %s σ %s", formatSigFigs(mean, 3), formatSigFigs(std, 3))
+}
+
+// ProcessHistograms records the latest stats of each logged histogram.
+func (ro *RunOverview) ProcessHistograms(histograms map[string]HistogramStats) {
+	for key, h := range histograms {
+		if prev, ok := ro.histograms[key]; ok && h.Step < prev.Step {
+			continue
+		}
+		if ro.histograms == nil {
+			ro.histograms = make(map[string]HistogramStats)
+		}
+		ro.histograms[key] = h
+	}
+}
+
+// HistogramItems returns the latest stats of each logged histogram,
+// sorted by key.
+func (ro *RunOverview) HistogramItems() []KeyValuePair {
+	items := make([]KeyValuePair, 0, len(ro.histograms))
+	for _, key := range slices.Sorted(maps.Keys(ro.histograms)) {
+		h := ro.histograms[key]
+		items = append(items, KeyValuePair{
+			Key:   key,
+			Value: formatHistogramStats(h.Mean, h.Std) + " @" + formatXValue(h.Step),
+			Path:  []string{key},
+		})
+	}
+	return items
+}
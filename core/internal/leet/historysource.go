@@ -70,6 +70,11 @@ func concatenateHistory(messages []HistoryMsg, runPath string) HistoryMsg {
 		Media:   make(map[string][]MediaPoint),
 	}
 	for _, msg := range messages {
+		for _, key := range msg.Keys {
+			if _, seen := h.Metrics[key]; !seen {
+				h.Keys = append(h.Keys, key)
+			}
+		}
 		for metricName, data := range msg.Metrics {
 			existing := h.Metrics[metricName]
 			existing.X = append(existing.X, data.X...)
@@ -83,6 +88,7 @@ func concatenateHistory(messages []HistoryMsg, runPath string) HistoryMsg {
 
 	if len(h.Metrics) == 0 {
 		h.Metrics = nil
+		h.Keys = nil
 	}
 	if len(h.Media) == 0 {
 		h.Media = nil
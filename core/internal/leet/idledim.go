@@ -0,0 +1,35 @@
+package leet
+
+import (
+	"time"
+
+	tea "charm.land/bubbletea/v2"
+)
+
+// NoteInput records a key press or mouse event, which undims the charts.
+//
+// It returns a command that redraws the view once unfocused charts
+// should dim, or nil if dimming is disabled.
+func (mg *MetricsGrid) NoteInput() tea.Cmd {
+	mg.mu.Lock()
+	mg.lastInput = mg.now()
+	mg.mu.Unlock()
+
+	delay := mg.config.IdleDimDelay()
+	if delay <= 0 {
+		return nil
+	}
+	return tea.Tick(delay, func(time.Time) tea.Msg { return ChartsIdleMsg{} })
+}
+
+// dimsChartNoLock reports whether the chart in the given cell of the
+// current page is dimmed: dimming is enabled, the user has been idle long
+// enough, and another chart has focus.
+func (mg *MetricsGrid) dimsChartNoLock(row, col int) bool {
+	if mg.focus.Type != FocusMainChart ||
+		(row == mg.focus.Row && col == mg.focus.Col) {
+		return false
+	}
+	delay := mg.config.IdleDimDelay()
+	return delay > 0 && mg.now().Sub(mg.lastInput) >= delay
+}
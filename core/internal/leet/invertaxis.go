@@ -0,0 +1,17 @@
+package leet
+
+// SetInvertY flips the Y axis so lower values are drawn at the top.
+//
+// Useful for lower-is-better metrics such as loss, where an improving
+// run then trends upward like the others.
+func (c *EpochLineChart) SetInvertY(invert bool) {
+	if c.invertY == invert {
+		return
+	}
+	c.invertY = invert
+	c.updateRanges()
+	c.dirty = true
+}
+
+// IsInvertedY reports whether the Y axis is inverted.
+func (c *EpochLineChart) IsInvertedY() bool { return c.invertY }
@@ -40,10 +40,18 @@ func RunKeyBindings() []BindingCategory[Run] {
 					Keys:        []string{"alt+r"},
 					Description: "Restart",
 				},
+				{
+					Keys:        []string{"alt+a"},
+					Description: "Freeze/resume animations (for screen recording)",
+				},
 				{
 					Keys:        []string{"esc"},
 					Description: "Back to workspace (when not filtering/configuring)",
 				},
+				{
+					Keys:        []string{"backspace"},
+					Description: "Back to the previously viewed run",
+				},
 			},
 		},
 		{
@@ -74,6 +82,11 @@ func RunKeyBindings() []BindingCategory[Run] {
 					Description: "Toggle console logs panel",
 					Handler:     (*Run).handleToggleConsoleLogsPane,
 				},
+				{
+					Keys:        []string{"L"},
+					Description: "Show/hide console log timestamps",
+					Handler:     (*Run).handleToggleConsoleLogTimestamps,
+				},
 			},
 		},
 		{
@@ -113,6 +126,66 @@ func RunKeyBindings() []BindingCategory[Run] {
 					Description: "Cycle focused chart mode (log Y / heatmap)",
 					Handler:     (*Run).handleCycleFocusedChartMode,
 				},
+				{
+					Keys:        []string{"b"},
+					Description: "Mark best point of focused chart (min / max / off)",
+					Handler:     (*Run).handleCycleChartObjective,
+				},
+				{
+					Keys:        []string{"U"},
+					Description: "Invert Y axis of focused chart (persisted per metric)",
+					Handler:     (*Run).handleToggleChartInvertY,
+				},
+				{
+					Keys:        []string{"A"},
+					Description: "Overlay next metric on a right-hand axis of focused chart",
+					Handler:     (*Run).handleCycleChartSecondary,
+				},
+				{
+					Keys:        []string{"Y"},
+					Description: "Copy the last inspected system metrics point",
+					Handler:     (*Run).handleCopyInspectedPoint,
+				},
+				{
+					Keys:        []string{"*"},
+					Description: "Keep focused chart in the first cell of every page (toggle)",
+					Handler:     (*Run).handleToggleChartHero,
+				},
+				{
+					Keys:        []string{"t"},
+					Description: "Toggle x-axis step labels: abbreviated (1.2M) / exact",
+					Handler:     (*Run).handleToggleRawXTicks,
+				},
+				{
+					Keys:        []string{"O"},
+					Description: "Toggle metrics order: alphabetical / as logged",
+					Handler:     (*Run).handleToggleMetricsSortOrder,
+				},
+				{
+					Keys:        []string{"m"},
+					Description: "Toggle rolling min/max band behind metrics lines",
+					Handler:     (*Run).handleToggleMinMaxBand,
+				},
+				{
+					Keys:        []string{"M"},
+					Description: "Toggle system charts: per device / mean across devices",
+					Handler:     (*Run).handleToggleSystemMetricsAggregation,
+				},
+				{
+					Keys:        []string{"|"},
+					Description: "Toggle scrub cursor across all charts (←/→ to move)",
+					Handler:     (*Run).handleToggleScrub,
+				},
+				{
+					Keys:        []string{"J"},
+					Description: "Jump all charts to a step",
+					Handler:     (*Run).handleEnterJumpToStep,
+				},
+				{
+					Keys:        []string{"X"},
+					Description: "Export all metric series to a JSON file",
+					Handler:     (*Run).handleExportMetrics,
+				},
 				{
 					Keys:        []string{"/"},
 					Description: "Filter metrics by pattern",
@@ -128,6 +201,16 @@ func RunKeyBindings() []BindingCategory[Run] {
 					Description: "Clear metrics filter",
 					Handler:     (*Run).handleClearMetricsFilter,
 				},
+				{
+					Keys:        []string{"W"},
+					Description: "Save metrics filter as a preset",
+					Handler:     (*Run).handleSaveMetricsFilterPreset,
+				},
+				{
+					Keys:        []string{"N"},
+					Description: "Apply next metrics filter preset",
+					Handler:     (*Run).handleCycleMetricsFilterPreset,
+				},
 				{
 					Keys:        []string{"ctrl+\\"},
 					Description: "Clear system metrics filter",
@@ -135,6 +218,31 @@ func RunKeyBindings() []BindingCategory[Run] {
 				},
 			},
 		},
+		{
+			Name: "Replay (finished runs)",
+			Bindings: []KeyBinding[Run]{
+				{
+					Keys:        []string{"v"},
+					Description: "Start/stop replaying the run's charts over time",
+					Handler:     (*Run).handleToggleReplay,
+				},
+				{
+					Keys:        []string{"p"},
+					Description: "Play/pause replay",
+					Handler:     (*Run).handleReplayPlayPause,
+				},
+				{
+					Keys:        []string{"+", "="},
+					Description: "Faster replay",
+					Handler:     (*Run).handleReplayFaster,
+				},
+				{
+					Keys:        []string{"-"},
+					Description: "Slower replay",
+					Handler:     (*Run).handleReplaySlower,
+				},
+			},
+		},
 		{
 			Name: "Run Overview",
 			Bindings: []KeyBinding[Run]{
@@ -148,6 +256,25 @@ func RunKeyBindings() []BindingCategory[Run] {
 					Description: "Clear overview filter",
 					Handler:     (*Run).handleClearOverviewFilter,
 				},
+				{
+					Keys:        []string{"i"},
+					Description: "Jump overview focus between Summary and Config",
+					Handler:     (*Run).handleJumpOverviewQuickView,
+				},
+				{
+					Keys:        []string{"z"},
+					Description: "Collapse/expand focused overview section",
+				},
+				{
+					Keys:        []string{"x"},
+					Description: "Export config as Python dict / YAML (clipboard or file)",
+					Handler:     (*Run).handleOpenConfigExport,
+				},
+				{
+					Keys:        []string{"E"},
+					Description: "Export run overview (config, summary, environment) to a YAML file",
+					Handler:     (*Run).handleExportOverview,
+				},
 			},
 		},
 		{
@@ -194,6 +321,10 @@ func RunKeyBindings() []BindingCategory[Run] {
 					Description: "Page next (list) / chart focus right (grid) / scrub +1 in media (arrow only)",
 					Handler:     (*Run).handleSidebarPageNav,
 				},
+				{
+					Keys:        []string{"+", "-"},
+					Description: "Grow / shrink console logs pane (console logs focused)",
+				},
 				{
 					Keys:        []string{"l"},
 					Description: "Link scrubbing: arrow keys scrub all media series in sync (media pane focused)",
@@ -228,6 +359,10 @@ func WorkspaceKeyBindings() []BindingCategory[Workspace] {
 					Keys:        []string{"alt+r"},
 					Description: "Restart LEET",
 				},
+				{
+					Keys:        []string{"alt+a"},
+					Description: "Freeze/resume animations (for screen recording)",
+				},
 				{
 					Keys:        []string{"esc"},
 					Description: "Focus runs list",
@@ -235,7 +370,12 @@ func WorkspaceKeyBindings() []BindingCategory[Workspace] {
 				},
 				{
 					Keys:        []string{"enter"},
-					Description: "View selected run (when not filtering/configuring)",
+					Description: "View or select the highlighted run (see the run list Enter setting)",
+					Handler:     (*Workspace).handleRunListEnter,
+				},
+				{
+					Keys:        []string{"alt+enter"},
+					Description: "View the highlighted run",
 				},
 			},
 		},
@@ -272,6 +412,21 @@ func WorkspaceKeyBindings() []BindingCategory[Workspace] {
 					Description: "Toggle console logs panel",
 					Handler:     (*Workspace).handleToggleConsoleLogsPane,
 				},
+				{
+					Keys:        []string{"L"},
+					Description: "Show/hide console log timestamps",
+					Handler:     (*Workspace).handleToggleConsoleLogTimestamps,
+				},
+				{
+					Keys:        []string{"e"},
+					Description: "Switch console logs between the current run and errors from all selected runs",
+					Handler:     (*Workspace).handleToggleConsoleErrorsView,
+				},
+				{
+					Keys:        []string{"C"},
+					Description: "Compare focused system chart's latest / peak values across selected runs",
+					Handler:     (*Workspace).handleToggleSystemMetricCompare,
+				},
 			},
 		},
 		{
@@ -316,6 +471,51 @@ func WorkspaceKeyBindings() []BindingCategory[Workspace] {
 					Description: "Clear runs filter",
 					Handler:     (*Workspace).handleClearRunsFilter,
 				},
+				{
+					Keys:        []string{"F"},
+					Description: "Toggle recent runs only (newest N by start time)",
+					Handler:     (*Workspace).handleToggleRecentRuns,
+				},
+				{
+					Keys:        []string{"alt+s"},
+					Description: "Sort runs newest first / by name / running first",
+					Handler:     (*Workspace).handleCycleRunListOrder,
+				},
+				{
+					Keys:        []string{"D"},
+					Description: "Deselect finished runs (keep live runs selected)",
+					Handler:     (*Workspace).handleDeselectFinishedRuns,
+				},
+				{
+					Keys:        []string{"X"},
+					Description: "Deselect all runs",
+					Handler:     (*Workspace).handleDeselectAllRuns,
+				},
+				{
+					Keys:        []string{"u"},
+					Description: "Undo the last bulk deselect (within the configured window)",
+					Handler:     (*Workspace).handleUndoDeselect,
+				},
+				{
+					Keys:        []string{"shift+up"},
+					Description: "Move highlighted run earlier in chart overlays and legends",
+					Handler:     (*Workspace).handleMoveRunUp,
+				},
+				{
+					Keys:        []string{"shift+down"},
+					Description: "Move highlighted run later in chart overlays and legends (drawn above)",
+					Handler:     (*Workspace).handleMoveRunDown,
+				},
+				{
+					Keys:        []string{"I"},
+					Description: "Copy selected run IDs to clipboard (one per line)",
+					Handler:     (*Workspace).handleCopySelectedRunIDs,
+				},
+				{
+					Keys:        []string{"T"},
+					Description: "Tee current run's console logs to a file (toggle)",
+					Handler:     (*Workspace).handleToggleConsoleTee,
+				},
 			},
 		},
 		{
@@ -326,6 +526,71 @@ func WorkspaceKeyBindings() []BindingCategory[Workspace] {
 					Description: "Cycle focused chart mode (log Y / heatmap)",
 					Handler:     (*Workspace).handleCycleFocusedChartMode,
 				},
+				{
+					Keys:        []string{"b"},
+					Description: "Mark best point of focused chart (min / max / off)",
+					Handler:     (*Workspace).handleCycleChartObjective,
+				},
+				{
+					Keys:        []string{"U"},
+					Description: "Invert Y axis of focused chart (persisted per metric)",
+					Handler:     (*Workspace).handleToggleChartInvertY,
+				},
+				{
+					Keys:        []string{"A"},
+					Description: "Overlay next metric on a right-hand axis of focused chart",
+					Handler:     (*Workspace).handleCycleChartSecondary,
+				},
+				{
+					Keys:        []string{"Y"},
+					Description: "Copy the last inspected system metrics point",
+					Handler:     (*Workspace).handleCopyInspectedPoint,
+				},
+				{
+					Keys:        []string{"*"},
+					Description: "Keep focused chart in the first cell of every page (toggle)",
+					Handler:     (*Workspace).handleToggleChartHero,
+				},
+				{
+					Keys:        []string{"t"},
+					Description: "Toggle x-axis step labels: abbreviated (1.2M) / exact",
+					Handler:     (*Workspace).handleToggleRawXTicks,
+				},
+				{
+					Keys:        []string{"O"},
+					Description: "Toggle metrics order: alphabetical / as logged",
+					Handler:     (*Workspace).handleToggleMetricsSortOrder,
+				},
+				{
+					Keys:        []string{"m"},
+					Description: "Toggle rolling min/max band behind metrics lines",
+					Handler:     (*Workspace).handleToggleMinMaxBand,
+				},
+				{
+					Keys:        []string{"M"},
+					Description: "Toggle system charts: per device / mean across devices",
+					Handler:     (*Workspace).handleToggleSystemMetricsAggregation,
+				},
+				{
+					Keys:        []string{"g"},
+					Description: "Toggle run legend on overlaid charts",
+					Handler:     (*Workspace).handleToggleChartLegend,
+				},
+				{
+					Keys:        []string{"|"},
+					Description: "Toggle scrub cursor across all charts (←/→ to move)",
+					Handler:     (*Workspace).handleToggleScrub,
+				},
+				{
+					Keys:        []string{"J"},
+					Description: "Jump all charts to a step",
+					Handler:     (*Workspace).handleEnterJumpToStep,
+				},
+				{
+					Keys:        []string{"V"},
+					Description: "Export focused chart (or all charts on the page) as SVG",
+					Handler:     (*Workspace).handleExportChartsSVG,
+				},
 				{
 					Keys:        []string{"/"},
 					Description: "Filter metrics by pattern",
@@ -342,6 +607,16 @@ func WorkspaceKeyBindings() []BindingCategory[Workspace] {
 					Description: "Clear metrics filter",
 					Handler:     (*Workspace).handleClearMetricsFilter,
 				},
+				{
+					Keys:        []string{"W"},
+					Description: "Save metrics filter as a preset",
+					Handler:     (*Workspace).handleSaveMetricsFilterPreset,
+				},
+				{
+					Keys:        []string{"N"},
+					Description: "Apply next metrics filter preset",
+					Handler:     (*Workspace).handleCycleMetricsFilterPreset,
+				},
 				{
 					Keys:        []string{"ctrl+\\"},
 					Description: "Clear system metrics filter",
@@ -362,6 +637,25 @@ func WorkspaceKeyBindings() []BindingCategory[Workspace] {
 					Description: "Clear overview filter",
 					Handler:     (*Workspace).handleClearOverviewFilter,
 				},
+				{
+					Keys:        []string{"i"},
+					Description: "Jump overview focus between Summary and Config",
+					Handler:     (*Workspace).handleJumpOverviewQuickView,
+				},
+				{
+					Keys:        []string{"z"},
+					Description: "Collapse/expand focused overview section",
+				},
+				{
+					Keys:        []string{"x"},
+					Description: "Export current run config as Python dict / YAML",
+					Handler:     (*Workspace).handleOpenConfigExport,
+				},
+				{
+					Keys:        []string{"E"},
+					Description: "Export current run overview (config, summary, environment) to a YAML file",
+					Handler:     (*Workspace).handleExportOverview,
+				},
 			},
 		},
 		{
@@ -417,6 +711,20 @@ func WorkspaceKeyBindings() []BindingCategory[Workspace] {
 					Description: "Pin/unpin selected run",
 					Handler:     (*Workspace).handlePinRunKey,
 				},
+				{
+					Keys:        []string{"P"},
+					Description: "Jump to pinned run in the runs list",
+					Handler:     (*Workspace).handleJumpToPinnedRun,
+				},
+				{
+					Keys:        []string{"S"},
+					Description: "Cycle the summary metric shown in the runs list",
+					Handler:     (*Workspace).handleCycleRunListMetric,
+				},
+				{
+					Keys:        []string{"+", "-"},
+					Description: "Grow / shrink console logs pane (console logs focused)",
+				},
 				{
 					Keys:        []string{"l"},
 					Description: "Link scrubbing: arrow keys scrub all media series in sync (media pane focused)",
@@ -451,6 +759,10 @@ func SymonKeyBindings() []BindingCategory[Symon] {
 					Keys:        []string{"alt+r"},
 					Description: "Restart",
 				},
+				{
+					Keys:        []string{"alt+a"},
+					Description: "Freeze/resume animations (for screen recording)",
+				},
 			},
 		},
 		{
@@ -494,6 +806,11 @@ func SymonKeyBindings() []BindingCategory[Symon] {
 					Description: "Toggle log Y on focused chart",
 					Handler:     (*Symon).handleToggleFocusedChartLogY,
 				},
+				{
+					Keys:        []string{"M"},
+					Description: "Toggle system charts: per device / mean across devices",
+					Handler:     (*Symon).handleToggleSystemMetricsAggregation,
+				},
 				{
 					Keys:        []string{"\\"},
 					Description: "Filter system metrics by pattern",
@@ -564,7 +881,7 @@ func mouseCategory[T any]() BindingCategory[T] {
 			},
 			{
 				Keys:        []string{"right-click+drag"},
-				Description: "Inspect: show (x, y) at nearest point on a chart",
+				Description: "Inspect: show (x, y) at nearest point on a chart (system charts report it on release)",
 			},
 			{
 				Keys:        []string{"alt+right-click+drag"},
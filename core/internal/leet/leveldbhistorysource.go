@@ -5,14 +5,18 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"maps"
 	"slices"
 	"strconv"
 	"strings"
 	"sync"
 	"time"
+	"unicode"
 
 	tea "charm.land/bubbletea/v2"
 
+	"github.com/wandb/simplejsonext"
+
 	"github.com/wandb/wandb/core/internal/observability"
 	spb "github.com/wandb/wandb/core/pkg/service_go_proto"
 )
@@ -164,11 +168,13 @@ func (hs *LevelDBHistorySource) recordToMsg(record *spb.Record) tea.Msg {
 		return RunMsg{
 			RunPath:     hs.runPath,
 			ID:          rec.Run.GetRunId(),
+			Entity:      rec.Run.GetEntity(),
 			DisplayName: rec.Run.GetDisplayName(),
 			Project:     rec.Run.GetProject(),
 			Notes:       rec.Run.GetNotes(),
 			Tags:        slices.Clone(rec.Run.GetTags()),
 			Config:      rec.Run.GetConfig(),
+			Summary:     rec.Run.GetSummary(),
 		}
 	case *spb.Record_History:
 		return ParseHistory(hs.runPath, rec.History)
@@ -203,7 +209,9 @@ func ParseHistory(runPath string, history *spb.HistoryRecord) tea.Msg {
 
 	step := int(history.GetStep().GetNum())
 	values := make(map[string]float64, len(history.GetItem()))
+	var keys []string
 	mediaFieldsByKey := make(map[string]map[string]string)
+	histograms := make(map[string]HistogramStats)
 
 	for _, item := range history.GetItem() {
 		if item == nil {
@@ -228,7 +236,31 @@ func ParseHistory(runPath string, history *spb.HistoryRecord) tea.Msg {
 			continue
 		}
 
+		// Older SDKs logged dicts as one JSON object value under a flat key
+		// instead of one item per nested key.
+		if obj, ok := legacyHistoryObject(item.ValueJson); ok {
+			if isHistogramObject(obj) {
+				if mean, std, ok := histogramStats(obj); ok {
+					histograms[key] = HistogramStats{Mean: mean, Std: std}
+				}
+				continue
+			}
+			if _, isMedia := obj["_type"]; isMedia {
+				mediaFieldsByKey[key] = legacyMediaFields(obj)
+				continue
+			}
+			flattenLegacyHistoryObject(key, obj, values, &keys)
+			continue
+		}
+
 		v := trimJSONString(item.ValueJson)
+		// Booleans plot as 0/1 and are drawn as step plots.
+		switch item.ValueJson {
+		case "true":
+			v = "1"
+		case "false":
+			v = "0"
+		}
 		if key == "_step" {
 			if s, err := strconv.Atoi(v); err == nil {
 				step = s
@@ -239,6 +271,9 @@ func ParseHistory(runPath string, history *spb.HistoryRecord) tea.Msg {
 			continue
 		}
 		if val, err := strconv.ParseFloat(v, 64); err == nil {
+			if _, seen := values[key]; !seen {
+				keys = append(keys, key)
+			}
 			values[key] = val
 		}
 	}
@@ -253,20 +288,89 @@ func ParseHistory(runPath string, history *spb.HistoryRecord) tea.Msg {
 
 	media := parseHistoryMedia(runPath, step, mediaFieldsByKey)
 
-	if len(metrics) == 0 && len(media) == 0 {
+	for key, h := range histograms {
+		h.Step = float64(step)
+		histograms[key] = h
+	}
+
+	if len(metrics) == 0 && len(media) == 0 && len(histograms) == 0 {
 		return nil
 	}
 
 	msg := HistoryMsg{RunPath: runPath}
 	if len(metrics) > 0 {
 		msg.Metrics = metrics
+		msg.Keys = keys
 	}
 	if len(media) > 0 {
 		msg.Media = media
 	}
+	if len(histograms) > 0 {
+		msg.Histograms = histograms
+	}
 	return msg
 }
 
+// legacyHistoryObject decodes a history value that is a JSON object.
+func legacyHistoryObject(valueJSON string) (map[string]any, bool) {
+	if !strings.HasPrefix(strings.TrimSpace(valueJSON), "{") {
+		return nil, false
+	}
+	v, err := simplejsonext.UnmarshalString(valueJSON)
+	if err != nil {
+		return nil, false
+	}
+	obj, ok := v.(map[string]any)
+	return obj, ok
+}
+
+// flattenLegacyHistoryObject records the numeric leaves of a history
+// value logged as a JSON object, keyed by their dotted path under prefix.
+func flattenLegacyHistoryObject(
+	prefix string,
+	obj map[string]any,
+	values map[string]float64,
+	keys *[]string,
+) {
+	for _, k := range slices.Sorted(maps.Keys(obj)) {
+		key := prefix + "." + k
+		var val float64
+		switch x := obj[k].(type) {
+		case map[string]any:
+			if _, isMedia := x["_type"]; !isMedia {
+				flattenLegacyHistoryObject(key, x, values, keys)
+			}
+			continue
+		case int64:
+			val = float64(x)
+		case float64:
+			val = x
+		default:
+			continue
+		}
+		if _, seen := values[key]; !seen {
+			*keys = append(*keys, key)
+		}
+		values[key] = val
+	}
+}
+
+// legacyMediaFields converts a media object logged as one JSON value to
+// the per-field strings that nested media items carry.
+func legacyMediaFields(obj map[string]any) map[string]string {
+	fields := make(map[string]string, len(obj))
+	for field, v := range obj {
+		if s, ok := v.(string); ok {
+			fields[field] = s
+			continue
+		}
+		if s, err := simplejsonext.MarshalToString(v); err == nil {
+			fields[field] = s
+		}
+	}
+	return fields
+}
+
 func trimJSONString(v string) string {
 	if v == "" {
 		return ""
@@ -384,11 +488,7 @@ func ParseStats(runPath string, stats *spb.StatsRecord) tea.Msg {
 			continue
 		}
 
-		v := item.ValueJson
-		if n := len(v); n >= 2 && v[0] == '"' && v[n-1] == '"' {
-			v = v[1 : n-1]
-		}
-		if value, err := strconv.ParseFloat(v, 64); err == nil {
+		if value, ok := parseStatValue(item.ValueJson); ok {
 			metrics[item.Key] = value
 		}
 	}
@@ -399,6 +499,22 @@ func ParseStats(runPath string, stats *spb.StatsRecord) tea.Msg {
 	return nil
 }
 
+// parseStatValue parses a stats item's JSON value as a finite number.
+//
+// Values may arrive quoted and with a trailing unit, as in "42" or
+// "85.3 %"; the unit is dropped. Anything else is rejected.
+func parseStatValue(valueJSON string) (float64, bool) {
+	v := strings.TrimSpace(trimJSONString(strings.TrimSpace(valueJSON)))
+	v = strings.TrimRightFunc(v, func(r rune) bool {
+		return unicode.IsLetter(r) || r == '%'
+	})
+	value, err := strconv.ParseFloat(strings.TrimSpace(v), 64)
+	if err != nil || !isFinite(value) {
+		return 0, false
+	}
+	return value, true
+}
+
 // parseOutputRaw extracts a ConsoleLogMsg from an OutputRawRecord.
 func parseOutputRaw(runPath string, rec *spb.OutputRawRecord) tea.Msg {
 	if rec == nil {
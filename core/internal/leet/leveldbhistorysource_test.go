@@ -40,6 +40,87 @@ func TestParseHistory_StepAndMetrics(t *testing.T) {
 	require.Equal(t, 0.5, msg.Metrics["loss"].Y[0])
 }
 
+func TestParseHistory_LegacyObjectValues(t *testing.T) {
+	h := &spb.HistoryRecord{Item: []*spb.HistoryItem{
+		{Key: "_step", ValueJson: "4"},
+		{Key: "eval", ValueJson: `{"acc": 0.9, "inner": {"loss": 2}, "note": "x"}`},
+		{Key: "sample", ValueJson: `{"_type": "image-file", "path": "media/images/s.png", "width": 8, "height": 8}`},
+	}}
+	msg := leet.ParseHistory("/some/run/path", h).(leet.HistoryMsg)
+
+	require.Equal(t, []string{"eval.acc", "eval.inner.loss"}, msg.Keys)
+	require.Equal(t, leet.MetricData{X: []float64{4}, Y: []float64{0.9}}, msg.Metrics["eval.acc"])
+	require.Equal(t, leet.MetricData{X: []float64{4}, Y: []float64{2}}, msg.Metrics["eval.inner.loss"])
+	require.Len(t, msg.Media["sample"], 1)
+}
+
+func TestParseHistory_Histograms_GoToOverviewNotCharts(t *testing.T) {
+	h := &spb.HistoryRecord{Item: []*spb.HistoryItem{
+		{NestedKey: []string{"_step"}, ValueJson: "3"},
+		{NestedKey: []string{"loss"}, ValueJson: "0.5"},
+		{
+			Key:       "gradients/fc.weight",
+			ValueJson: `{"_type": "histogram", "values": [1, 2, 1], "bins": [-1, 0, 1, 2]}`,
+		},
+		{
+			Key: "parameters/fc.bias",
+			ValueJson: `{"_type": "histogram", "values": [1, 1],` +
+				` "packedBins": {"min": 0, "size": 1, "count": 2}}`,
+		},
+	}}
+	msg := leet.ParseHistory("/some/run/path", h).(leet.HistoryMsg)
+
+	require.Equal(t, []string{"loss"}, msg.Keys)
+	require.Len(t, msg.Metrics, 1)
+	require.Len(t, msg.Histograms, 2)
+
+	logger := observability.NewNoOpLogger()
+	cfg := leet.NewConfigManager(filepath.Join(t.TempDir(), "config.json"), logger)
+	grid := leet.NewMetricsGrid(cfg, cfg.MetricsGrid, leet.NewFocus(), logger)
+	grid.ProcessHistory(msg)
+	require.Equal(t, 1, grid.ChartCount(), "only the scalar is charted")
+
+	ro := leet.NewRunOverview()
+	ro.ProcessHistograms(msg.Histograms)
+	require.Equal(t, []leet.KeyValuePair{
+		{Key: "gradients/fc.weight", Value: "μ 0.5 σ 0.707 @3", Path: []string{"gradients/fc.weight"}},
+		{Key: "parameters/fc.bias", Value: "μ 1 σ 0.5 @3", Path: []string{"parameters/fc.bias"}},
+	}, ro.HistogramItems())
+}
+
+func TestLevelDBHistorySource_LegacySummaryInRunRecord_ReachesOverview(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "legacy.wandb")
+	w, err := transactionlog.OpenWriter(path)
+	require.NoError(t, err)
+	require.NoError(t, w.Write(&spb.Record{RecordType: &spb.Record_Run{Run: &spb.RunRecord{
+		RunId: "old1",
+		Summary: &spb.SummaryRecord{Update: []*spb.SummaryItem{
+			{Key: "best", ValueJson: `{"acc": 0.93}`},
+			{Key: "_runtime", ValueJson: "90.5"},
+		}},
+	}}}))
+	require.NoError(t, w.Close())
+
+	reader, err := leet.NewLevelDBHistorySource(path, observability.NewNoOpLogger())
+	require.NoError(t, err)
+	defer reader.Close()
+	msg, err := reader.Read(10, time.Second)
+	if err != nil {
+		require.ErrorIs(t, err, io.EOF)
+	}
+	run, ok := leet.FindRunMsg(msg)
+	require.True(t, ok)
+
+	ro := leet.NewRunOverview()
+	ro.ProcessRunMsg(run)
+	require.Contains(t, ro.SummaryItems(), leet.KeyValuePair{
+		Key: "best.acc", Value: "0.93", Path: []string{"best", "acc"}, TypeHint: "float",
+	})
+	runtime, ok := ro.Runtime()
+	require.True(t, ok)
+	require.Equal(t, 90*time.Second, runtime)
+}
+
 func TestReadAllRecordsChunked_HistoryThenExit(t *testing.T) {
 	path := filepath.Join(t.TempDir(), "chunky.wandb")
 
@@ -343,6 +424,34 @@ func TestParseStats_ComplexMetrics(t *testing.T) {
 	require.NotContains(t, msg.Metrics, "invalid")
 }
 
+func TestParseStats_SkipsUnparseableValues(t *testing.T) {
+	stats := &spb.StatsRecord{
+		Timestamp: &timestamppb.Timestamp{Seconds: 1234567890},
+		Item: []*spb.StatsItem{
+			{Key: "cpu", ValueJson: "42"},
+			{Key: "gpu.0.gpu", ValueJson: `"17.5"`},
+			{Key: "gpu.0.temp", ValueJson: `" 75 "`},
+			{Key: "memory_percent", ValueJson: `"85.3%"`},
+			{Key: "power", ValueJson: "120 W"},
+			{Key: "garbage", ValueJson: `"n/a"`},
+			{Key: "empty", ValueJson: `""`},
+			{Key: "nan", ValueJson: "NaN"},
+			{Key: "inf", ValueJson: `"Infinity"`},
+			{Key: "object", ValueJson: `{"a": 1}`},
+		},
+	}
+
+	msg := leet.ParseStats("/some/run/path", stats).(leet.StatsMsg)
+
+	require.Equal(t, map[string]float64{
+		"cpu":            42,
+		"gpu.0.gpu":      17.5,
+		"gpu.0.temp":     75,
+		"memory_percent": 85.3,
+		"power":          120,
+	}, msg.Metrics)
+}
+
 func TestReadAvailableRecords_BatchProcessing(t *testing.T) {
 	path := filepath.Join(t.TempDir(), "batch.wandb")
 
@@ -409,3 +518,47 @@ func TestReadAvailableRecords_BatchProcessing(t *testing.T) {
 	require.False(t, chunkedBatchMsg.HasMore)
 	require.Equal(t, 0, len(chunkedBatchMsg.Msgs))
 }
+
+func TestInspectWandbFile_CountsRecordsByType(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "run.wandb")
+	w, err := transactionlog.OpenWriter(path)
+	require.NoError(t, err)
+
+	write := func(n int, rec *spb.Record) {
+		for range n {
+			require.NoError(t, w.Write(rec))
+		}
+	}
+	write(1, &spb.Record{RecordType: &spb.Record_Run{Run: &spb.RunRecord{RunId: "r"}}})
+	write(5, &spb.Record{RecordType: &spb.Record_History{History: &spb.HistoryRecord{
+		Item: []*spb.HistoryItem{{NestedKey: []string{"loss"}, ValueJson: "0.5"}},
+	}}})
+	write(2, &spb.Record{RecordType: &spb.Record_Summary{Summary: &spb.SummaryRecord{}}})
+	write(3, &spb.Record{RecordType: &spb.Record_Stats{Stats: &spb.StatsRecord{}}})
+	write(2, &spb.Record{RecordType: &spb.Record_OutputRaw{OutputRaw: &spb.OutputRawRecord{Line: "hi\n"}}})
+	write(1, &spb.Record{RecordType: &spb.Record_Output{Output: &spb.OutputRecord{Line: "hi\n"}}})
+	write(1, &spb.Record{RecordType: &spb.Record_Config{Config: &spb.ConfigRecord{}}})
+	write(1, &spb.Record{RecordType: &spb.Record_Exit{Exit: &spb.RunExitRecord{}}})
+	require.NoError(t, w.Close())
+
+	report, err := leet.InspectWandbFile(path)
+	require.NoError(t, err)
+
+	assert.Equal(t, map[string]int{
+		"run":     1,
+		"history": 5,
+		"summary": 2,
+		"stats":   3,
+		"output":  3,
+		"config":  1,
+		"exit":    1,
+	}, report.Records)
+	assert.Equal(t, 16, report.Total)
+	assert.Positive(t, report.Bytes)
+	assert.Empty(t, report.Warnings)
+}
+
+func TestInspectWandbFile_MissingFile(t *testing.T) {
+	_, err := leet.InspectWandbFile(filepath.Join(t.TempDir(), "nope.wandb"))
+	require.Error(t, err)
+}
@@ -0,0 +1,107 @@
+package leet
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	tea "charm.land/bubbletea/v2"
+)
+
+// liveUpdateSummaryTTL is how long a live update summary stays in the
+// status bar.
+const liveUpdateSummaryTTL = 5 * time.Second
+
+// summarizeRecords counts records by kind, e.g. "+12 history, +3 logs".
+//
+// Kinds are listed in a fixed order; kinds with no records are omitted.
+func summarizeRecords(msgs []tea.Msg) string {
+	var history, logs, stats, summary, run, system, exit int
+	for _, msg := range msgs {
+		switch msg.(type) {
+		case HistoryMsg:
+			history++
+		case ConsoleLogMsg:
+			logs++
+		case StatsMsg:
+			stats++
+		case SummaryMsg:
+			summary++
+		case RunMsg:
+			run++
+		case SystemInfoMsg:
+			system++
+		case FileCompleteMsg:
+			exit++
+		}
+	}
+
+	counts := []struct {
+		n     int
+		label string
+	}{
+		{history, "history"},
+		{logs, "logs"},
+		{stats, "stats"},
+		{summary, "summary"},
+		{run, "run"},
+		{system, "system info"},
+		{exit, "exit"},
+	}
+	parts := make([]string, 0, len(counts))
+	for _, c := range counts {
+		if c.n > 0 {
+			parts = append(parts, fmt.Sprintf("+%d %s", c.n, c.label))
+		}
+	}
+	return strings.Join(parts, ", ")
+}
+
+// noteLiveUpdate records a summary of a live read for the status bar.
+func (w *Workspace) noteLiveUpdate(runKey string, msgs []tea.Msg) {
+	if !w.config.LiveUpdateSummary() {
+		return
+	}
+	summary := summarizeRecords(msgs)
+	if summary == "" {
+		return
+	}
+	w.liveUpdate = w.shortRunLabel(runKey) + ": " + summary
+	w.liveUpdateAt = time.Now()
+}
+
+// scheduleLiveRedraw schedules the next periodic redraw while a run is
+// live, unless one is already pending or the interval is 0.
+func (w *Workspace) scheduleLiveRedraw() tea.Cmd {
+	interval := w.config.LiveRedrawInterval()
+	if interval <= 0 || w.liveRedrawPending || !w.anyRunRunning() {
+		return nil
+	}
+	w.liveRedrawPending = true
+	return tea.Tick(interval, func(time.Time) tea.Msg {
+		return WorkspaceLiveRedrawMsg{}
+	})
+}
+
+// handleLiveRedraw keeps the redraw ticking while a run is live.
+//
+// The message itself needs no handling: receiving it re-renders the view.
+func (w *Workspace) handleLiveRedraw() tea.Cmd {
+	w.liveRedrawPending = false
+	return w.scheduleLiveRedraw()
+}
+
+// lastUpdateStatus reports how long ago the highlighted live run last
+// delivered a record, e.g. "updated 5s ago".
+func (w *Workspace) lastUpdateStatus() string {
+	cur, ok := w.runs.CurrentItem()
+	if !ok {
+		return ""
+	}
+	run := w.runsByKey[cur.Key]
+	if run == nil || run.state != RunStateRunning || run.lastRecordAt.IsZero() {
+		return ""
+	}
+	ago := max(w.now().Sub(run.lastRecordAt), 0).Truncate(time.Second)
+	return "updated " + ago.String() + " ago"
+}
@@ -0,0 +1,145 @@
+package leet
+
+import (
+	"sort"
+	"strings"
+
+	"charm.land/lipgloss/v2"
+)
+
+// loggedBand holds the companion min/max keys a run logged around a
+// metric, each keyed by the steps it was logged at.
+type loggedBand struct {
+	lo, hi MetricData
+}
+
+// splitLoggedBands removes the companion min/max keys from metrics and
+// returns them by the name of their metric.
+//
+// Companions are matched by suffix alone, so that a band folds into its
+// metric even when the two arrive in different history batches.
+func splitLoggedBands(rules *metricRules, metrics map[string]MetricData) map[string]loggedBand {
+	if rules.bandMinSuffix == "" || rules.bandMaxSuffix == "" {
+		return nil
+	}
+
+	var bands map[string]loggedBand
+	for key, data := range metrics {
+		name, isMin := strings.CutSuffix(key, rules.bandMinSuffix)
+		if !isMin {
+			var isMax bool
+			if name, isMax = strings.CutSuffix(key, rules.bandMaxSuffix); !isMax {
+				continue
+			}
+		}
+		if name == "" {
+			continue
+		}
+
+		if bands == nil {
+			bands = make(map[string]loggedBand)
+		}
+		band := bands[name]
+		if isMin {
+			band.lo = data
+		} else {
+			band.hi = data
+		}
+		bands[name] = band
+		delete(metrics, key)
+	}
+	return bands
+}
+
+// addBandValues records data's finite values in band by step and
+// extends the series' band bounds with them.
+func (s *Series) addBandValues(band map[float64]float64, data MetricData) map[float64]float64 {
+	for i, step := range data.X {
+		if i >= len(data.Y) || !isFinite(data.Y[i]) {
+			continue
+		}
+		if band == nil {
+			band = make(map[float64]float64)
+		}
+		band[step] = data.Y[i]
+		s.bandMin = min(s.bandMin, data.Y[i])
+		s.bandMax = max(s.bandMax, data.Y[i])
+	}
+	return band
+}
+
+// updateBandBounds extends the series' band bounds with values.
+func (s *Series) updateBandBounds(values map[float64]float64) {
+	for _, v := range values {
+		s.bandMin = min(s.bandMin, v)
+		s.bandMax = max(s.bandMax, v)
+	}
+}
+
+// stepAt returns the step the series' i-th point was logged at.
+func (s *Series) stepAt(i int) float64 {
+	if s.steps == nil {
+		return s.X[i]
+	}
+	return s.steps[i]
+}
+
+// AddBand records the logged min/max band around key's series by step.
+//
+// The band may arrive before or after the points it surrounds; it is
+// shaded wherever both bounds were logged at a point's step. The Y range
+// grows to fit the band once the series has points.
+func (c *EpochLineChart) AddBand(key string, lo, hi MetricData) {
+	s, ok := c.data[key]
+	if !ok {
+		s = NewSeries(key, c.palette)
+		c.data[key] = s
+		c.order = append(c.order, key)
+	}
+
+	s.bandLo = s.addBandValues(s.bandLo, lo)
+	s.bandHi = s.addBandValues(s.bandHi, hi)
+	if len(s.X) == 0 {
+		return
+	}
+
+	c.yMin = min(c.yMin, s.bandMin)
+	c.yMax = max(c.yMax, s.bandMax)
+	c.updateRanges()
+	c.dirty = true
+}
+
+// drawLoggedBands shades each series' logged band within the view.
+//
+// Bands are drawn before the series so that lines stay on top.
+func (c *EpochLineChart) drawLoggedBands(graphStartX int) {
+	xRange := c.ViewMaxX() - c.ViewMinX()
+	yRange := c.ViewMaxY() - c.ViewMinY()
+	if xRange <= 0 || yRange <= 0 {
+		return
+	}
+
+	for _, key := range c.order {
+		s := c.data[key]
+		if len(s.bandLo) == 0 || len(s.bandHi) == 0 {
+			continue
+		}
+		n := len(s.X)
+		lb := sort.Search(n, func(i int) bool { return s.X[i] >= c.ViewMinX() })
+		ub := sort.Search(n, func(i int) bool { return s.X[i] > c.ViewMaxX() })
+		style := s.style.Load().(lipgloss.Style).Faint(true)
+
+		for i := lb; i < ub; i++ {
+			col := int((s.X[i] - c.ViewMinX()) / xRange * float64(c.GraphWidth()))
+			if col < 0 || col >= c.GraphWidth() {
+				continue
+			}
+			step := s.stepAt(i)
+			lo, okLo := s.bandLo[step]
+			hi, okHi := s.bandHi[step]
+			if okLo && okHi {
+				c.drawBandColumn(graphStartX+col, lo, hi, yRange, style)
+			}
+		}
+	}
+}
@@ -18,17 +18,31 @@ type HistoryMsg struct {
 	RunPath string
 	Metrics map[string]MetricData
 	Media   map[string][]MediaPoint
+
+	// Keys lists the keys of Metrics in the order they were first logged.
+	//
+	// It may be nil, in which case the logged order is unknown.
+	Keys []string
+
+	// Histograms summarizes the histograms logged in the record, keyed
+	// like Metrics. They are not charted as scalars.
+	Histograms map[string]HistogramStats
 }
 
 // RunMsg contains data from the wandb run record.
 type RunMsg struct {
 	RunPath     string
 	ID          string
+	Entity      string
 	Project     string
 	DisplayName string
 	Notes       string
 	Tags        []string
 	Config      *spb.ConfigRecord
+
+	// Summary is the summary carried in the run record itself, as written
+	// by older SDKs and for resumed runs; nil if there is none.
+	Summary *spb.SummaryRecord
 }
 
 // SummaryMsg contains summary data from the wandb run.
@@ -46,6 +60,12 @@ type SystemInfoMsg struct {
 // FileChangedMsg indicates that the watched file has changed.
 type FileChangedMsg struct{}
 
+// WatcherErrMsg indicates that the watched file could not be checked
+// for changes; the watcher has stopped.
+type WatcherErrMsg struct {
+	Err error
+}
+
 // FileCompleteMsg indicates that the file has been completely read.
 type FileCompleteMsg struct {
 	ExitCode int32
@@ -94,6 +114,10 @@ type ChunkedBatchMsg struct {
 // HeartbeatMsg is sent periodically for live runs to ensure we don't miss data.
 type HeartbeatMsg struct{}
 
+// WorkspaceLiveRedrawMsg redraws the workspace while a run is live, so
+// time-based status stays fresh between records.
+type WorkspaceLiveRedrawMsg struct{}
+
 // LeftSidebarAnimationMsg is sent during left sidebar animations.
 type LeftSidebarAnimationMsg struct{}
 
@@ -117,6 +141,10 @@ type WorkspaceRunInitMsg struct {
 type WorkspaceChunkedBatchMsg struct {
 	RunKey string
 	Batch  ChunkedBatchMsg
+
+	// load is the initial load the chunk belongs to, so that chunks of a
+	// canceled load are not applied to a reselected run.
+	load *chunkedLoad
 }
 
 // WorkspaceBatchedRecordsMsg wraps a BatchedRecordsMsg with the originating run key.
@@ -138,9 +166,16 @@ type WorkspaceFileChangedMsg struct {
 // RunKeys contains the set of run directory names (e.g. "run-..." / "offline-run-...").
 // If Err is non-nil, RunKeys may be nil and callers should treat the snapshot
 // as unusable.
+//
+// LiveRuns holds the runs whose .wandb file was written recently; it is
+// only filled in when the runs list sorts running runs first.
 type WorkspaceRunDirsMsg struct {
-	RunKeys []string
-	Err     error
+	RunKeys  []string
+	LiveRuns map[string]bool
+	Err      error
+
+	// runStatuses holds the runs whose scanned status changed.
+	runStatuses map[string]runStatus
 }
 
 // WorkspaceRunOverviewPreloadedMsg is emitted when the workspace finishes
@@ -160,6 +195,29 @@ type WorkspaceInitErrMsg struct {
 	Err     error
 }
 
+// WorkspaceRunFileRemovedMsg is emitted when a selected workspace run's
+// .wandb file disappears from disk while it is being streamed.
+type WorkspaceRunFileRemovedMsg struct {
+	RunKey  string
+	RunPath string
+}
+
+// WorkspaceWatcherErrMsg is emitted when the file watcher of a live
+// workspace run fails.
+type WorkspaceWatcherErrMsg struct {
+	RunKey string
+	Err    error
+}
+
+// WorkspaceWatcherRetryMsg asks the workspace to restart the file watcher
+// of a live run after a watcher failure.
+//
+// Attempt counts the retries so far, starting at 1.
+type WorkspaceWatcherRetryMsg struct {
+	RunKey  string
+	Attempt int
+}
+
 // ConsoleLogsPaneAnimationMsg drives animation for the run view console logs pane.
 type ConsoleLogsPaneAnimationMsg struct{}
 
@@ -172,6 +230,10 @@ type WorkspaceSystemMetricsPaneAnimationMsg struct{}
 // MetricsGridAnimationMsg drives animation for the run view metrics grid collapse/expand.
 type MetricsGridAnimationMsg struct{}
 
+// ChartsIdleMsg is sent once the user may have been idle long enough for
+// unfocused charts to dim, so that the view is redrawn.
+type ChartsIdleMsg struct{}
+
 // WorkspaceMetricsGridAnimationMsg drives animation for the workspace metrics grid.
 type WorkspaceMetricsGridAnimationMsg struct{}
 
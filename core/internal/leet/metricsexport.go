@@ -0,0 +1,99 @@
+package leet
+
+import (
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strconv"
+)
+
+// exportedSeries is one metric's samples in a metrics export.
+type exportedSeries struct {
+	Steps  []float64       `json:"steps"`
+	Values []exportedFloat `json:"values"`
+}
+
+// exportedFloat is a metric value that exports NaN and ±Inf as null,
+// which encoding/json cannot represent otherwise.
+type exportedFloat float64
+
+func (f exportedFloat) MarshalJSON() ([]byte, error) {
+	if !isFinite(float64(f)) {
+		return []byte("null"), nil
+	}
+	return strconv.AppendFloat(nil, float64(f), 'g', -1, 64), nil
+}
+
+// ExportMetricsJSON serializes every metric series of the run at runPath
+// as a JSON object keyed by metric name.
+//
+// Each series holds parallel "steps" and "values" arrays in step order.
+// Steps are exported even while the x-axis shows epochs.
+func (mg *MetricsGrid) ExportMetricsJSON(runPath string) ([]byte, error) {
+	mg.mu.RLock()
+	out := make(map[string]exportedSeries, len(mg.all))
+	for _, chart := range mg.all {
+		s, ok := chart.data[runPath]
+		if !ok {
+			continue
+		}
+		out[chart.Title()] = exportSeries(s.stepData())
+	}
+	mg.mu.RUnlock()
+
+	return json.MarshalIndent(out, "", "  ")
+}
+
+// exportSeries copies data into an exportedSeries sorted by step.
+func exportSeries(data MetricData) exportedSeries {
+	idx := make([]int, len(data.X))
+	for i := range idx {
+		idx[i] = i
+	}
+	sort.SliceStable(idx, func(a, b int) bool {
+		return data.X[idx[a]] < data.X[idx[b]]
+	})
+
+	s := exportedSeries{
+		Steps:  make([]float64, len(idx)),
+		Values: make([]exportedFloat, len(idx)),
+	}
+	for i, j := range idx {
+		s.Steps[i] = data.X[j]
+		s.Values[i] = exportedFloat(data.Y[j])
+	}
+	return s
+}
+
+// exportMetrics writes the run's metric series to a new JSON file in the
+// run's directory, or the working directory for remote runs.
+func (r *Run) exportMetrics() {
+	if r.runPath == "" {
+		r.setNotice("Export failed: no metrics loaded yet")
+		return
+	}
+	data, err := r.metricsGrid.ExportMetricsJSON(r.runPath)
+	if err != nil {
+		r.setNotice("Export failed: %v", err)
+		return
+	}
+
+	runID := r.runOverview.ID()
+	if runID == "" {
+		runID = "run"
+	}
+	dir := r.runDir()
+	if dir == "" {
+		dir = "."
+	}
+	path, err := writeNewFile(dir, fmt.Sprintf("metrics-%s", runID), "json", data)
+	if err != nil {
+		r.setNotice("Export failed: %v", err)
+		return
+	}
+	if abs, err := filepath.Abs(path); err == nil {
+		path = abs
+	}
+	r.setNotice("Wrote metrics to %s", path)
+}
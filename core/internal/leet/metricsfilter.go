@@ -1,6 +1,9 @@
 package leet
 
 import (
+	"fmt"
+	"slices"
+
 	tea "charm.land/bubbletea/v2"
 )
 
@@ -15,6 +18,10 @@ func (mg *MetricsGrid) ApplyFilter() {
 //
 // Caller must hold the lock mg.mu.
 func (mg *MetricsGrid) applyFilterNoLock() {
+	if mg.chartOverflowNoLock() {
+		mg.sortChartsNoLock()
+	}
+
 	// Fresh slice, no alias with allCharts.
 	filtered := make([]*EpochLineChart, 0, len(mg.all))
 	matcher := mg.filter.Matcher()
@@ -24,10 +31,11 @@ func (mg *MetricsGrid) applyFilterNoLock() {
 		}
 	}
 	mg.filtered = filtered
+	mg.heroIdx = heroIndex(mg.chartsToShowNoLock(), mg.config.HeroMetric())
 
 	// Keep pagination in sync with what fits now.
-	size := mg.effectiveGridSize()
-	mg.nav.UpdateTotalPages(len(mg.filtered), ItemsPerPage(size))
+	slots := mg.pageSlotsNoLock(mg.effectiveGridSize())
+	mg.nav.UpdateTotalPages(slots.pagedCount(), slots.perPage)
 
 	mg.loadCurrentPageNoLock()
 }
@@ -90,3 +98,45 @@ func (mg *MetricsGrid) FilterMode() FilterMatchMode {
 	defer mg.mu.RUnlock()
 	return mg.filter.Mode()
 }
+
+// saveFilterPreset saves the applied filter query as a preset.
+//
+// It returns the preset's 1-based position and the number of presets,
+// or ok false when no filter is applied.
+func (mg *MetricsGrid) saveFilterPreset() (pos, total int, ok bool) {
+	query := mg.FilterQuery()
+	if query == "" {
+		return 0, 0, false
+	}
+
+	presets := mg.config.MetricsFilterPresets()
+	i := slices.Index(presets, query)
+	if i < 0 {
+		presets = append(presets, query)
+		i = len(presets) - 1
+		if err := mg.config.SetMetricsFilterPresets(presets); err != nil {
+			mg.logger.Error(fmt.Sprintf("metricsgrid: failed to save filter preset: %v", err))
+		}
+	}
+	return i + 1, len(presets), true
+}
+
+// cycleFilterPreset applies the preset after the applied filter query,
+// wrapping around.
+//
+// It returns the applied query, its 1-based position and the number of
+// presets, or ok false when there are no presets.
+func (mg *MetricsGrid) cycleFilterPreset() (query string, pos, total int, ok bool) {
+	presets := mg.config.MetricsFilterPresets()
+	if len(presets) == 0 {
+		return "", 0, 0, false
+	}
+
+	i := (slices.Index(presets, mg.FilterQuery()) + 1) % len(presets)
+	mg.mu.Lock()
+	mg.filter.Set(presets[i])
+	mg.mu.Unlock()
+	mg.ApplyFilter()
+	mg.drawVisible()
+	return presets[i], i + 1, len(presets), true
+}
@@ -1,7 +1,9 @@
 package leet_test
 
 import (
+	"encoding/json"
 	"fmt"
+	"path/filepath"
 	"strings"
 	"sync"
 	"testing"
@@ -10,6 +12,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/wandb/wandb/core/internal/leet"
+	"github.com/wandb/wandb/core/internal/observability"
 )
 
 type ComponentWithContentFilter interface {
@@ -346,3 +349,61 @@ func TestMetricsGrid_RegexFilter(t *testing.T) {
 	require.Contains(t, out, "train/acc")
 	require.NotContains(t, out, "val/loss")
 }
+
+func TestMetricsGridFilter_NoMatchShowsHintUntilCleared(t *testing.T) {
+	w, h := 240, 80
+	grid := newMetricsGrid(t, 2, 2, w, h, nil)
+	grid.ProcessHistory(leet.HistoryMsg{Metrics: map[string]leet.MetricData{
+		"train/loss": {X: []float64{1}, Y: []float64{0.9}},
+	}})
+	dims := grid.CalculateChartDimensions(w, h)
+
+	grid.EnterFilterMode()
+	typeString(grid, "nope")
+	grid.ExitFilterMode(true)
+	out := stripANSI(grid.View(dims))
+	require.Contains(t, out, "No matching metrics.")
+	require.NotContains(t, out, "train/loss")
+
+	grid.ClearFilter()
+	out = stripANSI(grid.View(dims))
+	require.NotContains(t, out, "No matching metrics.")
+	require.Contains(t, out, "train/loss")
+}
+
+func TestMetricsGrid_ChartCap_HoldsBackMetricsUntilFiltered(t *testing.T) {
+	logger := observability.NewNoOpLogger()
+	cfg := leet.NewConfigManager(filepath.Join(t.TempDir(), "config.json"), logger)
+	require.NoError(t, cfg.SetMaxMetricCharts(5))
+	w, h := 240, 80
+	grid := leet.NewMetricsGrid(cfg, cfg.MetricsGrid, leet.NewFocus(), logger)
+	grid.UpdateDimensions(w, h)
+
+	metrics := make(map[string]leet.MetricData)
+	for i := range 8 {
+		metrics[fmt.Sprintf("m%d", i)] = leet.MetricData{X: []float64{1}, Y: []float64{float64(i)}}
+	}
+	grid.ProcessHistory(leet.HistoryMsg{RunPath: "run", Metrics: metrics})
+	grid.ProcessHistory(leet.HistoryMsg{RunPath: "run", Metrics: map[string]leet.MetricData{
+		"m7": {X: []float64{2}, Y: []float64{70}},
+	}})
+
+	require.Equal(t, 5, grid.ChartCount(), "the cap is respected")
+	require.Equal(t, 3, grid.OverflowCount())
+	dims := grid.CalculateChartDimensions(w, h)
+	require.Contains(t, stripANSI(grid.View(dims)), "+3 more, filter to see")
+
+	// Filtering to a held-back metric charts it with all of its samples.
+	grid.EnterFilterMode()
+	typeString(grid, "m7")
+	grid.ExitFilterMode(true)
+	require.Equal(t, 6, grid.ChartCount())
+	require.Equal(t, 2, grid.OverflowCount())
+	require.Equal(t, 1, grid.FilteredChartCount())
+
+	data, err := grid.ExportMetricsJSON("run")
+	require.NoError(t, err)
+	var exported map[string]struct{ Steps []float64 }
+	require.NoError(t, json.Unmarshal(data, &exported))
+	require.Equal(t, []float64{1, 2}, exported["m7"].Steps)
+}
@@ -1,13 +1,19 @@
 package leet
 
 import (
+	"cmp"
 	"fmt"
+	"maps"
+	"slices"
 	"sort"
 	"strconv"
+	"strings"
 	"sync"
+	"time"
 
 	tea "charm.land/bubbletea/v2"
 	"charm.land/lipgloss/v2"
+	"github.com/charmbracelet/x/ansi"
 
 	"github.com/wandb/wandb/core/internal/observability"
 )
@@ -34,10 +40,30 @@ type MetricsGrid struct {
 	nav GridNavigator
 
 	// Charts state.
-	all      []*EpochLineChart          // all charts, sorted by Title()
+	all      []*EpochLineChart          // all charts, in the configured sort order
 	byTitle  map[string]*EpochLineChart // Title() -> chart
 	filtered []*EpochLineChart          // subset matching filter (mirrors all when filter empty)
 
+	// loggedOrder records the order in which chart titles first appeared,
+	// for the "logged" metrics sort order; loggedSeq is the next rank.
+	loggedOrder map[string]int
+	loggedSeq   int
+
+	// overflow holds the metrics past the chart cap (see MaxMetricCharts).
+	overflow metricsOverflow
+
+	// xAxes holds each run's samples of the history keys usable as the
+	// x-axis, keyed by history key and then run path.
+	xAxes map[string]map[string]*epochAxis
+
+	// xAxisKey is the history key the charts' X values are keyed in,
+	// or "" for _step.
+	xAxisKey string
+
+	// heroIdx is the index of the hero chart among the charts to show,
+	// or -1. Recomputed whenever the filter is applied.
+	heroIdx int
+
 	// Charts visible on the current page grid.
 	currentPage [][]*EpochLineChart
 
@@ -69,8 +95,29 @@ type MetricsGrid struct {
 	// view.
 	seriesColorForKey func(string) AdaptiveColor
 
+	// seriesLabelForKey optionally maps series keys to the names shown in
+	// chart legends (for example workspace run paths to run names).
+	seriesLabelForKey func(string) string
+
 	// synchronized inspection session state (active only between press/release)
 	syncInspectActive bool
+
+	// scrubActive shows the keyboard scrub cursor at scrubX on every
+	// visible chart.
+	scrubActive bool
+	scrubX      float64
+
+	// jumpActive shows the jump-to-step prompt holding jumpDraft.
+	jumpActive bool
+	jumpDraft  string
+
+	// summaryStrip is shown after the header's navigation info.
+	summaryStrip string
+
+	// lastInput is when the user last pressed a key or used the mouse;
+	// now returns the current time.
+	lastInput time.Time
+	now       func() time.Time
 }
 
 func NewMetricsGrid(
@@ -88,6 +135,11 @@ func NewMetricsGrid(
 		gridConfig:            gridConfig,
 		all:                   make([]*EpochLineChart, 0),
 		byTitle:               make(map[string]*EpochLineChart),
+		loggedOrder:           make(map[string]int),
+		overflow:              make(metricsOverflow),
+		xAxes:                 make(map[string]map[string]*epochAxis),
+		xAxisKey:              xAxisKeyOf(config),
+		heroIdx:               -1,
 		filtered:              make([]*EpochLineChart, 0),
 		currentPage:           make([][]*EpochLineChart, gridRows),
 		focus:                 focus,
@@ -97,6 +149,8 @@ func NewMetricsGrid(
 		palette:               palette,
 		perPlotPalette:        perPlotPalette,
 		singleSeriesColorMode: ColorModePerSeries,
+		lastInput:             time.Now(),
+		now:                   time.Now,
 	}
 
 	for r := range gridRows {
@@ -129,6 +183,14 @@ func (mg *MetricsGrid) SetSeriesColorProvider(
 	mg.seriesColorForKey = provider
 }
 
+// SetSeriesLabelProvider installs an optional mapping from series keys to
+// legend labels.
+func (mg *MetricsGrid) SetSeriesLabelProvider(provider func(string) string) {
+	mg.mu.Lock()
+	defer mg.mu.Unlock()
+	mg.seriesLabelForKey = provider
+}
+
 // ChartCount returns the total number of metrics charts.
 func (mg *MetricsGrid) ChartCount() int {
 	mg.mu.RLock()
@@ -166,6 +228,143 @@ func (mg *MetricsGrid) toggleFocusedChartLogY() bool {
 	return true
 }
 
+// cycleFocusedChartObjective makes the focused chart the objective metric,
+// cycling min -> max -> off, and persists the choice.
+func (mg *MetricsGrid) cycleFocusedChartObjective() {
+	chart := mg.focusedChart()
+	if chart == nil {
+		return
+	}
+
+	metric, direction := mg.config.Objective()
+	switch {
+	case metric != chart.Title():
+		metric, direction = chart.Title(), ObjectiveMinimize
+	case direction == ObjectiveMinimize:
+		direction = ObjectiveMaximize
+	default:
+		metric, direction = "", ObjectiveMinimize
+	}
+	if err := mg.config.SetObjective(metric, direction); err != nil {
+		mg.logger.Error(fmt.Sprintf("metricsgrid: failed to save objective: %v", err))
+	}
+
+	rules := mg.config.metricRules()
+	mg.mu.Lock()
+	for _, ch := range mg.all {
+		ch.SetObjective(rules.objectiveFor(ch.Title()))
+	}
+	mg.mu.Unlock()
+	mg.drawVisible()
+}
+
+// toggleFocusedChartInvertY flips the focused chart's Y axis and
+// persists the choice for its metric.
+func (mg *MetricsGrid) toggleFocusedChartInvertY() {
+	chart := mg.focusedChart()
+	if chart == nil {
+		return
+	}
+
+	if err := mg.config.SetMetricInverted(chart.Title(), !chart.IsInvertedY()); err != nil {
+		mg.logger.Error(fmt.Sprintf("metricsgrid: failed to save y-axis inversion: %v", err))
+	}
+	mg.drawVisible()
+}
+
+// cycleFocusedChartSecondary overlays the next metric, in chart order,
+// on the focused chart's right-hand Y axis, cycling back to none, and
+// persists the choice.
+func (mg *MetricsGrid) cycleFocusedChartSecondary() {
+	chart := mg.focusedChart()
+	if chart == nil {
+		return
+	}
+
+	mg.mu.RLock()
+	var candidates []string
+	for _, ch := range mg.all {
+		if ch != chart {
+			candidates = append(candidates, ch.Title())
+		}
+	}
+	mg.mu.RUnlock()
+
+	next := ""
+	current := mg.config.SecondaryAxis(chart.Title())
+	if i := slices.Index(candidates, current); i+1 < len(candidates) {
+		next = candidates[i+1]
+	}
+	if err := mg.config.SetSecondaryAxis(chart.Title(), next); err != nil {
+		mg.logger.Error(fmt.Sprintf("metricsgrid: failed to save secondary axis: %v", err))
+	}
+	mg.drawVisible()
+}
+
+// toggleRawXTicks flips X axis step labels between abbreviated and exact
+// for all charts and persists the choice.
+func (mg *MetricsGrid) toggleRawXTicks() {
+	if err := mg.config.SetRawXAxisSteps(!mg.config.RawXAxisSteps()); err != nil {
+		mg.logger.Error(fmt.Sprintf("metricsgrid: failed to save x-axis step format: %v", err))
+	}
+	mg.drawVisible()
+}
+
+// toggleMinMaxBand flips and persists the rolling min/max band setting.
+func (mg *MetricsGrid) toggleMinMaxBand() {
+	show, _ := mg.config.MinMaxBand()
+	if err := mg.config.SetShowMinMaxBand(!show); err != nil {
+		mg.logger.Error(fmt.Sprintf("metricsgrid: failed to save min/max band setting: %v", err))
+	}
+	mg.drawVisible()
+}
+
+// toggleLegend flips and persists the overlay legend setting.
+func (mg *MetricsGrid) toggleLegend() {
+	if err := mg.config.SetShowChartLegend(!mg.config.ShowChartLegend()); err != nil {
+		mg.logger.Error(fmt.Sprintf("metricsgrid: failed to save chart legend setting: %v", err))
+	}
+	mg.drawVisible()
+}
+
+// toggleSortOrder switches between alphabetical and logged chart order,
+// persists the choice, and re-sorts the grid keeping the focused chart.
+func (mg *MetricsGrid) toggleSortOrder() {
+	order := MetricsSortLogged
+	if mg.config.MetricsSortOrder() == MetricsSortLogged {
+		order = MetricsSortAlphabetical
+	}
+	if err := mg.config.SetMetricsSortOrder(order); err != nil {
+		mg.logger.Error(fmt.Sprintf("metricsgrid: failed to save metrics sort order: %v", err))
+	}
+
+	prevTitle := mg.saveFocusTitle()
+	mg.mu.Lock()
+	mg.sortChartsNoLock()
+	mg.applyFilterNoLock()
+	mg.mu.Unlock()
+	mg.restoreFocus(prevTitle)
+	mg.drawVisible()
+}
+
+// showsLegendNoLock reports whether chart renders with a legend line.
+//
+// Only charts overlaying more than one series get a legend.
+func (mg *MetricsGrid) showsLegendNoLock(chart *EpochLineChart) bool {
+	return mg.seriesLabelForKey != nil &&
+		chart.SeriesCount() > 1 &&
+		mg.config.ShowChartLegend()
+}
+
+// footerLines returns the lines each chart cell reserves for the stats
+// footer.
+func (mg *MetricsGrid) footerLines() int {
+	if mg.config.ShowChartFooter() {
+		return 1
+	}
+	return 0
+}
+
 // CalculateChartDimensions computes chart dimensions.
 func (mg *MetricsGrid) CalculateChartDimensions(windowWidth, windowHeight int) GridDims {
 	gridRows, gridCols := mg.gridConfig()
@@ -177,6 +376,7 @@ func (mg *MetricsGrid) CalculateChartDimensions(windowWidth, windowHeight int) G
 		MinCellW:    MinChartWidth,
 		MinCellH:    MinChartHeight,
 		HeaderLines: ChartHeaderHeight,
+		FooterLines: mg.footerLines(),
 	})
 }
 
@@ -201,27 +401,71 @@ func (mg *MetricsGrid) ProcessHistory(msg HistoryMsg) bool {
 		seriesStyle = &style
 	}
 
+	var created []string
+
+	rules := mg.config.metricRules()
+	metrics = canonicalMetrics(&rules, metrics)
+	bands := splitLoggedBands(&rules, metrics)
+
 	mg.mu.Lock()
 
-	for name, data := range metrics {
+	mg.syncXAxisModeNoLock()
+	xOf := mg.alignXAxisNoLock(msg.RunPath, metrics)
+	for _, name := range mg.metricNamesNoLock(metrics) {
+		data := metrics[name]
 		chart, exists := mg.byTitle[name]
 		if !exists {
-			chart = NewEpochLineChart(name)
-			chart.SetPalette(mg.palette)
-			mg.all = append(mg.all, chart)
-			mg.byTitle[name] = chart
+			if mg.atChartCapNoLock() {
+				mg.overflow.add(name, msg.RunPath, data)
+				needsSort = needsSort || mg.filter.Query() != ""
+				continue
+			}
+			chart = mg.newChartNoLock(name, &rules)
+			mg.takeOverflowNoLock(chart)
+			created = append(created, name)
 			needsSort = true
 
 			if mg.logger != nil && len(mg.all)%1000 == 0 {
 				mg.logger.Debug(fmt.Sprintf("metricsgrid: created %d charts", len(mg.all)))
 			}
 		}
-		chart.AddData(msg.RunPath, data)
+		if xOf != nil {
+			chart.AddSteppedData(msg.RunPath, data, xOf)
+		} else {
+			chart.AddData(msg.RunPath, data)
+		}
+		chart.ObserveStates(data.Y)
 		if seriesStyle != nil {
 			chart.SetSeriesStyle(msg.RunPath, seriesStyle)
 		}
 	}
 
+	// Bands attach to their metric's chart by step, whichever batch the
+	// metric itself is logged in.
+	for name, band := range bands {
+		chart, exists := mg.byTitle[name]
+		if !exists {
+			if mg.atChartCapNoLock() {
+				continue
+			}
+			chart = mg.newChartNoLock(name, &rules)
+			mg.takeOverflowNoLock(chart)
+			created = append(created, name)
+			needsSort = true
+		}
+		chart.AddBand(msg.RunPath, band.lo, band.hi)
+		if seriesStyle != nil {
+			chart.SetSeriesStyle(msg.RunPath, seriesStyle)
+		}
+	}
+
+	for _, name := range loggedOrder(&rules, msg.Keys, created) {
+		if _, seen := mg.loggedOrder[name]; !seen {
+			mg.loggedOrder[name] = mg.loggedSeq
+			mg.loggedSeq++
+		}
+	}
+
 	// Keep ordering, colors, maps and filtered set in sync.
 	if needsSort {
 		mg.sortChartsNoLock()  // re-sorts + assigns stable colors
@@ -237,6 +481,90 @@ func (mg *MetricsGrid) ProcessHistory(msg HistoryMsg) bool {
 	return true
 }
 
+// metricNamesNoLock returns the names in metrics, sorted when the batch
+// may reach the chart cap so that the same metrics overflow every time.
+func (mg *MetricsGrid) metricNamesNoLock(metrics map[string]MetricData) []string {
+	names := slices.Collect(maps.Keys(metrics))
+	if limit := mg.config.MaxMetricCharts(); limit > 0 && len(mg.all)+len(names) > limit {
+		slices.Sort(names)
+	}
+	return names
+}
+
+// newChartNoLock creates and indexes the chart for metric name.
+func (mg *MetricsGrid) newChartNoLock(name string, rules *metricRules) *EpochLineChart {
+	chart := NewEpochLineChart(name)
+	chart.SetPalette(mg.palette)
+	chart.SetObjective(rules.objectiveFor(name))
+	chart.SetTarget(rules.targetFor(name))
+	chart.SetInvertY(rules.invertedFor(name))
+	chart.SetXLabel(mg.xAxisLabelNoLock())
+	if unit := MetricYUnit(name, rules.units[name]); unit != UnitScalar {
+		chart.SetYUnit(unit)
+	}
+	mg.all = append(mg.all, chart)
+	mg.byTitle[name] = chart
+	return chart
+}
+
+// loggedOrder sorts the chart names created from one record by the
+// position of their keys in the record.
+//
+// Names whose key position is unknown follow, sorted alphabetically so
+// that the "logged" order stays deterministic.
+func loggedOrder(rules *metricRules, keys, created []string) []string {
+	if len(created) < 2 {
+		return created
+	}
+	pos := make(map[string]int, len(keys))
+	for i, key := range keys {
+		name := rules.resolve(key)
+		if _, ok := pos[name]; !ok {
+			pos[name] = i
+		}
+	}
+	slices.SortFunc(created, func(a, b string) int {
+		pa, okA := pos[a]
+		pb, okB := pos[b]
+		switch {
+		case okA && okB:
+			return cmp.Compare(pa, pb)
+		case okA != okB:
+			if okA {
+				return -1
+			}
+			return 1
+		default:
+			return strings.Compare(a, b)
+		}
+	})
+	return created
+}
+
+// canonicalMetrics re-keys a record's metrics by canonical name and drops
+// blocked keys.
+//
+// When a record logs both a key and its alias target, the data logged
+// under the canonical name wins so the series gets one point per step.
+func canonicalMetrics(
+	rules *metricRules,
+	metrics map[string]MetricData,
+) map[string]MetricData {
+	out := make(map[string]MetricData, len(metrics))
+	for key, data := range metrics {
+		// Renamed keys are charted under their canonical name.
+		name := rules.resolve(key)
+		if rules.blocked(key, name) {
+			continue
+		}
+		if _, dup := out[name]; dup && name != key {
+			continue
+		}
+		out[name] = data
+	}
+	return out
+}
+
 // effectiveGridSize returns the grid size that can fit in the current viewport.
 func (mg *MetricsGrid) effectiveGridSize() GridSize {
 	gridRows, gridCols := mg.gridConfig()
@@ -246,6 +574,7 @@ func (mg *MetricsGrid) effectiveGridSize() GridSize {
 		MinCellW:    MinChartWidth,
 		MinCellH:    MinChartHeight,
 		HeaderLines: ChartHeaderHeight,
+		FooterLines: mg.footerLines(),
 	})
 }
 
@@ -259,16 +588,6 @@ func (mg *MetricsGrid) chartsToShowNoLock() []*EpochLineChart {
 	return mg.filtered
 }
 
-// effectiveChartCountNoLock is the count used for nav/pagination.
-//
-// Caller must hold mg.mu (RLock is fine).
-func (mg *MetricsGrid) effectiveChartCountNoLock() int {
-	if mg.filter.Query() == "" {
-		return len(mg.all)
-	}
-	return len(mg.filtered)
-}
-
 // colorForNoLock returns a stable color for a given metric title.
 func (mg *MetricsGrid) colorForNoLock(title string) AdaptiveColor {
 	if c, ok := mg.colorOfTitle[title]; ok {
@@ -288,13 +607,20 @@ func (mg *MetricsGrid) colorForNoLock(title string) AdaptiveColor {
 	return c
 }
 
-// sortChartsNoLock sorts charts alphabetically, rebuilds indices, and (re)assigns colors.
+// sortChartsNoLock sorts charts in the configured order, rebuilds indices,
+// and (re)assigns colors.
 //
 // Caller must hold mg.mu.
 func (mg *MetricsGrid) sortChartsNoLock() {
-	sort.Slice(mg.all, func(i, j int) bool {
-		return mg.all[i].Title() < mg.all[j].Title()
-	})
+	if mg.config.MetricsSortOrder() == MetricsSortLogged {
+		sort.SliceStable(mg.all, func(i, j int) bool {
+			return mg.loggedOrder[mg.all[i].Title()] < mg.loggedOrder[mg.all[j].Title()]
+		})
+	} else {
+		sort.Slice(mg.all, func(i, j int) bool {
+			return mg.all[i].Title() < mg.all[j].Title()
+		})
+	}
 
 	mg.byTitle = make(map[string]*EpochLineChart, len(mg.all))
 	for _, chart := range mg.all {
@@ -331,16 +657,19 @@ func (mg *MetricsGrid) loadCurrentPageNoLock() {
 		mg.currentPage[row] = make([]*EpochLineChart, size.Cols)
 	}
 
-	chartsToShow := mg.chartsToShowNoLock()
+	slots := mg.pageSlotsNoLock(size)
 
-	startIdx, endIdx := mg.nav.PageBounds(len(chartsToShow), ItemsPerPage(size))
+	startIdx, endIdx := mg.nav.PageBounds(slots.pagedCount(), slots.perPage)
 
-	idx := startIdx
-	for row := 0; row < size.Rows && idx < endIdx; row++ {
-		for col := 0; col < size.Cols && idx < endIdx; col++ {
-			mg.currentPage[row][col] = chartsToShow[idx]
-			idx++
-		}
+	// The hero chart, if any, takes the first cell of every page.
+	slot := 0
+	if hero := slots.hero(); hero != nil {
+		mg.currentPage[0][0] = hero
+		slot = 1
+	}
+	for idx := startIdx; idx < endIdx && slot < size.Rows*size.Cols; idx++ {
+		mg.currentPage[slot/size.Cols][slot%size.Cols] = slots.pagedAt(idx)
+		slot++
 	}
 }
 
@@ -350,9 +679,8 @@ func (mg *MetricsGrid) UpdateDimensions(contentWidth, contentHeight int) {
 
 	// Keep pagination in sync with what fits now.
 	mg.mu.Lock()
-	size := mg.effectiveGridSize()
-	chartCount := mg.effectiveChartCountNoLock()
-	mg.nav.UpdateTotalPages(chartCount, ItemsPerPage(size))
+	slots := mg.pageSlotsNoLock(mg.effectiveGridSize())
+	mg.nav.UpdateTotalPages(slots.pagedCount(), slots.perPage)
 	mg.loadCurrentPageNoLock()
 	mg.mu.Unlock()
 
@@ -385,14 +713,14 @@ func (mg *MetricsGrid) renderHeader(size GridSize) string {
 
 	navInfo := ""
 
-	chartCount := mg.effectiveChartCountNoLock()
+	slots := mg.pageSlotsNoLock(size)
+	chartCount := slots.pagedCount()
 	totalCount := len(mg.all)
 
-	itemsPerPage := ItemsPerPage(size)
 	totalPages := mg.nav.TotalPages()
 
 	if totalPages > 0 && chartCount > 0 {
-		startIdx, endIdx := mg.nav.PageBounds(chartCount, itemsPerPage)
+		startIdx, endIdx := mg.nav.PageBounds(chartCount, slots.perPage)
 		startIdx++ // Display as 1-indexed
 
 		if mg.filter.Query() != "" {
@@ -404,8 +732,20 @@ func (mg *MetricsGrid) renderHeader(size GridSize) string {
 				fmt.Sprintf(" [%d-%d of %d]", startIdx, endIdx, chartCount))
 		}
 	}
+	if hero := slots.hero(); hero != nil {
+		navInfo += navInfoStyle.Render(" + hero: " + hero.Title())
+	}
+	if n := len(mg.overflow); n > 0 {
+		navInfo += navInfoStyle.Render(fmt.Sprintf(" +%d more, filter to see", n))
+	}
 
 	headerLine := lipgloss.JoinHorizontal(lipgloss.Left, header, navInfo)
+	if mg.summaryStrip != "" {
+		avail := mg.width - ContentPaddingCols - lipgloss.Width(headerLine) - 2
+		if avail > 3 {
+			headerLine += "  " + summaryStripStyle.Render(truncateRight(mg.summaryStrip, avail))
+		}
+	}
 	headerContainer := headerContainerStyle.Render(headerLine)
 
 	return headerContainer
@@ -414,9 +754,17 @@ func (mg *MetricsGrid) renderHeader(size GridSize) string {
 func (mg *MetricsGrid) renderGrid(dims GridDims, size GridSize) string {
 	mg.mu.RLock()
 	noData := len(mg.all) == 0
+	noMatch := len(mg.filtered) == 0 && mg.filter.Query() != ""
 	mg.mu.RUnlock()
 
-	if noData {
+	hint := ""
+	switch {
+	case noData:
+		hint = "No metric data for selected runs."
+	case noMatch:
+		hint = "No matching metrics."
+	}
+	if hint != "" {
 		innerW := max(mg.width-ContentPaddingCols, 0)
 		gridH := max(size.Rows*dims.CellHWithPadding, 1)
 		return lipgloss.Place(
@@ -424,7 +772,7 @@ func (mg *MetricsGrid) renderGrid(dims GridDims, size GridSize) string {
 			gridH,
 			lipgloss.Center,
 			lipgloss.Center,
-			navInfoStyle.Render("No metric data for selected runs."),
+			navInfoStyle.Render(hint),
 		)
 	}
 
@@ -462,18 +810,36 @@ func (mg *MetricsGrid) renderGridCell(row, col int, dims GridDims) string {
 		if chart.IsLogY() {
 			titleSuffix = " [log]"
 		}
+		if chart.IsInvertedY() {
+			titleSuffix += " [inv]"
+		}
+		if secondary := chart.Secondary(); secondary != nil {
+			titleSuffix += " + " + secondary.Title() + " (right)"
+		}
+		if chart.IsSampled() {
+			titleSuffix += sampledTitleSuffix
+		}
+		if best := chart.BestLabel(); best != "" {
+			titleSuffix += " " + best
+		}
 
 		availableTitleWidth := max(dims.CellWWithPadding-4-lipgloss.Width(titleSuffix), 10)
 		displayTitle := TruncateTitle(chart.Title(), availableTitleWidth)
 		titleText := titleStyle.Render(displayTitle) + navInfoStyle.Render(titleSuffix)
 
-		boxContent := lipgloss.JoinVertical(
-			lipgloss.Left,
-			titleText,
-			chartView,
-		)
+		parts := []string{titleText, chartView}
+		if mg.showsLegendNoLock(chart) {
+			parts = append(parts, chart.Legend(dims.CellW, mg.seriesLabelForKey))
+		}
+		if mg.config.ShowChartFooter() {
+			parts = append(parts, navInfoStyle.Render(chart.StatsFooter(dims.CellW)))
+		}
+		boxContent := lipgloss.JoinVertical(lipgloss.Left, parts...)
 
 		box := boxStyle.Render(boxContent)
+		if mg.dimsChartNoLock(row, col) {
+			box = idleDimStyle.Render(ansi.Strip(box))
+		}
 
 		return lipgloss.Place(
 			dims.CellWWithPadding,
@@ -556,12 +922,63 @@ func (mg *MetricsGrid) drawVisible() {
 
 	// Resize and draw visible charts under lock to serialize with
 	// ProcessHistory's AddData calls on the same chart internals.
+	rawXTicks := mg.config.RawXAxisSteps()
+	bandWindow := 0
+	if show, window := mg.config.MinMaxBand(); show {
+		bandWindow = window
+	}
+	sampleThreshold := mg.config.SampledRenderingThreshold()
+	pointMarkers := mg.config.ShowPointMarkers()
+	rules := mg.config.metricRules()
+	sharedX := mg.sharedXAxisChartsNoLock()
+	mg.syncXAxisModeNoLock()
 	for ch := range currentCharts {
-		ch.Resize(dims.CellW, dims.CellH)
+		ch.SetRawXTicks(rawXTicks)
+		ch.SetBandWindow(bandWindow)
+		ch.SetPointMarkers(pointMarkers)
+		ch.SetSampleThreshold(sampleThreshold)
+		ch.SetObjective(rules.objectiveFor(ch.Title()))
+		ch.SetTarget(rules.targetFor(ch.Title()))
+		ch.SetInvertY(rules.invertedFor(ch.Title()))
+		ch.SetSecondary(mg.byTitle[rules.secondaryFor(ch.Title())])
+		h := dims.CellH
+		if mg.showsLegendNoLock(ch) {
+			h = max(h-1, 1)
+		}
+		// A chart sharing the x-axis below it gives its label row to the graph.
+		_, shared := sharedX[ch]
+		ch.SetXLabelsHidden(shared)
+		if shared {
+			h++
+		}
+		ch.Resize(dims.CellW, h)
+		if mg.scrubActive {
+			ch.InspectAtDataX(mg.scrubX)
+		}
 		ch.Draw()
 	}
 }
 
+// sharedXAxisChartsNoLock returns the charts on the current page that have
+// another chart below them in the same column, which omit their x-axis
+// labels when Config.SharedXAxis is on.
+func (mg *MetricsGrid) sharedXAxisChartsNoLock() map[*EpochLineChart]struct{} {
+	if !mg.config.SharedXAxis() {
+		return nil
+	}
+	shared := make(map[*EpochLineChart]struct{})
+	for row := 0; row+1 < len(mg.currentPage); row++ {
+		for col, ch := range mg.currentPage[row] {
+			if ch == nil || col >= len(mg.currentPage[row+1]) ||
+				mg.currentPage[row+1][col] == nil {
+				continue
+			}
+			shared[ch] = struct{}{}
+		}
+	}
+	return shared
+}
+
 // saveFocusTitle returns the title of the currently focused main-grid chart,
 // or an empty string if nothing valid is focused.
 func (mg *MetricsGrid) saveFocusTitle() string {
@@ -975,11 +1392,14 @@ func (mg *MetricsGrid) RemoveSeries(key string) {
 	}
 
 	mg.mu.Lock()
-	if len(mg.all) == 0 {
+	if len(mg.all) == 0 && len(mg.overflow) == 0 {
 		mg.mu.Unlock()
 		return
 	}
 
+	mg.removeRunXAxesNoLock(key)
+	mg.overflow.removeRun(key)
+
 	filtered := mg.all[:0]
 	for _, ch := range mg.all {
 		ch.RemoveSeries(key)
@@ -994,6 +1414,11 @@ func (mg *MetricsGrid) RemoveSeries(key string) {
 	for _, ch := range mg.all {
 		mg.byTitle[ch.Title()] = ch
 	}
+	for title := range mg.loggedOrder {
+		if _, ok := mg.byTitle[title]; !ok {
+			delete(mg.loggedOrder, title)
+		}
+	}
 
 	// Reapply filter + nav on the pruned chart set.
 	mg.applyFilterNoLock()
@@ -1,15 +1,21 @@
 package leet_test
 
 import (
+	"encoding/json"
+	"fmt"
 	"math"
 	"path/filepath"
+	"regexp"
 	"strings"
 	"testing"
+	"time"
 
+	"charm.land/lipgloss/v2"
 	"github.com/stretchr/testify/require"
 
 	leet "github.com/wandb/wandb/core/internal/leet"
 	"github.com/wandb/wandb/core/internal/observability"
+	spb "github.com/wandb/wandb/core/pkg/service_go_proto"
 )
 
 func newMetricsGrid(
@@ -359,3 +365,948 @@ func TestMetricsGrid_MultiSeries_PromoteAndRemoveSeries_PrunesEmptyCharts(t *tes
 	require.Equal(t, 0, grid.ChartCount())
 	require.Nil(t, grid.TestChartAt(0, 0), "expected chart removed after last series removed")
 }
+
+func TestMetricsGrid_MetricAliases_MergeRenamedKeysIntoOneChart(t *testing.T) {
+	logger := observability.NewNoOpLogger()
+	cfg := leet.NewConfigManager(filepath.Join(t.TempDir(), "config.json"), logger)
+	require.NoError(t, cfg.SetMetricsRows(1))
+	require.NoError(t, cfg.SetMetricsCols(1))
+	require.NoError(t, cfg.SetMetricAlias("acc", "accuracy"))
+
+	grid := leet.NewMetricsGrid(cfg, cfg.MetricsGrid, leet.NewFocus(), logger)
+	grid.UpdateDimensions(200, 24)
+
+	const runOld = "/wandb/old.wandb"
+	const runNew = "/wandb/new.wandb"
+
+	require.True(t, grid.ProcessHistory(leet.HistoryMsg{
+		RunPath: runOld,
+		Metrics: map[string]leet.MetricData{
+			"acc": {X: []float64{1, 2}, Y: []float64{0.5, 0.6}},
+		},
+	}))
+	require.True(t, grid.ProcessHistory(leet.HistoryMsg{
+		RunPath: runNew,
+		Metrics: map[string]leet.MetricData{
+			"accuracy": {X: []float64{1, 2}, Y: []float64{0.7, 0.8}},
+		},
+	}))
+
+	require.Equal(t, 1, grid.ChartCount())
+	ch := grid.TestChartAt(0, 0)
+	require.NotNil(t, ch)
+	require.Equal(t, "accuracy", ch.Title())
+	require.Equal(t, []string{runOld, runNew}, ch.DrawOrder())
+}
+
+func TestMetricsGrid_MetricAliases_KeyAndTargetInOneRecordChartOnce(t *testing.T) {
+	logger := observability.NewNoOpLogger()
+	cfg := leet.NewConfigManager(filepath.Join(t.TempDir(), "config.json"), logger)
+	require.NoError(t, cfg.SetMetricsRows(1))
+	require.NoError(t, cfg.SetMetricsCols(1))
+	require.NoError(t, cfg.SetMetricAlias("acc", "accuracy"))
+
+	grid := leet.NewMetricsGrid(cfg, cfg.MetricsGrid, leet.NewFocus(), logger)
+	grid.UpdateDimensions(200, 24)
+
+	const run = "/wandb/run.wandb"
+	require.True(t, grid.ProcessHistory(leet.HistoryMsg{
+		RunPath: run,
+		Metrics: map[string]leet.MetricData{
+			"acc":      {X: []float64{1}, Y: []float64{0.5}},
+			"accuracy": {X: []float64{1}, Y: []float64{0.7}},
+		},
+	}))
+
+	require.Equal(t, 1, grid.ChartCount())
+	ch := grid.TestChartAt(0, 0)
+	require.NotNil(t, ch)
+	require.Equal(t, []float64{1}, ch.TestSeriesX(run),
+		"expected one point per step for the canonical series")
+}
+
+func TestMetricsGrid_SortOrder_AlphabeticalVsLogged(t *testing.T) {
+	logger := observability.NewNoOpLogger()
+	cfg := leet.NewConfigManager(filepath.Join(t.TempDir(), "config.json"), logger)
+	require.NoError(t, cfg.SetMetricsRows(1))
+	require.NoError(t, cfg.SetMetricsCols(2))
+	require.Equal(t, leet.MetricsSortAlphabetical, cfg.MetricsSortOrder())
+
+	grid := leet.NewMetricsGrid(cfg, cfg.MetricsGrid, leet.NewFocus(), logger)
+	grid.UpdateDimensions(240, 60)
+
+	for i, name := range []string{"zeta", "alpha", "mid"} {
+		grid.ProcessHistory(leet.HistoryMsg{Metrics: map[string]leet.MetricData{
+			name: {X: []float64{1}, Y: []float64{float64(i)}},
+		}})
+	}
+
+	pages := func() [][]string {
+		t.Helper()
+		for grid.TestNavigatorCurrentPage() > 0 {
+			grid.Navigate(-1)
+		}
+		var out [][]string
+		for p := 0; p < 2; p++ {
+			var titles []string
+			for col := range 2 {
+				if ch := grid.TestChartAt(0, col); ch != nil {
+					titles = append(titles, ch.Title())
+				}
+			}
+			out = append(out, titles)
+			grid.Navigate(1)
+		}
+		return out
+	}
+
+	require.Equal(t, [][]string{{"alpha", "mid"}, {"zeta"}}, pages())
+
+	grid.TestToggleSortOrder()
+	require.Equal(t, leet.MetricsSortLogged, cfg.MetricsSortOrder())
+	require.Equal(t, [][]string{{"zeta", "alpha"}, {"mid"}}, pages())
+
+	// New metrics go to the end in logged order.
+	grid.ProcessHistory(leet.HistoryMsg{Metrics: map[string]leet.MetricData{
+		"beta": {X: []float64{1}, Y: []float64{1}},
+	}})
+	require.Equal(t, [][]string{{"zeta", "alpha"}, {"mid", "beta"}}, pages())
+
+	grid.TestToggleSortOrder()
+	require.Equal(t, [][]string{{"alpha", "beta"}, {"mid", "zeta"}}, pages())
+}
+
+func TestMetricsGrid_LoggedSortOrder_FollowsRecordKeyOrder(t *testing.T) {
+	logger := observability.NewNoOpLogger()
+	cfg := leet.NewConfigManager(filepath.Join(t.TempDir(), "config.json"), logger)
+	require.NoError(t, cfg.SetMetricsRows(1))
+	require.NoError(t, cfg.SetMetricsCols(3))
+	require.NoError(t, cfg.SetMetricsSortOrder(leet.MetricsSortLogged))
+
+	grid := leet.NewMetricsGrid(cfg, cfg.MetricsGrid, leet.NewFocus(), logger)
+	grid.UpdateDimensions(240, 60)
+
+	const runA = "/wandb/a.wandb"
+	point := leet.MetricData{X: []float64{1}, Y: []float64{1}}
+	grid.ProcessHistory(leet.HistoryMsg{
+		RunPath: runA,
+		Metrics: map[string]leet.MetricData{"loss": point, "acc": point, "lr": point},
+		Keys:    []string{"loss", "acc", "lr"},
+	})
+
+	titles := func() []string {
+		var out []string
+		for col := range 3 {
+			if ch := grid.TestChartAt(0, col); ch != nil {
+				out = append(out, ch.Title())
+			}
+		}
+		return out
+	}
+	require.Equal(t, []string{"loss", "acc", "lr"}, titles())
+
+	// Removing the only run prunes the logged order, so a metric logged
+	// again later is ranked by its new first appearance.
+	grid.RemoveSeries(runA)
+	grid.ProcessHistory(leet.HistoryMsg{
+		RunPath: "/wandb/b.wandb",
+		Metrics: map[string]leet.MetricData{"lr": point, "loss": point},
+		Keys:    []string{"lr", "loss"},
+	})
+	require.Equal(t, []string{"lr", "loss"}, titles())
+}
+
+func TestMetricsGrid_Objective_MarksBestPointAsSeriesStreams(t *testing.T) {
+	logger := observability.NewNoOpLogger()
+	cfg := leet.NewConfigManager(filepath.Join(t.TempDir(), "config.json"), logger)
+	require.NoError(t, cfg.SetMetricsRows(1))
+	require.NoError(t, cfg.SetMetricsCols(2))
+	require.NoError(t, cfg.SetObjective("loss", leet.ObjectiveMinimize))
+
+	grid := leet.NewMetricsGrid(cfg, cfg.MetricsGrid, leet.NewFocus(), logger)
+	grid.UpdateDimensions(160, 40)
+
+	grid.ProcessHistory(leet.HistoryMsg{Metrics: map[string]leet.MetricData{
+		"loss": {X: []float64{100, 200, 340, 400}, Y: []float64{0.9, 0.4, 0.12, 0.3}},
+		"acc":  {X: []float64{100, 200, 340, 400}, Y: []float64{0.1, 0.5, 0.7, 0.6}},
+	}})
+
+	var loss, acc *leet.EpochLineChart
+	for col := range 2 {
+		switch ch := grid.TestChartAt(0, col); ch.Title() {
+		case "loss":
+			loss = ch
+		case "acc":
+			acc = ch
+		}
+	}
+	require.NotNil(t, loss)
+	require.NotNil(t, acc)
+
+	x, y, ok := loss.Best()
+	require.True(t, ok)
+	require.Equal(t, 340.0, x)
+	require.Equal(t, 0.12, y)
+	require.Equal(t, "best: 0.12 @ step 340", loss.BestLabel())
+	require.Empty(t, acc.BestLabel(), "only the objective metric is marked")
+
+	grid.UpdateDimensions(160, 40) // redraw visible charts
+	dims := grid.CalculateChartDimensions(160, 40)
+	view := stripANSI(grid.View(dims))
+	require.Contains(t, view, "best: 0.12 @ step 340")
+	require.Equal(t, 1, strings.Count(view, "★"))
+
+	// A later, better sample moves the marker; a worse one does not.
+	grid.ProcessHistory(leet.HistoryMsg{Metrics: map[string]leet.MetricData{
+		"loss": {X: []float64{500, 600}, Y: []float64{0.05, 0.2}},
+	}})
+	require.Equal(t, "best: 0.05 @ step 500", loss.BestLabel())
+
+	// Switching the direction recomputes from the full series.
+	require.NoError(t, cfg.SetObjective("loss", leet.ObjectiveMaximize))
+	grid.UpdateDimensions(160, 40)
+	require.Equal(t, "best: 0.9 @ step 100", loss.BestLabel())
+}
+
+var sgrRE = regexp.MustCompile(`\x1b\[[0-9;]*m`)
+
+// distinctStyles returns how many distinct non-reset SGR sequences the
+// lines use.
+func distinctStyles(lines []string) int {
+	seen := make(map[string]bool)
+	for _, line := range lines {
+		for _, seq := range sgrRE.FindAllString(line, -1) {
+			if seq != "\x1b[m" && seq != "\x1b[0m" {
+				seen[seq] = true
+			}
+		}
+	}
+	return len(seen)
+}
+
+func TestMetricsGrid_IdleDim_DimsUnfocusedChartsUntilInput(t *testing.T) {
+	logger := observability.NewNoOpLogger()
+	cfg := leet.NewConfigManager(filepath.Join(t.TempDir(), "config.json"), logger)
+	require.NoError(t, cfg.SetMetricsRows(2))
+	require.NoError(t, cfg.SetMetricsCols(1))
+	require.NoError(t, cfg.SetIdleDimSeconds(10))
+
+	now := time.Unix(1_700_000_000, 0)
+	grid := leet.NewMetricsGrid(cfg, cfg.MetricsGrid, leet.NewFocus(), logger)
+	grid.TestSetClock(func() time.Time { return now })
+	grid.UpdateDimensions(80, 40)
+	grid.ProcessHistory(leet.HistoryMsg{Metrics: map[string]leet.MetricData{
+		"a/loss": {X: []float64{1, 2, 3}, Y: []float64{0.9, 0.4, 0.2}},
+		"b/acc":  {X: []float64{1, 2, 3}, Y: []float64{0.1, 0.5, 0.7}},
+	}})
+	grid.UpdateDimensions(80, 40)
+	require.Equal(t, "a/loss", grid.TestChartAt(0, 0).Title())
+	grid.HandleClick(0, 0)
+	require.NotNil(t, grid.NoteInput(), "input should schedule a redraw for dimming")
+
+	// cells splits the view into the focused and the unfocused chart.
+	cells := func() (focused, unfocused []string) {
+		dims := grid.CalculateChartDimensions(80, 40)
+		lines := strings.Split(grid.View(dims), "\n")
+		split := -1
+		for i, line := range lines {
+			if strings.Contains(stripANSI(line), "b/acc") {
+				split = i
+			}
+		}
+		require.Positive(t, split)
+		return lines[:split], lines[split:]
+	}
+
+	_, unfocused := cells()
+	require.Greater(t, distinctStyles(unfocused), 1, "bright before the idle delay")
+
+	now = now.Add(10 * time.Second)
+	focused, unfocused := cells()
+	require.Equal(t, 1, distinctStyles(unfocused), "dimmed to a single style")
+	require.Greater(t, distinctStyles(focused), 1, "the focused chart stays bright")
+
+	grid.NoteInput()
+	_, unfocused = cells()
+	require.Greater(t, distinctStyles(unfocused), 1, "input brightens the charts")
+}
+
+func TestMetricsGrid_MetricTarget_DrawsHorizontalLineAtTarget(t *testing.T) {
+	logger := observability.NewNoOpLogger()
+	cfg := leet.NewConfigManager(filepath.Join(t.TempDir(), "config.json"), logger)
+	require.NoError(t, cfg.SetMetricsRows(1))
+	require.NoError(t, cfg.SetMetricsCols(2))
+	require.NoError(t, cfg.SetMetricTarget("acc", 0.9))
+
+	grid := leet.NewMetricsGrid(cfg, cfg.MetricsGrid, leet.NewFocus(), logger)
+	grid.UpdateDimensions(160, 40)
+	grid.ProcessHistory(leet.HistoryMsg{Metrics: map[string]leet.MetricData{
+		"acc":  {X: []float64{1, 2, 3, 4}, Y: []float64{0.1, 0.5, 0.7, 0.6}},
+		"loss": {X: []float64{1, 2, 3, 4}, Y: []float64{0.9, 0.4, 0.2, 0.3}},
+	}})
+	grid.UpdateDimensions(160, 40) // redraw visible charts
+
+	var acc, loss *leet.EpochLineChart
+	for col := range 2 {
+		switch ch := grid.TestChartAt(0, col); ch.Title() {
+		case "acc":
+			acc = ch
+		case "loss":
+			loss = ch
+		}
+	}
+	require.NotNil(t, acc)
+	require.NotNil(t, loss)
+	require.NotContains(t, loss.View(), "┄", "only the metric with a target")
+
+	// The Y range grows past the data to keep the target in view.
+	require.GreaterOrEqual(t, acc.ViewMaxY(), 0.9)
+	frac := (0.9 - acc.ViewMinY()) / (acc.ViewMaxY() - acc.ViewMinY())
+	wantRow := acc.GraphHeight() - 1 - int(frac*float64(acc.GraphHeight()))
+
+	lines := strings.Split(stripANSI(acc.View()), "\n")
+	var rows []int
+	for i, line := range lines {
+		if strings.Contains(line, strings.Repeat("┄", acc.GraphWidth())) {
+			rows = append(rows, i)
+		}
+	}
+	require.Equal(t, []int{wantRow}, rows)
+
+	// Removing the target removes the line.
+	require.NoError(t, cfg.SetMetricTarget("acc", math.NaN()))
+	grid.UpdateDimensions(160, 40)
+	require.NotContains(t, acc.View(), "┄")
+}
+
+func TestMetricsGrid_EpochXAxis_AlignsRunsWithDifferentStepGranularity(t *testing.T) {
+	logger := observability.NewNoOpLogger()
+	cfg := leet.NewConfigManager(filepath.Join(t.TempDir(), "config.json"), logger)
+	require.Equal(t, leet.XAxisStep, cfg.XAxisMode())
+	require.NoError(t, cfg.SetXAxisMode(leet.XAxisEpoch))
+
+	grid := leet.NewMetricsGrid(cfg, cfg.MetricsGrid, leet.NewFocus(), logger)
+	grid.UpdateDimensions(160, 40)
+
+	// "fine" logs every 10 steps with the epoch on each row; "coarse" logs
+	// every 100 steps (one batch) and records the epoch only on some rows.
+	for i, step := range []float64{10, 20, 30, 40} {
+		grid.ProcessHistory(leet.HistoryMsg{RunPath: "fine", Metrics: map[string]leet.MetricData{
+			"loss":  {X: []float64{step}, Y: []float64{1 / step}},
+			"epoch": {X: []float64{step}, Y: []float64{float64(i / 2)}},
+		}})
+	}
+	grid.ProcessHistory(leet.HistoryMsg{RunPath: "coarse", Metrics: map[string]leet.MetricData{
+		"loss":  {X: []float64{100, 200, 300, 400}, Y: []float64{0.9, 0.8, 0.7, 0.6}},
+		"epoch": {X: []float64{100, 300}, Y: []float64{0, 1}},
+	}})
+	// A run without an epoch metric keeps its steps.
+	grid.ProcessHistory(leet.HistoryMsg{RunPath: "plain", Metrics: map[string]leet.MetricData{
+		"loss": {X: []float64{5, 6}, Y: []float64{0.5, 0.4}},
+	}})
+
+	var loss *leet.EpochLineChart
+	for col := range 2 {
+		if ch := grid.TestChartAt(0, col); ch != nil && ch.Title() == "loss" {
+			loss = ch
+		}
+	}
+	require.NotNil(t, loss)
+	require.Equal(t, []float64{0, 0, 1, 1}, loss.TestSeriesX("fine"))
+	require.Equal(t, []float64{0, 0, 1, 1}, loss.TestSeriesX("coarse"))
+	require.Equal(t, []float64{5, 6}, loss.TestSeriesX("plain"))
+
+	// Later rows without an epoch stay at the run's last epoch.
+	grid.ProcessHistory(leet.HistoryMsg{RunPath: "coarse", Metrics: map[string]leet.MetricData{
+		"loss": {X: []float64{500}, Y: []float64{0.5}},
+	}})
+	require.Equal(t, []float64{0, 0, 1, 1, 1}, loss.TestSeriesX("coarse"))
+}
+
+func TestMetricsGrid_CustomXAxisKey_PlotsAgainstLoggedCounter(t *testing.T) {
+	logger := observability.NewNoOpLogger()
+	cfg := leet.NewConfigManager(filepath.Join(t.TempDir(), "config.json"), logger)
+	require.NoError(t, cfg.SetXAxisKey("global_step"))
+
+	grid := leet.NewMetricsGrid(cfg, cfg.MetricsGrid, leet.NewFocus(), logger)
+	grid.UpdateDimensions(160, 40)
+
+	// "custom" logs a global_step counter on every row.
+	grid.ProcessHistory(leet.HistoryMsg{RunPath: "custom", Metrics: map[string]leet.MetricData{
+		"loss":        {X: []float64{0, 1, 2}, Y: []float64{0.9, 0.8, 0.7}},
+		"global_step": {X: []float64{0, 1, 2}, Y: []float64{256, 512, 768}},
+	}})
+	// A run without the counter falls back to _step.
+	grid.ProcessHistory(leet.HistoryMsg{RunPath: "plain", Metrics: map[string]leet.MetricData{
+		"loss": {X: []float64{5, 6}, Y: []float64{0.5, 0.4}},
+	}})
+
+	var loss *leet.EpochLineChart
+	for col := range 2 {
+		if ch := grid.TestChartAt(0, col); ch != nil && ch.Title() == "loss" {
+			loss = ch
+		}
+	}
+	require.NotNil(t, loss)
+	require.Equal(t, []float64{256, 512, 768}, loss.TestSeriesX("custom"))
+	require.Equal(t, []float64{5, 6}, loss.TestSeriesX("plain"))
+
+	// Clearing the key plots steps again.
+	require.NoError(t, cfg.SetXAxisKey(""))
+	grid.UpdateDimensions(160, 40)
+	require.Equal(t, []float64{0, 1, 2}, loss.TestSeriesX("custom"))
+}
+
+func TestMetricsGrid_EpochXAxis_RekeysRunOnFirstEpochAndModeSwitch(t *testing.T) {
+	logger := observability.NewNoOpLogger()
+	cfg := leet.NewConfigManager(filepath.Join(t.TempDir(), "config.json"), logger)
+	require.NoError(t, cfg.SetXAxisMode(leet.XAxisEpoch))
+	require.NoError(t, cfg.SetObjective("loss", leet.ObjectiveMinimize))
+
+	grid := leet.NewMetricsGrid(cfg, cfg.MetricsGrid, leet.NewFocus(), logger)
+	grid.UpdateDimensions(160, 40)
+
+	// The early batch has no epoch yet, so the run still plots steps.
+	grid.ProcessHistory(leet.HistoryMsg{RunPath: "run", Metrics: map[string]leet.MetricData{
+		"loss": {X: []float64{0, 500, 999}, Y: []float64{0.9, 0.5, 0.4}},
+	}})
+	loss := grid.TestChartAt(0, 0)
+	require.NotNil(t, loss)
+	require.Equal(t, []float64{0, 500, 999}, loss.TestSeriesX("run"))
+
+	// Its first epoch re-keys the whole series, so X never goes backwards.
+	grid.ProcessHistory(leet.HistoryMsg{RunPath: "run", Metrics: map[string]leet.MetricData{
+		"loss":  {X: []float64{1000, 2000}, Y: []float64{0.3, 0.2}},
+		"epoch": {X: []float64{1000, 2000}, Y: []float64{1, 2}},
+	}})
+	require.Equal(t, []float64{1, 1, 1, 1, 2}, loss.TestSeriesX("run"))
+	require.Equal(t, "best: 0.2 @ epoch 2", loss.BestLabel())
+
+	// Switching modes re-keys data that is already loaded.
+	require.NoError(t, cfg.SetXAxisMode(leet.XAxisStep))
+	grid.UpdateDimensions(160, 40)
+	require.Equal(t, []float64{0, 500, 999, 1000, 2000}, loss.TestSeriesX("run"))
+	require.Equal(t, "best: 0.2 @ step 2000", loss.BestLabel())
+
+	require.NoError(t, cfg.SetXAxisMode(leet.XAxisEpoch))
+	grid.UpdateDimensions(160, 40)
+	require.Equal(t, []float64{1, 1, 1, 1, 2}, loss.TestSeriesX("run"))
+}
+
+func TestMetricsGrid_HeroMetric_StaysInFirstCellOnEveryPage(t *testing.T) {
+	logger := observability.NewNoOpLogger()
+	cfg := leet.NewConfigManager(filepath.Join(t.TempDir(), "config.json"), logger)
+	require.NoError(t, cfg.SetMetricsRows(2))
+	require.NoError(t, cfg.SetMetricsCols(2))
+	require.NoError(t, cfg.SetHeroMetric("m5"))
+
+	grid := leet.NewMetricsGrid(cfg, cfg.MetricsGrid, leet.NewFocus(), logger)
+	grid.UpdateDimensions(240, 60)
+
+	metrics := make(map[string]leet.MetricData)
+	for i := range 8 {
+		metrics[fmt.Sprintf("m%d", i)] = leet.MetricData{X: []float64{1}, Y: []float64{float64(i)}}
+	}
+	grid.ProcessHistory(leet.HistoryMsg{Metrics: metrics})
+
+	// Seven non-hero charts over three cells per page: three pages.
+	var others []string
+	for page := range 3 {
+		require.Equal(t, page, grid.TestNavigatorCurrentPage())
+		require.Equal(t, "m5", grid.TestChartAt(0, 0).Title(), "page %d", page)
+		for _, rc := range [][2]int{{0, 1}, {1, 0}, {1, 1}} {
+			if ch := grid.TestChartAt(rc[0], rc[1]); ch != nil {
+				others = append(others, ch.Title())
+			}
+		}
+		grid.Navigate(1)
+	}
+	require.Equal(t, []string{"m0", "m1", "m2", "m3", "m4", "m6", "m7"}, others)
+	require.Equal(t, 0, grid.TestNavigatorCurrentPage(), "navigation wraps after the last page")
+
+	dims := grid.CalculateChartDimensions(240, 60)
+	require.Contains(t, stripANSI(grid.View(dims)), "[1-3 of 7] + hero: m5")
+
+	// Clearing the hero restores plain paging.
+	require.NoError(t, cfg.SetHeroMetric(""))
+	grid.UpdateDimensions(240, 60)
+	require.Equal(t, "m0", grid.TestChartAt(0, 0).Title())
+	require.Equal(t, "m3", grid.TestChartAt(1, 1).Title())
+}
+
+func TestMetricsGrid_SampledRendering_KicksInAboveThreshold(t *testing.T) {
+	logger := observability.NewNoOpLogger()
+	cfg := leet.NewConfigManager(filepath.Join(t.TempDir(), "config.json"), logger)
+	require.NoError(t, cfg.SetMetricsRows(1))
+	require.NoError(t, cfg.SetMetricsCols(1))
+	require.NoError(t, cfg.SetSampledRenderingThreshold(5000))
+
+	grid := leet.NewMetricsGrid(cfg, cfg.MetricsGrid, leet.NewFocus(), logger)
+	grid.UpdateDimensions(120, 30)
+	dims := grid.CalculateChartDimensions(120, 30)
+
+	addRun := func(run int) {
+		xs := make([]float64, 1000)
+		ys := make([]float64, 1000)
+		for i := range xs {
+			xs[i] = float64(i)
+			ys[i] = float64(run) + math.Sin(float64(i)/10)
+		}
+		grid.ProcessHistory(leet.HistoryMsg{
+			RunPath: fmt.Sprintf("run-%d.wandb", run),
+			Metrics: map[string]leet.MetricData{"loss": {X: xs, Y: ys}},
+		})
+	}
+
+	// At the threshold, every point is drawn.
+	for run := range 5 {
+		addRun(run)
+	}
+	grid.UpdateDimensions(120, 30)
+	chart := grid.TestChartAt(0, 0)
+	require.False(t, chart.IsSampled())
+	require.Equal(t, 5000, chart.TestDrawnPoints())
+	require.NotContains(t, stripANSI(grid.View(dims)), "[sampled]")
+
+	// One more overlaid run crosses it.
+	addRun(5)
+	grid.UpdateDimensions(120, 30)
+	require.True(t, chart.IsSampled())
+	require.Positive(t, chart.TestDrawnPoints())
+	require.Less(t, chart.TestDrawnPoints(), 6000/2)
+	require.Contains(t, stripANSI(grid.View(dims)), "loss [sampled]")
+
+	// 0 turns sampling off.
+	require.NoError(t, cfg.SetSampledRenderingThreshold(0))
+	grid.UpdateDimensions(120, 30)
+	require.False(t, chart.IsSampled())
+	require.Equal(t, 6000, chart.TestDrawnPoints())
+}
+
+func TestMetricsGrid_SampledRendering_NotMarkedWhenNothingIsDropped(t *testing.T) {
+	logger := observability.NewNoOpLogger()
+	cfg := leet.NewConfigManager(filepath.Join(t.TempDir(), "config.json"), logger)
+	require.NoError(t, cfg.SetMetricsRows(1))
+	require.NoError(t, cfg.SetMetricsCols(1))
+	require.NoError(t, cfg.SetSampledRenderingThreshold(10))
+
+	grid := leet.NewMetricsGrid(cfg, cfg.MetricsGrid, leet.NewFocus(), logger)
+	grid.UpdateDimensions(120, 30)
+	dims := grid.CalculateChartDimensions(120, 30)
+
+	// Above the threshold, but too few points per column to thin out.
+	grid.ProcessHistory(leet.HistoryMsg{Metrics: map[string]leet.MetricData{
+		"loss": {X: []float64{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11}, Y: make([]float64, 12)},
+	}})
+	grid.UpdateDimensions(120, 30)
+
+	chart := grid.TestChartAt(0, 0)
+	require.False(t, chart.IsSampled())
+	require.Equal(t, 12, chart.TestDrawnPoints())
+	require.NotContains(t, stripANSI(grid.View(dims)), "[sampled]")
+}
+
+func TestMetricsGrid_MetricBlocklist_SkipsMatchingMetrics(t *testing.T) {
+	logger := observability.NewNoOpLogger()
+	cfg := leet.NewConfigManager(filepath.Join(t.TempDir(), "config.json"), logger)
+	require.NoError(t, cfg.SetMetricsRows(2))
+	require.NoError(t, cfg.SetMetricsCols(2))
+	require.NoError(t, cfg.SetMetricBlocklist([]string{"_timestamp", "debug/*"}))
+	require.Error(t, cfg.SetMetricBlocklist([]string{"["}))
+
+	grid := leet.NewMetricsGrid(cfg, cfg.MetricsGrid, leet.NewFocus(), logger)
+	grid.UpdateDimensions(200, 40)
+
+	grid.ProcessHistory(leet.HistoryMsg{
+		RunPath: "/wandb/run.wandb",
+		Metrics: map[string]leet.MetricData{
+			"_timestamp":    {X: []float64{1}, Y: []float64{1.7e9}},
+			"debug/grad":    {X: []float64{1}, Y: []float64{0.1}},
+			"loss":          {X: []float64{1}, Y: []float64{0.5}},
+			"train/_metric": {X: []float64{1}, Y: []float64{0.2}},
+		},
+	})
+
+	require.Equal(t, 2, grid.ChartCount())
+	var titles []string
+	for col := range 2 {
+		ch := grid.TestChartAt(0, col)
+		require.NotNil(t, ch)
+		titles = append(titles, ch.Title())
+	}
+	require.ElementsMatch(t, []string{"loss", "train/_metric"}, titles)
+}
+
+func TestMetricYUnit_BytesAcrossMagnitudes(t *testing.T) {
+	cases := []struct {
+		name, unit string
+		val        float64
+		want       string
+	}{
+		{"mem_bytes", "", 512, "512B"},
+		{"mem_bytes", "", 2048, "2KiB"},
+		{"mem_bytes", "", 5 * 1024 * 1024, "5MiB"},
+		{"gpu/mem_bytes", "", 3.5 * 1024 * 1024 * 1024, "3.5GiB"},
+		{"gpu/mem_bytes", "", 2 * 1024 * 1024 * 1024 * 1024, "2TiB"},
+		{"cache (KiB)", "", 1536, "1.5MiB"},
+		{"memory[MiB]", "", 40960, "40GiB"},
+		{"disk_gib", "", 0.5, "512MiB"},
+		{"allocated", "bytes", 1024, "1KiB"},
+		{"allocated", "GiB", 2, "2GiB"},
+		{"loss_b", "", 1024, "1.02e+03"},
+		{"bytes", "", 1024, "1.02e+03"},
+		{"loss", "", 1024, "1.02e+03"},
+	}
+	for _, tc := range cases {
+		got := leet.MetricYUnit(tc.name, tc.unit).Format(tc.val)
+		require.Equal(t, tc.want, got, "name: %q, unit: %q, val: %g", tc.name, tc.unit, tc.val)
+	}
+
+	c := leet.NewEpochLineChart("gpu/mem_bytes")
+	c.SetYUnit(leet.MetricYUnit("gpu/mem_bytes", ""))
+	require.Equal(t, "1GiB", c.TestFormatYTick(1024*1024*1024))
+}
+
+func TestMetricsGrid_MetricUnits_KeyedByCanonicalName(t *testing.T) {
+	logger := observability.NewNoOpLogger()
+	cfg := leet.NewConfigManager(filepath.Join(t.TempDir(), "config.json"), logger)
+	require.NoError(t, cfg.SetMetricsRows(1))
+	require.NoError(t, cfg.SetMetricsCols(1))
+	require.NoError(t, cfg.SetMetricAlias("mem", "allocated"))
+	require.NoError(t, cfg.SetMetricUnit("allocated", "MiB"))
+
+	grid := leet.NewMetricsGrid(cfg, cfg.MetricsGrid, leet.NewFocus(), logger)
+	grid.UpdateDimensions(200, 24)
+
+	require.True(t, grid.ProcessHistory(leet.HistoryMsg{
+		Metrics: map[string]leet.MetricData{
+			"mem": {X: []float64{1}, Y: []float64{512}},
+		},
+	}))
+
+	ch := grid.TestChartAt(0, 0)
+	require.NotNil(t, ch)
+	require.Equal(t, "allocated", ch.Title())
+	require.Equal(t, "2GiB", ch.TestFormatYTick(2048))
+}
+
+func TestMetricsGrid_ExportMetricsJSON_WritesEachSeriesInStepOrder(t *testing.T) {
+	grid := newMetricsGrid(t, 2, 2, 120, 40, nil)
+
+	grid.ProcessHistory(leet.HistoryMsg{RunPath: "run-a", Metrics: map[string]leet.MetricData{
+		"loss": {X: []float64{1, 2}, Y: []float64{0.9, 0.7}},
+		"acc":  {X: []float64{1}, Y: []float64{0.1}},
+	}})
+	grid.ProcessHistory(leet.HistoryMsg{RunPath: "run-a", Metrics: map[string]leet.MetricData{
+		"loss": {X: []float64{0, 3}, Y: []float64{1, math.NaN()}},
+	}})
+	grid.ProcessHistory(leet.HistoryMsg{RunPath: "run-b", Metrics: map[string]leet.MetricData{
+		"loss": {X: []float64{1}, Y: []float64{5}},
+		"lr":   {X: []float64{1}, Y: []float64{0.01}},
+	}})
+
+	data, err := grid.ExportMetricsJSON("run-a")
+	require.NoError(t, err)
+
+	var got map[string]struct {
+		Steps  []float64  `json:"steps"`
+		Values []*float64 `json:"values"`
+	}
+	require.NoError(t, json.Unmarshal(data, &got))
+	require.Len(t, got, 2, "only run-a's metrics are exported")
+
+	require.Equal(t, []float64{0, 1, 2, 3}, got["loss"].Steps)
+	require.Len(t, got["loss"].Values, 4)
+	require.Equal(t, 1.0, *got["loss"].Values[0])
+	require.Equal(t, 0.9, *got["loss"].Values[1])
+	require.Equal(t, 0.7, *got["loss"].Values[2])
+	require.Nil(t, got["loss"].Values[3], "NaN exports as null")
+
+	require.Equal(t, []float64{1}, got["acc"].Steps)
+	require.Equal(t, 0.1, *got["acc"].Values[0])
+}
+
+func TestMetricsGrid_Scrub_MovesCursorAcrossAllVisibleCharts(t *testing.T) {
+	w, h := 240, 60
+	grid := newMetricsGrid(t, 1, 2, w, h, nil)
+
+	require.True(t, grid.ProcessHistory(leet.HistoryMsg{Metrics: map[string]leet.MetricData{
+		"alpha": {X: []float64{0, 1, 2, 3, 4}, Y: []float64{10, 11, 12, 13, 14}},
+		"beta":  {X: []float64{0, 2, 4}, Y: []float64{20, 22, 24}},
+	}}))
+	grid.UpdateDimensions(w, h)
+	chA, chB := grid.TestChartAt(0, 0), grid.TestChartAt(0, 1)
+
+	requireAt := func(ch *leet.EpochLineChart, wantX, wantY float64) {
+		t.Helper()
+		x, y, active := ch.InspectionData()
+		require.True(t, active, "%s should be inspected", ch.Title())
+		require.Equal(t, wantX, x, ch.Title())
+		require.Equal(t, wantY, y, ch.Title())
+	}
+
+	// The cursor starts at the latest step.
+	grid.ToggleScrub()
+	require.True(t, grid.IsScrubbing())
+	requireAt(chA, 4, 14)
+	requireAt(chB, 4, 24)
+
+	// Left moves to the previous step logged by any chart.
+	grid.MoveScrub(-1)
+	requireAt(chA, 3, 13)
+	grid.MoveScrub(-1)
+	requireAt(chA, 2, 12)
+	requireAt(chB, 2, 22)
+
+	// Moving past the first step stays there.
+	grid.MoveScrub(-1)
+	grid.MoveScrub(-1)
+	grid.MoveScrub(-1)
+	requireAt(chA, 0, 10)
+	requireAt(chB, 0, 20)
+
+	grid.MoveScrub(1)
+	requireAt(chA, 1, 11)
+
+	grid.ToggleScrub()
+	require.False(t, grid.IsScrubbing())
+	require.False(t, chA.IsInspecting())
+	require.False(t, chB.IsInspecting())
+}
+
+func TestMetricsGrid_StatsFooter_ShowsLastMinMaxAndFitsCell(t *testing.T) {
+	logger := observability.NewNoOpLogger()
+	cfg := leet.NewConfigManager(filepath.Join(t.TempDir(), "config.json"), logger)
+	require.NoError(t, cfg.SetMetricsRows(2))
+	require.NoError(t, cfg.SetMetricsCols(2))
+	w, h := 160, 40
+	grid := leet.NewMetricsGrid(cfg, cfg.MetricsGrid, leet.NewFocus(), logger)
+	grid.UpdateDimensions(w, h)
+
+	require.True(t, grid.ProcessHistory(leet.HistoryMsg{Metrics: map[string]leet.MetricData{
+		"loss": {X: []float64{0, 1, 2, 3}, Y: []float64{5, 2, 9, 4}},
+	}}))
+	before := grid.CalculateChartDimensions(w, h)
+	beforeView := stripANSI(grid.View(before))
+	require.NotContains(t, beforeView, "last 4")
+
+	require.NoError(t, cfg.SetShowChartFooter(true))
+	grid.UpdateDimensions(w, h)
+	after := grid.CalculateChartDimensions(w, h)
+
+	// The footer takes its line from the chart, not from the grid.
+	require.Equal(t, before.CellHWithPadding, after.CellHWithPadding)
+	require.Equal(t, before.CellH-1, after.CellH)
+
+	view := stripANSI(grid.View(after))
+	require.Contains(t, view, "last 4  min 2  max 9")
+	require.Equal(t, lipgloss.Height(beforeView), lipgloss.Height(view))
+}
+
+func TestMetricsGrid_CenterOnStep_ZoomsAllChartsAroundStep(t *testing.T) {
+	w, h := 240, 60
+	grid := newMetricsGrid(t, 1, 2, w, h, nil)
+
+	xs := make([]float64, 1000)
+	ys := make([]float64, 1000)
+	for i := range xs {
+		xs[i], ys[i] = float64(i), float64(i%7)
+	}
+	require.True(t, grid.ProcessHistory(leet.HistoryMsg{Metrics: map[string]leet.MetricData{
+		"loss": {X: xs, Y: ys},
+		"acc":  {X: xs[:800], Y: ys[:800]},
+	}}))
+	grid.UpdateDimensions(w, h)
+
+	require.NoError(t, grid.CenterOnStep(700))
+	for _, ch := range []*leet.EpochLineChart{grid.TestChartAt(0, 0), grid.TestChartAt(0, 1)} {
+		lo, hi := ch.ViewMinX(), ch.ViewMaxX()
+		require.LessOrEqual(t, lo, 700.0, ch.Title())
+		require.GreaterOrEqual(t, hi, 700.0, ch.Title())
+		require.Less(t, hi-lo, ch.MaxX()-ch.MinX(), "%s should be zoomed in", ch.Title())
+
+		x, _, active := ch.InspectionData()
+		require.True(t, active, "%s should mark the step", ch.Title())
+		require.Equal(t, 700.0, x, ch.Title())
+	}
+
+	err := grid.CenterOnStep(5000)
+	require.ErrorContains(t, err, "outside the logged steps 0-999")
+}
+
+func TestMetricsGrid_BooleanHistory_DrawsStepPlot(t *testing.T) {
+	w, h := 200, 40
+	grid := newMetricsGrid(t, 1, 2, w, h, nil)
+
+	for i, v := range []string{"false", "true", "false"} {
+		msg := leet.ParseHistory("run", &spb.HistoryRecord{Item: []*spb.HistoryItem{
+			{Key: "_step", ValueJson: fmt.Sprint(i * 50)},
+			{Key: "converged", ValueJson: v},
+			{Key: "loss", ValueJson: fmt.Sprint(0.5 + float64(i)*0.25)},
+		}}).(leet.HistoryMsg)
+		grid.ProcessHistory(msg)
+	}
+	grid.UpdateDimensions(w, h)
+
+	converged, loss := grid.TestChartAt(0, 0), grid.TestChartAt(0, 1)
+	require.Equal(t, "converged", converged.Title())
+	require.True(t, converged.IsStepPlot())
+	require.False(t, loss.IsStepPlot())
+
+	// Away from the two state levels, a step plot only has the vertical
+	// jumps; an interpolated line would cross many columns.
+	require.LessOrEqual(t, brailleColumnsOffLevels(converged.View()), 4)
+	require.Greater(t, brailleColumnsOffLevels(loss.View()), 4)
+}
+
+// brailleColumnsOffLevels returns the number of columns with line cells
+// outside the two rows holding the most line cells.
+func brailleColumnsOffLevels(view string) int {
+	isBraille := func(r rune) bool { return r > 0x2800 && r <= 0x28FF }
+	rows := strings.Split(stripANSI(view), "\n")
+	counts := make([]int, len(rows))
+	for i, row := range rows {
+		for _, r := range row {
+			if isBraille(r) {
+				counts[i]++
+			}
+		}
+	}
+	top1, top2 := -1, -1
+	for i, n := range counts {
+		switch {
+		case top1 < 0 || n > counts[top1]:
+			top1, top2 = i, top1
+		case top2 < 0 || n > counts[top2]:
+			top2 = i
+		}
+	}
+	cols := make(map[int]bool)
+	for i, row := range rows {
+		if i == top1 || i == top2 {
+			continue
+		}
+		for col, r := range []rune(row) {
+			if isBraille(r) {
+				cols[col] = true
+			}
+		}
+	}
+	return len(cols)
+}
+
+func TestMetricsGrid_SharedXAxis_OnlyBottomChartsShowXLabels(t *testing.T) {
+	logger := observability.NewNoOpLogger()
+	cfg := leet.NewConfigManager(filepath.Join(t.TempDir(), "config.json"), logger)
+	require.NoError(t, cfg.SetMetricsRows(2))
+	require.NoError(t, cfg.SetMetricsCols(2))
+	require.NoError(t, cfg.SetSharedXAxis(true))
+
+	w, h := 200, 40
+	grid := leet.NewMetricsGrid(cfg, cfg.MetricsGrid, leet.NewFocus(), logger)
+	grid.UpdateDimensions(w, h)
+	// Three charts: column 0 holds two, column 1 only one.
+	grid.ProcessHistory(leet.HistoryMsg{Metrics: map[string]leet.MetricData{
+		"a": seedXY(100),
+		"b": seedXY(100),
+		"c": seedXY(100),
+	}})
+	grid.UpdateDimensions(w, h)
+	_ = grid.View(grid.CalculateChartDimensions(w, h))
+
+	lastLine := func(ch *leet.EpochLineChart) string {
+		lines := strings.Split(stripANSI(ch.View()), "\n")
+		return lines[len(lines)-1]
+	}
+	digits := regexp.MustCompile(`\d`)
+
+	interior := grid.TestChartAt(0, 0)
+	bottom := grid.TestChartAt(1, 0)
+	alone := grid.TestChartAt(0, 1)
+	require.True(t, interior.XLabelsHidden())
+	require.False(t, bottom.XLabelsHidden())
+	require.False(t, alone.XLabelsHidden(), "no chart below shares its axis")
+
+	// Labeled charts end with a row of x ticks below the axis line.
+	require.Contains(t, lastLine(interior), "└", "interior chart ends at its axis line")
+	require.NotContains(t, lastLine(bottom), "└")
+	require.Regexp(t, digits, lastLine(bottom))
+	require.NotContains(t, lastLine(alone), "└")
+
+	// The graph takes over the label row, so cells keep their height.
+	require.Equal(t,
+		strings.Count(bottom.View(), "\n"), strings.Count(interior.View(), "\n"))
+	require.Equal(t, bottom.GraphHeight()+1, interior.GraphHeight())
+
+	require.NoError(t, cfg.SetSharedXAxis(false))
+	grid.UpdateDimensions(w, h)
+	require.False(t, interior.XLabelsHidden())
+	require.NotContains(t, lastLine(interior), "└")
+}
+
+func TestMetricsGrid_LoggedBand_ShadesCompanionKeysAroundMetric(t *testing.T) {
+	steps := []float64{0, 1, 2, 3, 4, 5, 6, 7}
+	msg := leet.HistoryMsg{
+		RunPath: "run",
+		Metrics: map[string]leet.MetricData{
+			"loss":     {X: steps, Y: []float64{5, 4.5, 4, 3.5, 3, 2.5, 2, 1.5}},
+			"loss_min": {X: steps, Y: []float64{4, 3.5, 3, 2.5, 2, 1.5, 1, 0.5}},
+			"loss_max": {X: steps, Y: []float64{6, 5.5, 5, 4.5, 4, 3.5, 3, 2.5}},
+		},
+	}
+
+	// Logged bands are off by default: the companions are ordinary metrics.
+	plain := newMetricsGrid(t, 1, 1, 100, 30, nil)
+	plain.ProcessHistory(msg)
+	plain.UpdateDimensions(100, 30)
+	require.Equal(t, 3, plain.ChartCount())
+	require.NotContains(t, plain.View(plain.CalculateChartDimensions(100, 30)), "░")
+
+	logger := observability.NewNoOpLogger()
+	cfg := leet.NewConfigManager(filepath.Join(t.TempDir(), "config.json"), logger)
+	require.NoError(t, cfg.SetShowLoggedBands(true))
+	grid := leet.NewMetricsGrid(cfg, cfg.MetricsGrid, leet.NewFocus(), logger)
+	grid.ProcessHistory(msg)
+	grid.UpdateDimensions(100, 30)
+	require.Equal(t, 1, grid.ChartCount(), "companion keys should not get charts")
+
+	chart := grid.TestChartAt(0, 0)
+	require.Equal(t, "loss", chart.Title())
+	_, _, yMin, yMax := chart.TestBounds()
+	require.Equal(t, 0.5, yMin, "the Y range should fit the band")
+	require.Equal(t, 6.0, yMax)
+	require.Contains(t, grid.View(grid.CalculateChartDimensions(100, 30)), "░")
+}
+
+func TestMetricsGrid_LoggedBand_FoldsAcrossHistoryBatches(t *testing.T) {
+	logger := observability.NewNoOpLogger()
+	cfg := leet.NewConfigManager(filepath.Join(t.TempDir(), "config.json"), logger)
+	require.NoError(t, cfg.SetShowLoggedBands(true))
+	grid := leet.NewMetricsGrid(cfg, cfg.MetricsGrid, leet.NewFocus(), logger)
+
+	// Each key arrives in its own batch, the companions first.
+	for _, step := range []float64{0, 1, 2, 3, 4, 5, 6, 7} {
+		for _, key := range []string{"loss_min", "loss_max", "loss"} {
+			y := 5 - step/2
+			switch key {
+			case "loss_min":
+				y--
+			case "loss_max":
+				y++
+			}
+			grid.ProcessHistory(leet.HistoryMsg{
+				RunPath: "run",
+				Metrics: map[string]leet.MetricData{
+					key: {X: []float64{step}, Y: []float64{y}},
+				},
+			})
+		}
+	}
+	grid.UpdateDimensions(100, 30)
+
+	require.Equal(t, 1, grid.ChartCount(), "companion keys should not get charts")
+	_, _, yMin, yMax := grid.TestChartAt(0, 0).TestBounds()
+	require.Equal(t, 0.5, yMin, "the Y range should fit the band")
+	require.Equal(t, 6.0, yMax)
+	require.Contains(t, grid.View(grid.CalculateChartDimensions(100, 30)), "░")
+}
@@ -0,0 +1,104 @@
+package leet
+
+import (
+	"fmt"
+	"slices"
+)
+
+// pageSlots lays out the charts to show across grid pages.
+//
+// The hero chart, if any, is pinned to the first cell of every page and
+// the other charts are paged through the remaining cells.
+type pageSlots struct {
+	charts  []*EpochLineChart
+	heroIdx int // index of the hero chart in charts, or -1
+	perPage int // paged charts per page
+}
+
+// hero returns the hero chart, or nil.
+func (p pageSlots) hero() *EpochLineChart {
+	if p.heroIdx < 0 {
+		return nil
+	}
+	return p.charts[p.heroIdx]
+}
+
+// pagedCount returns the number of charts paged through the grid.
+func (p pageSlots) pagedCount() int {
+	if p.heroIdx < 0 {
+		return len(p.charts)
+	}
+	return len(p.charts) - 1
+}
+
+// pagedAt returns the i-th paged chart, skipping over the hero.
+func (p pageSlots) pagedAt(i int) *EpochLineChart {
+	if p.heroIdx >= 0 && i >= p.heroIdx {
+		i++
+	}
+	return p.charts[i]
+}
+
+// pageSlotsNoLock returns the page layout of the charts to show.
+//
+// There is no hero when no hero metric is configured, it is filtered out,
+// or the grid has a single cell.
+//
+// Caller must hold mg.mu (RLock is fine).
+func (mg *MetricsGrid) pageSlotsNoLock(size GridSize) pageSlots {
+	slots := pageSlots{
+		charts:  mg.chartsToShowNoLock(),
+		heroIdx: -1,
+		perPage: ItemsPerPage(size),
+	}
+
+	name := mg.config.HeroMetric()
+	if name == "" || slots.perPage < 2 {
+		return slots
+	}
+
+	idx := mg.heroIdx
+	if idx < 0 || idx >= len(slots.charts) || slots.charts[idx].Title() != name {
+		// The hero changed since the chart set was last laid out.
+		idx = heroIndex(slots.charts, name)
+	}
+	if idx >= 0 {
+		slots.heroIdx = idx
+		slots.perPage--
+	}
+	return slots
+}
+
+// heroIndex returns the index of the chart titled name, or -1.
+func heroIndex(charts []*EpochLineChart, name string) int {
+	if name == "" {
+		return -1
+	}
+	return slices.IndexFunc(charts, func(ch *EpochLineChart) bool {
+		return ch.Title() == name
+	})
+}
+
+// toggleFocusedChartHero makes the focused chart the hero chart, or clears
+// the hero if it already is, and persists the choice.
+func (mg *MetricsGrid) toggleFocusedChartHero() {
+	chart := mg.focusedChart()
+	if chart == nil {
+		return
+	}
+
+	name := chart.Title()
+	if mg.config.HeroMetric() == name {
+		name = ""
+	}
+	if err := mg.config.SetHeroMetric(name); err != nil {
+		mg.logger.Error(fmt.Sprintf("metricsgrid: failed to save hero metric: %v", err))
+	}
+
+	prevTitle := mg.saveFocusTitle()
+	mg.mu.Lock()
+	mg.applyFilterNoLock()
+	mg.mu.Unlock()
+	mg.restoreFocus(prevTitle)
+	mg.drawVisible()
+}
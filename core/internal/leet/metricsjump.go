@@ -0,0 +1,165 @@
+package leet
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+
+	tea "charm.land/bubbletea/v2"
+)
+
+// jumpViewFraction is the share of a chart's X domain shown around the
+// step jumped to when the chart is not zoomed in already.
+const jumpViewFraction = 0.25
+
+// IsJumpMode reports whether the jump-to-step prompt is open.
+func (mg *MetricsGrid) IsJumpMode() bool {
+	mg.mu.RLock()
+	defer mg.mu.RUnlock()
+	return mg.jumpActive
+}
+
+// JumpDraft returns the step typed into the jump-to-step prompt.
+func (mg *MetricsGrid) JumpDraft() string {
+	mg.mu.RLock()
+	defer mg.mu.RUnlock()
+	return mg.jumpDraft
+}
+
+// EnterJumpMode opens the jump-to-step prompt.
+func (mg *MetricsGrid) EnterJumpMode() {
+	mg.mu.Lock()
+	defer mg.mu.Unlock()
+	mg.jumpActive = true
+	mg.jumpDraft = ""
+}
+
+// buildJumpToStepStatus returns the status bar prompt for jumping to a step.
+func buildJumpToStepStatus(mg *MetricsGrid) string {
+	return fmt.Sprintf("Jump to step: %s%s (Enter to jump • Esc to cancel)",
+		mg.JumpDraft(), string(mediumShadeBlock))
+}
+
+// handleJumpKey edits the jump-to-step prompt.
+//
+// Enter centers the charts on the typed step and returns the error for a
+// step outside the logged range; Esc closes the prompt.
+func (mg *MetricsGrid) handleJumpKey(msg tea.KeyPressMsg) error {
+	mg.mu.Lock()
+	switch msg.String() {
+	case "esc":
+		mg.jumpActive = false
+		mg.mu.Unlock()
+		return nil
+	case "enter":
+		draft := mg.jumpDraft
+		mg.jumpActive = false
+		mg.mu.Unlock()
+		if draft == "" {
+			return nil
+		}
+		step, err := strconv.ParseInt(draft, 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid step %q", draft)
+		}
+		return mg.CenterOnStep(step)
+	case "backspace":
+		mg.jumpDraft = trimLastRune(mg.jumpDraft)
+	default:
+		if s := msg.String(); len(s) == 1 && s[0] >= '0' && s[0] <= '9' {
+			mg.jumpDraft += s
+		}
+	}
+	mg.mu.Unlock()
+	return nil
+}
+
+// CenterOnStep zooms every chart to center on step and marks it with the
+// inspection crosshair.
+//
+// It returns an error if no chart has logged a step that far.
+func (mg *MetricsGrid) CenterOnStep(step int64) error {
+	mg.mu.Lock()
+	lo, hi := math.Inf(1), math.Inf(-1)
+	for _, ch := range mg.all {
+		if chLo, chHi, ok := ch.stepBounds(); ok {
+			lo, hi = min(lo, chLo), max(hi, chHi)
+		}
+	}
+	if !isFinite(lo) || float64(step) < lo || float64(step) > hi {
+		mg.mu.Unlock()
+		if !isFinite(lo) {
+			return fmt.Errorf("no steps logged yet")
+		}
+		return fmt.Errorf("step %d is outside the logged steps %s-%s",
+			step, formatXValue(lo), formatXValue(hi))
+	}
+
+	for _, ch := range mg.all {
+		if x, ok := ch.xForStep(float64(step)); ok {
+			ch.CenterOnX(x)
+		}
+	}
+	mg.mu.Unlock()
+
+	mg.drawVisible()
+	return nil
+}
+
+// stepBounds returns the first and last step logged by any series.
+func (c *EpochLineChart) stepBounds() (lo, hi float64, ok bool) {
+	lo, hi = math.Inf(1), math.Inf(-1)
+	for _, s := range c.data {
+		steps := s.stepData().X
+		if len(steps) == 0 {
+			continue
+		}
+		lo, hi = min(lo, steps[0]), max(hi, steps[len(steps)-1])
+	}
+	return lo, hi, isFinite(lo)
+}
+
+// xForStep returns the X value at which the topmost series plots the
+// sample nearest to step.
+func (c *EpochLineChart) xForStep(step float64) (float64, bool) {
+	s := c.topSeries()
+	if s == nil {
+		return 0, false
+	}
+	idx := nearestIndexForX(s.stepData().X, step)
+	if idx < 0 {
+		return 0, false
+	}
+	return s.X[idx], true
+}
+
+// CenterOnX zooms the view to center on x and marks it with the
+// inspection crosshair.
+//
+// A zoomed-in view keeps its width; otherwise the view shows
+// jumpViewFraction of the domain.
+func (c *EpochLineChart) CenterOnX(x float64) {
+	domMin, domMax := c.MinX(), c.MaxX()
+	domRange := domMax - domMin
+	if domRange <= 0 {
+		return
+	}
+
+	width := c.ViewMaxX() - c.ViewMinX()
+	if !c.isZoomed {
+		width = domRange * jumpViewFraction
+	}
+	width = max(minZoomRange, min(width, domRange))
+
+	newMin := max(x-width/2, domMin)
+	newMax := min(newMin+width, domMax)
+	newMin = max(newMax-width, domMin)
+
+	c.SetViewXRange(newMin, newMax)
+	c.userViewMinX = newMin
+	c.userViewMaxX = newMax
+	c.isZoomed = true
+	c.dirty = true
+
+	c.InspectAtDataX(x)
+}
@@ -0,0 +1,125 @@
+package leet
+
+import (
+	"maps"
+	"slices"
+
+	"charm.land/lipgloss/v2"
+)
+
+// metricsOverflow holds the samples of metrics that were not charted
+// because the grid reached its chart cap, keyed by metric name and then
+// by run path.
+//
+// Keeping the samples lets a filter chart these metrics later without
+// the grid paying for thousands of charts up front.
+type metricsOverflow map[string]map[string]*MetricData
+
+// add appends a batch of runPath's samples of metric name.
+func (o metricsOverflow) add(name, runPath string, data MetricData) {
+	runs, ok := o[name]
+	if !ok {
+		runs = make(map[string]*MetricData)
+		o[name] = runs
+	}
+	md, ok := runs[runPath]
+	if !ok {
+		md = &MetricData{}
+		runs[runPath] = md
+	}
+	md.X = append(md.X, data.X...)
+	md.Y = append(md.Y, data.Y...)
+}
+
+// removeRun drops runPath's samples, and metrics left without samples.
+func (o metricsOverflow) removeRun(runPath string) {
+	for name, runs := range o {
+		delete(runs, runPath)
+		if len(runs) == 0 {
+			delete(o, name)
+		}
+	}
+}
+
+// atChartCapNoLock reports whether the grid holds as many charts as the
+// configured cap allows.
+func (mg *MetricsGrid) atChartCapNoLock() bool {
+	limit := mg.config.MaxMetricCharts()
+	return limit > 0 && len(mg.all) >= limit
+}
+
+// chartOverflowNoLock charts the overflow metrics matching the active
+// filter and reports whether it created any.
+//
+// Nothing is charted while the filter matches more overflow metrics than
+// the cap, so that a broad filter cannot bring back the stall the cap
+// prevents.
+func (mg *MetricsGrid) chartOverflowNoLock() bool {
+	if len(mg.overflow) == 0 || mg.filter.Query() == "" {
+		return false
+	}
+
+	matcher := mg.filter.Matcher()
+	var names []string
+	for name := range mg.overflow {
+		if matcher(name) {
+			names = append(names, name)
+		}
+	}
+	if len(names) == 0 {
+		return false
+	}
+	if limit := mg.config.MaxMetricCharts(); limit > 0 && len(names) > limit {
+		return false
+	}
+	slices.Sort(names)
+
+	rules := mg.config.metricRules()
+	for _, name := range names {
+		mg.takeOverflowNoLock(mg.newChartNoLock(name, &rules))
+
+		mg.loggedOrder[name] = mg.loggedSeq
+		mg.loggedSeq++
+	}
+	return true
+}
+
+// takeOverflowNoLock moves the buffered samples of chart's metric, if
+// any, into chart.
+func (mg *MetricsGrid) takeOverflowNoLock(chart *EpochLineChart) {
+	runs, ok := mg.overflow[chart.Title()]
+	if !ok {
+		return
+	}
+	for _, runPath := range slices.Sorted(maps.Keys(runs)) {
+		mg.addOverflowSeriesNoLock(chart, runPath, *runs[runPath])
+	}
+	delete(mg.overflow, chart.Title())
+}
+
+// addOverflowSeriesNoLock adds runPath's buffered samples to chart,
+// keyed by the grid's current x-axis mode.
+func (mg *MetricsGrid) addOverflowSeriesNoLock(
+	chart *EpochLineChart,
+	runPath string,
+	data MetricData,
+) {
+	if xOf := mg.xOfNoLock(runPath); xOf != nil {
+		chart.AddSteppedData(runPath, data, xOf)
+	} else {
+		chart.AddData(runPath, data)
+	}
+
+	if mg.seriesColorForKey != nil && runPath != "" {
+		style := lipgloss.NewStyle().Foreground(mg.seriesColorForKey(runPath))
+		chart.SetSeriesStyle(runPath, &style)
+	}
+}
+
+// OverflowCount returns the number of metrics not charted because of
+// the chart cap.
+func (mg *MetricsGrid) OverflowCount() int {
+	mg.mu.RLock()
+	defer mg.mu.RUnlock()
+	return len(mg.overflow)
+}
@@ -0,0 +1,134 @@
+package leet
+
+import (
+	"sort"
+
+	tea "charm.land/bubbletea/v2"
+)
+
+// The scrub cursor is a keyboard-driven crosshair shared by the visible
+// main charts: each chart inspects its sample nearest to the cursor X,
+// which left/right move one logged step at a time.
+
+// IsScrubbing reports whether the scrub cursor is shown.
+func (mg *MetricsGrid) IsScrubbing() bool {
+	mg.mu.RLock()
+	defer mg.mu.RUnlock()
+	return mg.scrubActive
+}
+
+// ToggleScrub shows the scrub cursor at the latest step of the visible
+// charts, or hides it.
+func (mg *MetricsGrid) ToggleScrub() {
+	mg.mu.Lock()
+	if mg.scrubActive {
+		mg.scrubActive = false
+		for _, ch := range mg.all {
+			if ch.IsInspecting() {
+				ch.EndInspection()
+				ch.DrawIfNeeded()
+			}
+		}
+		mg.mu.Unlock()
+		return
+	}
+
+	x, ok := mg.latestVisibleXNoLock()
+	if !ok {
+		mg.mu.Unlock()
+		return
+	}
+	mg.scrubActive = true
+	mg.scrubX = x
+	mg.mu.Unlock()
+
+	mg.broadcastInspectAtDataX(x)
+}
+
+// MoveScrub moves the scrub cursor to the next (dir > 0) or previous
+// (dir < 0) step logged by any visible chart.
+func (mg *MetricsGrid) MoveScrub(dir int) {
+	mg.mu.Lock()
+	if !mg.scrubActive {
+		mg.mu.Unlock()
+		return
+	}
+	x, ok := mg.adjacentVisibleXNoLock(mg.scrubX, dir)
+	if ok {
+		mg.scrubX = x
+	}
+	mg.mu.Unlock()
+
+	if ok {
+		mg.broadcastInspectAtDataX(x)
+	}
+}
+
+// handleScrubKey moves the scrub cursor on left/right while it is shown
+// and reports whether it consumed the key.
+func (mg *MetricsGrid) handleScrubKey(msg tea.KeyPressMsg) bool {
+	if !mg.IsScrubbing() {
+		return false
+	}
+	switch DecodeNav(msg) {
+	case NavIntentLeft:
+		mg.MoveScrub(-1)
+	case NavIntentRight:
+		mg.MoveScrub(1)
+	default:
+		return false
+	}
+	return true
+}
+
+// visibleSeriesNoLock returns the topmost series of each chart on the
+// current page.
+func (mg *MetricsGrid) visibleSeriesNoLock() []*Series {
+	var series []*Series
+	for _, row := range mg.currentPage {
+		for _, ch := range row {
+			if ch == nil {
+				continue
+			}
+			if s := ch.topSeries(); s != nil && len(s.X) > 0 {
+				series = append(series, s)
+			}
+		}
+	}
+	return series
+}
+
+// latestVisibleXNoLock returns the largest X among the visible charts.
+func (mg *MetricsGrid) latestVisibleXNoLock() (float64, bool) {
+	found := false
+	var latest float64
+	for _, s := range mg.visibleSeriesNoLock() {
+		if x := s.X[len(s.X)-1]; !found || x > latest {
+			latest, found = x, true
+		}
+	}
+	return latest, found
+}
+
+// adjacentVisibleXNoLock returns the X nearest to x in direction dir
+// among the samples of the visible charts.
+func (mg *MetricsGrid) adjacentVisibleXNoLock(x float64, dir int) (float64, bool) {
+	found := false
+	var best float64
+	for _, s := range mg.visibleSeriesNoLock() {
+		var i int
+		if dir > 0 {
+			i = sort.Search(len(s.X), func(i int) bool { return s.X[i] > x })
+		} else {
+			i = sort.SearchFloat64s(s.X, x) - 1
+		}
+		if i < 0 || i >= len(s.X) {
+			continue
+		}
+		cand := s.X[i]
+		if !found || (dir > 0 && cand < best) || (dir < 0 && cand > best) {
+			best, found = cand, true
+		}
+	}
+	return best, found
+}
@@ -0,0 +1,145 @@
+package leet
+
+import (
+	"math"
+	"sort"
+
+	"charm.land/lipgloss/v2"
+	"github.com/NimbleMarkets/ntcharts/v2/canvas"
+)
+
+// minMaxBandRune shades the rolling min/max band behind a series line.
+const minMaxBandRune = '░'
+
+// rollingMinMax returns the min and max of each sample's trailing window of
+// up to window samples (the sample itself and the ones before it).
+//
+// Non-finite values are ignored; positions whose window holds no finite
+// value get NaN.
+func rollingMinMax(ys []float64, window int) (lo, hi []float64) {
+	lo = make([]float64, len(ys))
+	hi = make([]float64, len(ys))
+	window = max(window, 1)
+
+	// Monotonic deques of indices: ys[minQ] increasing, ys[maxQ] decreasing.
+	var minQ, maxQ []int
+	for i, y := range ys {
+		if isFinite(y) {
+			for len(minQ) > 0 && ys[minQ[len(minQ)-1]] >= y {
+				minQ = minQ[:len(minQ)-1]
+			}
+			minQ = append(minQ, i)
+			for len(maxQ) > 0 && ys[maxQ[len(maxQ)-1]] <= y {
+				maxQ = maxQ[:len(maxQ)-1]
+			}
+			maxQ = append(maxQ, i)
+		}
+		for len(minQ) > 0 && minQ[0] <= i-window {
+			minQ = minQ[1:]
+		}
+		for len(maxQ) > 0 && maxQ[0] <= i-window {
+			maxQ = maxQ[1:]
+		}
+
+		lo[i], hi[i] = math.NaN(), math.NaN()
+		if len(minQ) > 0 {
+			lo[i], hi[i] = ys[minQ[0]], ys[maxQ[0]]
+		}
+	}
+	return lo, hi
+}
+
+// SetBandWindow sets the rolling min/max band window in samples.
+//
+// A window below 2 hides the band.
+func (c *EpochLineChart) SetBandWindow(window int) {
+	if window < 2 {
+		window = 0
+	}
+	if c.bandWindow == window {
+		return
+	}
+	c.bandWindow = window
+	c.dirty = true
+}
+
+// Band returns the rolling min/max band of the series with the given key,
+// aligned with its samples. Returns nil slices if the band is off or the
+// series doesn't exist.
+func (c *EpochLineChart) Band(key string) (lo, hi []float64) {
+	s, ok := c.data[key]
+	if !ok || c.bandWindow == 0 {
+		return nil, nil
+	}
+	return rollingMinMax(s.Y, c.bandWindow)
+}
+
+// drawBands shades each series' rolling min/max band within the view.
+//
+// Bands are drawn before the series so that lines stay on top.
+func (c *EpochLineChart) drawBands(graphStartX int) {
+	if c.bandWindow == 0 {
+		return
+	}
+	xRange := c.ViewMaxX() - c.ViewMinX()
+	yRange := c.ViewMaxY() - c.ViewMinY()
+	if xRange <= 0 || yRange <= 0 {
+		return
+	}
+
+	for _, key := range c.order {
+		s := c.data[key]
+		if len(s.X) == 0 {
+			continue
+		}
+		lb := sort.Search(len(s.X), func(i int) bool { return s.X[i] >= c.ViewMinX() })
+		ub := sort.Search(len(s.X), func(i int) bool { return s.X[i] > c.ViewMaxX() })
+		if ub <= lb {
+			continue
+		}
+
+		// Include the samples that feed the first visible window.
+		from := max(lb-c.bandWindow+1, 0)
+		lo, hi := rollingMinMax(s.Y[from:ub], c.bandWindow)
+		style := s.style.Load().(lipgloss.Style).Faint(true)
+
+		for i := lb; i < ub; i++ {
+			col := int((s.X[i] - c.ViewMinX()) / xRange * float64(c.GraphWidth()))
+			if col < 0 || col >= c.GraphWidth() {
+				continue
+			}
+			c.drawBandColumn(graphStartX+col, lo[i-from], hi[i-from], yRange, style)
+		}
+	}
+}
+
+// drawBandColumn shades the canvas column x between the raw values lo
+// and hi.
+func (c *EpochLineChart) drawBandColumn(x int, lo, hi, yRange float64, style lipgloss.Style) {
+	top, okTop := c.bandRow(hi, yRange)
+	bottom, okBottom := c.bandRow(lo, yRange)
+	if !okTop || !okBottom {
+		return
+	}
+	if top > bottom {
+		// An inverted axis draws the high value lower.
+		top, bottom = bottom, top
+	}
+	for row := top; row <= bottom; row++ {
+		c.Canvas.SetCell(
+			canvas.Point{X: x, Y: row},
+			canvas.NewCellWithStyle(minMaxBandRune, style),
+		)
+	}
+}
+
+// bandRow maps a raw Y value to a canvas row, clamped to the graph area.
+func (c *EpochLineChart) bandRow(y, yRange float64) (int, bool) {
+	v, ok := c.scaleYValue(y)
+	if !ok {
+		return 0, false
+	}
+	frac := (v - c.ViewMinY()) / yRange
+	row := c.GraphHeight() - 1 - int(frac*float64(c.GraphHeight()))
+	return min(max(row, 0), c.GraphHeight()-1), true
+}
@@ -5,6 +5,8 @@ import (
 	"os"
 	"path/filepath"
 	"regexp"
+	"slices"
+	"time"
 
 	tea "charm.land/bubbletea/v2"
 	"charm.land/lipgloss/v2"
@@ -26,6 +28,9 @@ const (
 // point at the most recently started run directory.
 const latestRunLinkName = "latest-run"
 
+// maxRunHistory is how many recently viewed runs back-navigation keeps.
+const maxRunHistory = 10
+
 // Model is the top-level app model.
 //
 // It owns the workspace (always present) and optionally a single-run detail
@@ -45,12 +50,33 @@ type Model struct {
 	// workspace mode and created on-demand when they press Enter on a run.
 	run *Run
 
+	// runKey is the key of the run shown in single-run view.
+	runKey string
+
+	// runHistory holds the keys of the runs opened in single-run view,
+	// least recently opened first, and runHistoryPos indexes the one
+	// shown. Backspace steps back through it.
+	runHistory    []string
+	runHistoryPos int
+
 	// width and height cache the latest terminal dimensions for layout.
 	width, height int
 
 	// help is the full-screen help overlay, shared across both modes.
 	help *HelpModel
 
+	// showDiagnostics shows the hidden diagnostics overlay.
+	showDiagnostics bool
+
+	// diagnosticsRuntime is the last runtime stats sample shown in the
+	// diagnostics overlay, and diagnosticsTicking is set while a refresh
+	// is scheduled.
+	diagnosticsRuntime diagnosticsStats
+	diagnosticsTicking bool
+
+	// perf holds Update/View timings for the performance overlay.
+	perf perfStats
+
 	// shouldRestart is the restart flag.
 	shouldRestart bool
 
@@ -66,6 +92,10 @@ type ModelParams struct {
 	// that contains run directories and the "latest-run" symlink.
 	WandbDir string
 
+	// RunGlob, if set, limits the workspace to run directories whose names
+	// match the pattern (e.g. "run-20260209_*"), using filepath.Match syntax.
+	RunGlob string
+
 	// RunParams contains information about the run to load.
 	//
 	// When RunParams is nil, LEET starts in Config.StartupMode.
@@ -80,8 +110,9 @@ type ModelParams struct {
 // Startup behavior depends on the combination of RunFile and Config.StartupMode:
 //
 //   - RunFile is set → start in single-run view for that file.
-//   - RunFile is empty + StartupModeSingleRunLatest → resolve the "latest-run"
-//     symlink and start in single-run view.
+//   - RunFile is empty + StartupModeSingleRunLatest → start in single-run
+//     view for the newest run (the "latest-run" symlink if present, else the
+//     most recent run directory); stay in workspace view if there are no runs.
 //   - RunFile is empty + StartupModeWorkspaceLatest (default) → start in
 //     workspace view; the workspace will auto-select the latest run once
 //     the directory poll completes.
@@ -90,20 +121,35 @@ func NewModel(params ModelParams) *Model {
 		params.Config = NewConfigManager(leetConfigPath(), params.Logger)
 	}
 
+	applyColorProfile(params.Config)
+
+	if params.RunGlob != "" {
+		if _, err := filepath.Match(params.RunGlob, ""); err != nil {
+			params.Logger.Error(fmt.Sprintf("model: ignoring run glob %q: %v", params.RunGlob, err))
+			params.RunGlob = ""
+		}
+	}
+
 	if params.RunParams == nil && params.Config.StartupMode() == StartupModeSingleRunLatest {
-		latest, err := wandbFileFromLatestRunLink(params.WandbDir)
-		if err != nil {
+		latest, err := latestRunWandbFile(params.WandbDir, params.RunGlob)
+		switch {
+		case err != nil:
 			params.Logger.Error(fmt.Sprintf("model: failed to find latest run: %v", err))
-		}
-		if latest != "" {
+		case latest == "":
+			params.Logger.Info("model: no runs found, starting in workspace view")
+		default:
 			params.RunParams = &RunParams{RunFile: latest}
 		}
 	}
 
+	workspace := NewWorkspace(params.WandbDir, params.Config, params.Logger)
+	workspace.runGlob = params.RunGlob
+
 	m := &Model{
 		mode:      viewModeWorkspace,
-		workspace: NewWorkspace(params.WandbDir, params.Config, params.Logger),
+		workspace: workspace,
 		help:      NewHelp(),
+		perf:      newPerfStats(),
 		config:    params.Config,
 		logger:    params.Logger,
 	}
@@ -111,6 +157,10 @@ func NewModel(params ModelParams) *Model {
 	if params.RunParams != nil {
 		m.run = NewRun(params.RunParams, params.Config, params.Logger)
 		m.mode = viewModeRun
+		if runKey := filepath.Base(filepath.Dir(params.RunParams.RunFile)); extractRunID(runKey) != "" {
+			m.runKey = runKey
+			m.pushRunHistory(runKey)
+		}
 	}
 
 	return m
@@ -142,6 +192,10 @@ func (m *Model) Init() tea.Cmd {
 //
 // Implements tea.Model.Update.
 func (m *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	if m.perfOverlayEnabled() {
+		defer m.perf.trackUpdate(time.Now())
+	}
+
 	if wsMsg, ok := msg.(tea.WindowSizeMsg); ok {
 		m.width, m.height = wsMsg.Width, wsMsg.Height
 		m.help.SetSize(wsMsg.Width, wsMsg.Height)
@@ -151,6 +205,10 @@ func (m *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		SetDarkBackground(bgMsg.IsDark())
 	}
 
+	if handled, cmd := m.handleDiagnostics(msg); handled {
+		return m, cmd
+	}
+
 	if handled, cmd := m.handleHelp(msg); handled {
 		return m, cmd
 	}
@@ -159,6 +217,10 @@ func (m *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		return m, cmd
 	}
 
+	if handled, cmd := m.handleFreezeAnimations(msg); handled {
+		return m, cmd
+	}
+
 	// Snapshot before sub-models consume the key — a filter's Enter
 	// exits filter mode, so checking after would miss it.
 	awaitingInput := m.isAwaitingUserInput()
@@ -210,7 +272,8 @@ func (m *Model) handleModeSwitch(msg tea.Msg, awaitingInput bool) tea.Cmd {
 	case viewModeWorkspace:
 		if keyMsg.Code == tea.KeyEnter &&
 			!awaitingInput &&
-			m.workspace.RunSelectorActive() {
+			m.workspace.RunSelectorActive() &&
+			(keyMsg.Mod == tea.ModAlt || m.config.RunListEnter() == RunListEnterView) {
 			return m.enterRunView()
 		}
 	case viewModeRun:
@@ -219,6 +282,9 @@ func (m *Model) handleModeSwitch(msg tea.Msg, awaitingInput bool) tea.Cmd {
 			!awaitingInput && !runCapturesEsc {
 			return m.exitRunView()
 		}
+		if keyMsg.Code == tea.KeyBackspace && keyMsg.Mod == 0 && !awaitingInput {
+			return m.backToPreviousRun()
+		}
 	}
 	return nil
 }
@@ -227,11 +293,15 @@ func (m *Model) handleModeSwitch(msg tea.Msg, awaitingInput bool) tea.Cmd {
 //
 // Implements tea.Model.View.
 func (m *Model) View() tea.View {
+	start := time.Now()
 	var vs string
 
-	if m.help.IsActive() {
+	switch {
+	case m.showDiagnostics:
+		vs = m.renderDiagnostics()
+	case m.help.IsActive():
 		vs = m.renderHelpScreen()
-	} else {
+	default:
 		switch m.mode {
 		case viewModeWorkspace:
 			vs = m.workspace.View().Content
@@ -240,6 +310,11 @@ func (m *Model) View() tea.View {
 		}
 	}
 
+	if m.perfOverlayEnabled() {
+		m.perf.trackFrame(time.Now(), time.Since(start))
+		vs = m.renderPerfOverlay(vs)
+	}
+
 	v := tea.NewView(vs)
 
 	v.WindowTitle = "wandb leet"
@@ -287,9 +362,9 @@ func (m *Model) isAwaitingUserInput() bool {
 	}
 	switch m.mode {
 	case viewModeWorkspace:
-		return m.workspace.IsFiltering()
+		return m.workspace.IsFiltering() || m.workspace.IsExportingConfig()
 	case viewModeRun:
-		return m.run.IsFiltering()
+		return m.run.IsFiltering() || m.run.IsExportingConfig()
 	default:
 		return false
 	}
@@ -355,6 +430,32 @@ func (m *Model) handleRestart(msg tea.Msg) (bool, tea.Cmd) {
 	return false, nil
 }
 
+// handleFreezeAnimations toggles the global animation freeze on alt+a.
+//
+// Animation loops stop ticking while frozen, so unfreezing restarts
+// the loops of any pane that is still mid-animation.
+func (m *Model) handleFreezeAnimations(msg tea.Msg) (bool, tea.Cmd) {
+	km, ok := msg.(tea.KeyPressMsg)
+	if !ok || km.String() != "alt+a" {
+		return false, nil
+	}
+
+	SetAnimationsFrozen(!AnimationsFrozen())
+	m.logger.Debug(fmt.Sprintf("model: animations frozen: %v", AnimationsFrozen()))
+	if AnimationsFrozen() {
+		return true, nil
+	}
+
+	var cmds []tea.Cmd
+	if m.workspace != nil {
+		cmds = append(cmds, m.workspace.resumeAnimationsCmd())
+	}
+	if m.run != nil {
+		cmds = append(cmds, m.run.resumeAnimationsCmd())
+	}
+	return true, tea.Batch(cmds...)
+}
+
 // renderHelpScreen renders the help screen.
 func (m *Model) renderHelpScreen() string {
 	helpView := m.help.View().Content
@@ -374,16 +475,51 @@ func (m *Model) renderHelpScreen() string {
 
 // enterRunView switches to single-run view for the selected run.
 func (m *Model) enterRunView() tea.Cmd {
-	wandbFile := m.workspace.SelectedRunWandbFile()
+	runKey := m.workspace.SelectedRunKey()
+	cmd := m.openRun(runKey)
+	if cmd != nil {
+		m.pushRunHistory(runKey)
+	}
+	return cmd
+}
+
+// backToPreviousRun replaces the run in single-run view with the one
+// opened before it, if any.
+func (m *Model) backToPreviousRun() tea.Cmd {
+	if m.runHistoryPos <= 0 || m.runHistoryPos >= len(m.runHistory) {
+		return nil
+	}
+	if m.run != nil && m.run.IsRemote() {
+		return nil
+	}
+
+	m.closeRun()
+	m.runHistoryPos--
+	return m.openRun(m.runHistory[m.runHistoryPos])
+}
+
+// pushRunHistory records runKey as the most recently opened run.
+func (m *Model) pushRunHistory(runKey string) {
+	m.runHistory = slices.DeleteFunc(m.runHistory, func(k string) bool { return k == runKey })
+	m.runHistory = append(m.runHistory, runKey)
+	if n := len(m.runHistory); n > maxRunHistory {
+		m.runHistory = slices.Delete(m.runHistory, 0, n-maxRunHistory)
+	}
+	m.runHistoryPos = len(m.runHistory) - 1
+}
+
+// openRun shows runKey in single-run view, restoring its media view.
+func (m *Model) openRun(runKey string) tea.Cmd {
+	wandbFile := runWandbFile(m.workspace.wandbDir, runKey)
 	if wandbFile == "" {
 		return nil
 	}
 
 	m.run = NewRun(&RunParams{RunFile: wandbFile}, m.config, m.logger)
+	m.runKey = runKey
 	m.mode = viewModeRun
 
 	// Share the workspace's media store so data persists across transitions.
-	runKey := m.workspace.SelectedRunKey()
 	if store := m.workspace.MediaStoreForRun(runKey); store != nil {
 		m.run.SetMediaStore(store)
 	}
@@ -408,22 +544,28 @@ func (m *Model) exitRunView() tea.Cmd {
 		return nil
 	}
 
-	if m.run != nil {
-		// Save media pane view state for later restoration.
-		runKey := m.workspace.SelectedRunKey()
-		if runKey != "" {
-			m.workspace.SaveMediaPaneState(runKey, m.run.mediaPane.SaveViewState())
-			// Force the workspace pane to re-sync from the saved per-run state on return.
-			m.workspace.currentMediaRunKey = ""
-		}
-		m.run.Cleanup()
-		m.run = nil
-	}
-
+	m.closeRun()
 	m.mode = viewModeWorkspace
 	return nil
 }
 
+// closeRun saves the single-run view's media state and shuts it down.
+func (m *Model) closeRun() {
+	if m.run == nil {
+		return
+	}
+
+	// Save media pane view state for later restoration.
+	if m.runKey != "" {
+		m.workspace.SaveMediaPaneState(m.runKey, m.run.mediaPane.SaveViewState())
+		// Force the workspace pane to re-sync from the saved per-run state on return.
+		m.workspace.currentMediaRunKey = ""
+	}
+	m.run.Cleanup()
+	m.run = nil
+	m.runKey = ""
+}
+
 // --------------------------------------------------------------------
 // Path resolution utilities
 // --------------------------------------------------------------------
@@ -455,6 +597,38 @@ func runWandbFile(wandbDir, runDir string) string {
 	return filepath.Join(wandbDir, runDir, "run-"+runID+".wandb")
 }
 
+// latestRunWandbFile returns the .wandb file of the newest run in wandbDir.
+//
+// The "latest-run" link is preferred when it points at a run matching runGlob;
+// otherwise the most recent run directory with a .wandb file is used.
+// Returns an empty path if wandbDir contains no runs.
+func latestRunWandbFile(wandbDir, runGlob string) (string, error) {
+	if latest, err := wandbFileFromLatestRunLink(wandbDir); err == nil && latest != "" {
+		if runGlob == "" {
+			return latest, nil
+		}
+		runKey := filepath.Base(filepath.Dir(latest))
+		if ok, _ := filepath.Match(runGlob, runKey); ok {
+			return latest, nil
+		}
+	}
+
+	runKeys, err := scanWandbRunDirs(wandbDir, runGlob)
+	if err != nil {
+		return "", err
+	}
+	for _, runKey := range runKeys {
+		runFile := runWandbFile(wandbDir, runKey)
+		if runFile == "" {
+			continue
+		}
+		if _, err := os.Stat(runFile); err == nil {
+			return runFile, nil
+		}
+	}
+	return "", nil
+}
+
 func wandbFileFromLatestRunLink(wandbDir string) (string, error) {
 	latestRunPath, err := filepath.Abs(filepath.Join(wandbDir, latestRunLinkName))
 	if err != nil {
@@ -0,0 +1,127 @@
+package leet
+
+import (
+	"fmt"
+
+	"github.com/NimbleMarkets/ntcharts/v2/canvas"
+)
+
+// Objective directions for the best-point marker.
+const (
+	ObjectiveMinimize = "min"
+	ObjectiveMaximize = "max"
+)
+
+// bestPointGlyph marks the best point of an objective metric on its chart.
+const bestPointGlyph = '★'
+
+// bestPoint is the best sample seen so far for a chart's objective.
+type bestPoint struct {
+	x, y float64
+	ok   bool
+}
+
+// better reports whether y improves on the current best for direction.
+func (b bestPoint) better(y float64, direction string) bool {
+	if !b.ok {
+		return true
+	}
+	if direction == ObjectiveMaximize {
+		return y > b.y
+	}
+	return y < b.y
+}
+
+// SetObjective marks the chart as an objective to minimize or maximize.
+//
+// An empty direction clears the objective and hides the best marker.
+func (c *EpochLineChart) SetObjective(direction string) {
+	if direction != ObjectiveMinimize && direction != ObjectiveMaximize {
+		direction = ""
+	}
+	if c.objective == direction {
+		return
+	}
+	c.objective = direction
+	c.recomputeBest()
+	c.dirty = true
+}
+
+// Objective returns the chart's objective direction, or "" if none.
+func (c *EpochLineChart) Objective() string { return c.objective }
+
+// Best returns the best point across all series for the chart's objective.
+func (c *EpochLineChart) Best() (x, y float64, ok bool) {
+	return c.best.x, c.best.y, c.best.ok
+}
+
+// BestLabel describes the best point, e.g. "best: 0.12 @ step 340" or
+// "best: 0.12 @ epoch 3" when X values are epochs.
+//
+// Returns "" when the chart has no objective or no finite samples.
+func (c *EpochLineChart) BestLabel() string {
+	if c.objective == "" || !c.best.ok {
+		return ""
+	}
+	label := c.xLabel
+	if label == "" {
+		label = "step"
+	}
+	return fmt.Sprintf("best: %v @ %s %s",
+		formatSigFigs(c.best.y, 4), label, formatXValue(c.best.x))
+}
+
+// trackBest folds newly appended samples into the best point.
+func (c *EpochLineChart) trackBest(xs, ys []float64) {
+	if c.objective == "" {
+		return
+	}
+	for i, y := range ys {
+		if !isFinite(xs[i]) || !isFinite(y) {
+			continue
+		}
+		if c.best.better(y, c.objective) {
+			c.best = bestPoint{x: xs[i], y: y, ok: true}
+		}
+	}
+}
+
+// recomputeBest rescans all series, e.g. after a series was removed.
+func (c *EpochLineChart) recomputeBest() {
+	c.best = bestPoint{}
+	for _, key := range c.order {
+		if s, ok := c.data[key]; ok {
+			c.trackBest(s.X, s.Y)
+		}
+	}
+}
+
+// drawBestMarker draws the best-point glyph over the series if it is in view.
+func (c *EpochLineChart) drawBestMarker(graphStartX int) {
+	if c.objective == "" || !c.best.ok {
+		return
+	}
+
+	yValue, ok := c.scaleYValue(c.best.y)
+	if !ok {
+		return
+	}
+	xRange := c.ViewMaxX() - c.ViewMinX()
+	yRange := c.ViewMaxY() - c.ViewMinY()
+	if xRange <= 0 || yRange <= 0 {
+		return
+	}
+
+	x := (c.best.x - c.ViewMinX()) / xRange * float64(c.GraphWidth())
+	y := (yValue - c.ViewMinY()) / yRange * float64(c.GraphHeight())
+	if x < 0 || x > float64(c.GraphWidth()) || y < 0 || y > float64(c.GraphHeight()) {
+		return
+	}
+
+	col := min(int(x), c.GraphWidth()-1)
+	row := max(c.GraphHeight()-1-int(y), 0)
+	c.Canvas.SetCell(
+		canvas.Point{X: graphStartX + col, Y: row},
+		canvas.NewCellWithStyle(bestPointGlyph, bestPointStyle),
+	)
+}
@@ -0,0 +1,135 @@
+package leet
+
+import (
+	"bufio"
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	tea "charm.land/bubbletea/v2"
+
+	"github.com/wandb/wandb/core/internal/observability"
+)
+
+// maxOutputLogLines bounds the lines ingested from an output.log file.
+const maxOutputLogLines = 100_000
+
+// outputLogNames are the console output files looked for next to a
+// .wandb file, in order of preference.
+var outputLogNames = []string{
+	"output.log",
+	"output.log.gz",
+	filepath.Join("files", "output.log"),
+	filepath.Join("files", "output.log.gz"),
+}
+
+// OutputLogMsg carries the lines of a run's output.log file.
+type OutputLogMsg struct {
+	Lines []ConsoleLogLine
+}
+
+// WorkspaceOutputLogMsg wraps an OutputLogMsg with the originating run key.
+type WorkspaceOutputLogMsg struct {
+	RunKey string
+	Lines  []ConsoleLogLine
+}
+
+// readOutputLogCmd reads the output.log file stored alongside runPath,
+// if any. It returns nil when there is none.
+func readOutputLogCmd(runPath string, logger *observability.CoreLogger) tea.Cmd {
+	if runPath == "" {
+		return nil
+	}
+	return func() tea.Msg {
+		lines, err := readOutputLog(filepath.Dir(runPath))
+		if err != nil {
+			logger.Warn(fmt.Sprintf("leet: error reading output.log for %s: %v", runPath, err))
+		}
+		if len(lines) == 0 {
+			return nil
+		}
+		return OutputLogMsg{Lines: lines}
+	}
+}
+
+// readOutputLog reads the first output log found in runDir.
+//
+// Lines that start with an RFC 3339 timestamp take it; other lines
+// inherit the previous line's timestamp, or the file's modification time.
+func readOutputLog(runDir string) ([]ConsoleLogLine, error) {
+	for _, name := range outputLogNames {
+		path := filepath.Join(runDir, name)
+		info, err := os.Stat(path)
+		if errors.Is(err, fs.ErrNotExist) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		if info.IsDir() {
+			continue
+		}
+		return readOutputLogFile(path, info.ModTime())
+	}
+	return nil, nil
+}
+
+func readOutputLogFile(path string, modTime time.Time) ([]ConsoleLogLine, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var r io.Reader = f
+	if strings.HasSuffix(path, ".gz") {
+		gz, err := gzip.NewReader(f)
+		if err != nil {
+			return nil, err
+		}
+		defer gz.Close()
+		r = gz
+	}
+
+	var lines []ConsoleLogLine
+	ts := modTime
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for scanner.Scan() && len(lines) < maxOutputLogLines {
+		text := scanner.Text()
+		// Keep only the final state of carriage-return redraws.
+		if i := strings.LastIndexByte(text, '\r'); i >= 0 {
+			text = text[i+1:]
+		}
+		if t, rest, ok := cutOutputLogTimestamp(text); ok {
+			ts, text = t, rest
+		}
+		if runes := []rune(text); len(runes) > maxConsoleLineLength {
+			text = string(runes[:maxConsoleLineLength])
+		}
+		lines = append(lines, ConsoleLogLine{
+			Timestamp: ts,
+			Content:   sanitizeConsoleLine(strings.TrimRight(text, " \t")),
+		})
+	}
+	return lines, scanner.Err()
+}
+
+// cutOutputLogTimestamp splits a leading RFC 3339 timestamp off a line.
+func cutOutputLogTimestamp(line string) (time.Time, string, bool) {
+	prefix, rest, ok := strings.Cut(line, " ")
+	if !ok || len(prefix) < len("2006-01-02T15:04:05Z") {
+		return time.Time{}, line, false
+	}
+	ts, err := time.Parse(time.RFC3339Nano, prefix)
+	if err != nil {
+		return time.Time{}, line, false
+	}
+	return ts, rest, true
+}
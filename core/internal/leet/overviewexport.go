@@ -0,0 +1,127 @@
+package leet
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"gopkg.in/yaml.v3"
+)
+
+// overviewExport is the YAML document written when exporting a run
+// overview. Sections are in the order the overview sidebar shows them.
+type overviewExport struct {
+	Run         overviewExportRun `yaml:"run"`
+	Config      map[string]any    `yaml:"config"`
+	Summary     map[string]any    `yaml:"summary"`
+	Environment map[string]any    `yaml:"environment"`
+}
+
+// overviewExportRun holds the run's header metadata.
+type overviewExportRun struct {
+	ID       string   `yaml:"id,omitempty"`
+	Name     string   `yaml:"name,omitempty"`
+	Entity   string   `yaml:"entity,omitempty"`
+	Project  string   `yaml:"project,omitempty"`
+	State    string   `yaml:"state,omitempty"`
+	ExitCode *int32   `yaml:"exit_code,omitempty"`
+	Tags     []string `yaml:"tags,omitempty"`
+	Notes    string   `yaml:"notes,omitempty"`
+}
+
+// ExportOverview serializes the run's metadata, config, summary and
+// environment as a single YAML document.
+//
+// As in ExportConfig, the SDK-internal "_wandb" config key is omitted.
+func (ro *RunOverview) ExportOverview() ([]byte, error) {
+	doc := overviewExport{
+		Run: overviewExportRun{
+			ID:      ro.ID(),
+			Name:    ro.DisplayName(),
+			Entity:  ro.Entity(),
+			Project: ro.Project(),
+			Tags:    ro.Tags(),
+			Notes:   ro.Notes(),
+		},
+		Config:      map[string]any{},
+		Summary:     map[string]any{},
+		Environment: map[string]any{},
+	}
+	if ro.State() != RunStateUnknown {
+		doc.Run.State = ro.StateString()
+	}
+	if code, ok := ro.ExitCode(); ok {
+		doc.Run.ExitCode = &code
+	}
+	if ro.runConfig != nil {
+		doc.Config = ro.runConfig.CloneTree()
+		delete(doc.Config, "_wandb")
+	}
+	if ro.runSummary != nil {
+		doc.Summary = ro.runSummary.ToNestedMaps()
+	}
+	if ro.runEnvironment != nil {
+		if env := ro.runEnvironment.ToRunConfigData(); env != nil {
+			doc.Environment = env
+		}
+	}
+
+	return yaml.Marshal(doc)
+}
+
+// writeOverviewFile exports ro to a new overview-<runID>.yaml file in dir,
+// or the working directory if dir is "", and returns its absolute path.
+func writeOverviewFile(ro *RunOverview, runID, dir string) (string, error) {
+	if ro == nil {
+		return "", fmt.Errorf("no run selected")
+	}
+	data, err := ro.ExportOverview()
+	if err != nil {
+		return "", err
+	}
+	if runID == "" {
+		runID = "run"
+	}
+	if dir == "" {
+		dir = "."
+	}
+	path, err := writeNewFile(dir, fmt.Sprintf("overview-%s", runID), "yaml", data)
+	if err != nil {
+		return "", err
+	}
+	if abs, err := filepath.Abs(path); err == nil {
+		path = abs
+	}
+	return path, nil
+}
+
+// exportOverview writes the run's overview to a YAML file in the run's
+// directory, or the working directory for remote runs.
+func (r *Run) exportOverview() {
+	path, err := writeOverviewFile(r.runOverview, r.runOverview.ID(), r.runDir())
+	if err != nil {
+		r.setNotice("Export failed: %v", err)
+		return
+	}
+	r.setNotice("Wrote run overview to %s", path)
+}
+
+// exportOverview writes the overview of the run under the runs list
+// cursor to a YAML file in that run's directory.
+func (w *Workspace) exportOverview() {
+	cur, ok := w.runs.CurrentItem()
+	if !ok {
+		w.setRunNotice("Export failed: no run selected")
+		return
+	}
+	ro := w.runOverview[cur.Key]
+	runID := extractRunID(cur.Key)
+	if ro != nil && ro.ID() != "" {
+		runID = ro.ID()
+	}
+	path, err := writeOverviewFile(ro, runID, filepath.Join(w.wandbDir, cur.Key))
+	if err != nil {
+		w.setRunNotice("Export failed: %v", err)
+		return
+	}
+	w.setRunNotice("Wrote run overview to %s", path)
+}
@@ -0,0 +1,67 @@
+package leet_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	tea "charm.land/bubbletea/v2"
+
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v3"
+
+	"github.com/wandb/wandb/core/internal/leet"
+	"github.com/wandb/wandb/core/internal/observability"
+	spb "github.com/wandb/wandb/core/pkg/service_go_proto"
+)
+
+func TestRun_ExportOverview_WritesConfigSummaryAndEnvironment(t *testing.T) {
+	logger := observability.NewNoOpLogger()
+	cfg := leet.NewConfigManager(filepath.Join(t.TempDir(), "config.json"), logger)
+	runDir := t.TempDir()
+	run := leet.NewRun(&leet.RunParams{
+		RunFile: filepath.Join(runDir, "run-abc123.wandb"),
+	}, cfg, logger)
+
+	run.TestHandleRecordMsg(leet.RunMsg{
+		ID:          "abc123",
+		DisplayName: "bright-sky-7",
+		Project:     "vision",
+		Config: &spb.ConfigRecord{
+			Update: []*spb.ConfigItem{
+				{NestedKey: []string{"trainer", "lr"}, ValueJson: "0.001"},
+				{NestedKey: []string{"_wandb", "cli_version"}, ValueJson: `"0.20.0"`},
+			},
+		},
+	})
+	run.TestHandleRecordMsg(leet.SummaryMsg{Summary: []*spb.SummaryRecord{{
+		Update: []*spb.SummaryItem{
+			{NestedKey: []string{"loss"}, ValueJson: "0.25"},
+		},
+	}}})
+	run.TestHandleRecordMsg(leet.SystemInfoMsg{
+		Record: &spb.EnvironmentRecord{WriterId: "w1", Os: "linux", Python: "3.12.1"},
+	})
+
+	run.Update(tea.KeyPressMsg{Code: 'E', Text: "E"})
+
+	data, err := os.ReadFile(filepath.Join(runDir, "overview-abc123.yaml"))
+	require.NoError(t, err)
+
+	var doc struct {
+		Run         map[string]any `yaml:"run"`
+		Config      map[string]any `yaml:"config"`
+		Summary     map[string]any `yaml:"summary"`
+		Environment map[string]any `yaml:"environment"`
+	}
+	require.NoError(t, yaml.Unmarshal(data, &doc))
+
+	require.Equal(t, "abc123", doc.Run["id"])
+	require.Equal(t, "bright-sky-7", doc.Run["name"])
+	require.Equal(t, "vision", doc.Run["project"])
+	require.Equal(t, map[string]any{"trainer": map[string]any{"lr": 0.001}}, doc.Config)
+	require.Equal(t, 0.25, doc.Summary["loss"])
+	require.Contains(t, string(data), "linux")
+	require.Contains(t, string(data), "3.12.1")
+	require.NotEmpty(t, doc.Environment)
+}
@@ -12,6 +12,9 @@ type PagedList struct {
 
 	Height int
 	Active bool
+
+	// MaxHeight caps Height when the list shares space with others.
+	MaxHeight int
 }
 
 func (s *PagedList) ItemsPerPage() int {
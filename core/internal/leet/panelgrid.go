@@ -8,6 +8,7 @@ type GridSpec struct {
 	MinCellW    int // inner chart width (no borders)
 	MinCellH    int // inner chart height (no borders + title line)
 	HeaderLines int // lines reserved above the grid (section header etc.)
+	FooterLines int // lines reserved under each chart inside its cell
 }
 
 // GridSize is the final rows/cols after clamping to available space.
@@ -78,9 +79,9 @@ func EffectiveGridSize(availW, availH int, spec GridSpec) GridSize {
 		availH = 0
 	}
 
-	// Minimum padded cell sizes: chart + borders + title.
+	// Minimum padded cell sizes: chart + borders + title + footer.
 	minWWithPad := spec.MinCellW + ChartBorderSize
-	minHWithPad := spec.MinCellH + ChartBorderSize + ChartTitleHeight
+	minHWithPad := spec.MinCellH + ChartBorderSize + ChartTitleHeight + spec.FooterLines
 
 	// Compute the maximum grid that fits.
 	maxCols := 1
@@ -126,7 +127,8 @@ func ComputeGridDims(availW, availH int, spec GridSpec) GridDims {
 
 	// Inner chart sizes (respect minimums).
 	innerW := max(max(cellWWithPad-ChartBorderSize, spec.MinCellW), 0)
-	innerH := max(max(cellHWithPad-ChartBorderSize-ChartTitleHeight, spec.MinCellH), 0)
+	innerH := max(max(
+		cellHWithPad-ChartBorderSize-ChartTitleHeight-spec.FooterLines, spec.MinCellH), 0)
 
 	return GridDims{
 		CellW:            innerW,
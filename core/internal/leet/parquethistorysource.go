@@ -214,6 +214,7 @@ func (s *ParquetHistorySource) Read(
 			RunMsg{
 				RunPath:     s.runPath,
 				ID:          s.runInfo.runId,
+				Entity:      s.runInfo.entity,
 				Project:     s.runInfo.project,
 				DisplayName: s.runInfo.displayName,
 				Config:      nil,
@@ -327,6 +328,9 @@ func parseParquetHistorySteps(
 				continue
 			}
 
+			if len(existing.X) == 0 {
+				h.Keys = append(h.Keys, keyValue.Key)
+			}
 			existing.X = append(existing.X, currentStep)
 			existing.Y = append(existing.Y, value)
 			h.Metrics[keyValue.Key] = existing
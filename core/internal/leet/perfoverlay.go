@@ -0,0 +1,87 @@
+package leet
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"charm.land/lipgloss/v2"
+)
+
+// envPerfOverlay enables the performance overlay without touching the config.
+const envPerfOverlay = "WANDB_LEET_PERF_OVERLAY"
+
+// perfSmoothing is the weight of the newest frame interval in the FPS average.
+const perfSmoothing = 0.2
+
+// perfStats tracks timings shown by the performance overlay.
+type perfStats struct {
+	// fromEnv is set when the overlay was enabled via envPerfOverlay.
+	fromEnv bool
+
+	lastUpdate time.Duration // time spent in the last Model.Update
+	lastView   time.Duration // time spent rendering the last frame
+
+	lastFrame     time.Time     // when the previous frame was rendered
+	frameInterval time.Duration // smoothed time between frames
+}
+
+func newPerfStats() perfStats {
+	enabled, _ := strconv.ParseBool(strings.TrimSpace(os.Getenv(envPerfOverlay)))
+	return perfStats{fromEnv: enabled}
+}
+
+// perfOverlayEnabled reports whether Update and View should be timed.
+func (m *Model) perfOverlayEnabled() bool {
+	return m.perf.fromEnv || (m.config != nil && m.config.PerfOverlay())
+}
+
+// trackUpdate records the time since start as the last Update duration.
+//
+// Meant to be deferred at the top of Model.Update.
+func (p *perfStats) trackUpdate(start time.Time) {
+	p.lastUpdate = time.Since(start)
+}
+
+// trackFrame records a rendered frame that took d to build.
+func (p *perfStats) trackFrame(now time.Time, d time.Duration) {
+	p.lastView = d
+	if !p.lastFrame.IsZero() {
+		interval := now.Sub(p.lastFrame)
+		if p.frameInterval == 0 {
+			p.frameInterval = interval
+		} else {
+			p.frameInterval = time.Duration(
+				perfSmoothing*float64(interval) + (1-perfSmoothing)*float64(p.frameInterval))
+		}
+	}
+	p.lastFrame = now
+}
+
+// label renders the overlay text, e.g. "update 0.4ms │ frame 2.1ms │ 30 fps".
+func (p *perfStats) label() string {
+	fps := "– fps"
+	if p.frameInterval > 0 {
+		fps = fmt.Sprintf("%.0f fps", float64(time.Second)/float64(p.frameInterval))
+	}
+	return fmt.Sprintf("update %s │ frame %s │ %s",
+		formatMillis(p.lastUpdate), formatMillis(p.lastView), fps)
+}
+
+// formatMillis formats d in milliseconds with one decimal.
+func formatMillis(d time.Duration) string {
+	return fmt.Sprintf("%.1fms", float64(d.Microseconds())/1000)
+}
+
+// renderPerfOverlay draws the performance label over the top-right corner of
+// content.
+func (m *Model) renderPerfOverlay(content string) string {
+	box := perfOverlayStyle.Render(m.perf.label())
+	x := max(m.width-lipgloss.Width(box), 0)
+	return lipgloss.NewCompositor(
+		lipgloss.NewLayer(content),
+		lipgloss.NewLayer(box).X(x).Y(0).Z(1),
+	).Render()
+}
@@ -0,0 +1,41 @@
+package leet
+
+import (
+	"charm.land/lipgloss/v2"
+	"github.com/NimbleMarkets/ntcharts/v2/canvas"
+	"github.com/NimbleMarkets/ntcharts/v2/canvas/graph"
+)
+
+// pointMarkerRune marks a logged sample on a metrics line.
+const pointMarkerRune = '•'
+
+// SetPointMarkers sets whether each drawn sample is marked with a dot
+// on top of the line.
+func (c *EpochLineChart) SetPointMarkers(show bool) {
+	if c.pointMarkers == show {
+		return
+	}
+	c.pointMarkers = show
+	c.dirty = true
+}
+
+// drawPointMarkers marks the cells of a series' drawn points, given in
+// the coordinates of the braille grid the series was drawn on.
+//
+// Points sharing a cell get a single marker; the line between cells
+// stays visible.
+func (c *EpochLineChart) drawPointMarkers(
+	bGrid *graph.BrailleGrid,
+	points []canvas.Float64Point,
+	graphStartX int,
+	style lipgloss.Style,
+) {
+	for _, p := range points {
+		gp := bGrid.GridPoint(p)
+		col, row := gp.X/2, gp.Y/4 // braille cells are 2x4 dots
+		c.Canvas.SetCell(
+			canvas.Point{X: graphStartX + col, Y: row},
+			canvas.NewCellWithStyle(pointMarkerRune, style),
+		)
+	}
+}
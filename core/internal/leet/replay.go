@@ -0,0 +1,248 @@
+package leet
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"time"
+
+	tea "charm.land/bubbletea/v2"
+)
+
+const (
+	// ReplayTickInterval is how often a playing replay advances.
+	ReplayTickInterval = 100 * time.Millisecond
+
+	// replayBaseDuration is roughly how long a full replay takes at 1x.
+	replayBaseDuration = 30 * time.Second
+)
+
+// replaySpeeds are the selectable playback speed multipliers.
+var replaySpeeds = []float64{0.25, 0.5, 1, 2, 4, 8, 16}
+
+// replayDefaultSpeedIdx points at 1x in replaySpeeds.
+const replayDefaultSpeedIdx = 2
+
+// ReplayTickMsg advances an active replay.
+type ReplayTickMsg struct {
+	Time time.Time
+}
+
+// replayTrack is one chart series captured for replay.
+//
+// X and Y alias the series' slices; the charts are reset before a replay
+// starts, so the captured slices are no longer appended to.
+type replayTrack struct {
+	title   string
+	runPath string
+	data    MetricData
+}
+
+// Replay re-feeds a finished run's history into the charts over a
+// compressed timeline.
+//
+// It holds the captured series and the sorted distinct X values across
+// them; each tick moves a cursor over those X values forward in proportion
+// to the elapsed time and the playback speed.
+type Replay struct {
+	tracks []replayTrack
+
+	// steps are the distinct X values across all tracks, in order.
+	steps []float64
+
+	// cursor is the number of steps already fed to the charts.
+	cursor int
+
+	// carry accumulates fractional steps between ticks so slow
+	// speeds still make progress.
+	carry float64
+
+	playing  bool
+	speedIdx int
+
+	// lastTick is the time of the previous tick, zero before the first
+	// tick or after a pause.
+	lastTick time.Time
+
+	// ticking is set while a ReplayTickMsg is scheduled, so that resuming
+	// playback doesn't start a second tick chain.
+	ticking bool
+}
+
+// newReplay creates a replay of tracks that plays from the first step.
+func newReplay(tracks []replayTrack) *Replay {
+	var steps []float64
+	for _, tr := range tracks {
+		steps = append(steps, tr.data.X...)
+	}
+	slices.Sort(steps)
+
+	return &Replay{
+		tracks:   tracks,
+		steps:    slices.Compact(steps),
+		playing:  true,
+		speedIdx: replayDefaultSpeedIdx,
+	}
+}
+
+// Playing reports whether the replay is advancing.
+func (rp *Replay) Playing() bool { return rp.playing }
+
+// Done reports whether every step has been fed.
+func (rp *Replay) Done() bool { return rp.cursor >= len(rp.steps) }
+
+// Speed returns the current speed multiplier.
+func (rp *Replay) Speed() float64 { return replaySpeeds[rp.speedIdx] }
+
+// TogglePause pauses or resumes playback.
+func (rp *Replay) TogglePause() {
+	rp.playing = !rp.playing
+	rp.lastTick = time.Time{}
+}
+
+// Faster increases the playback speed, if possible.
+func (rp *Replay) Faster() {
+	rp.speedIdx = min(rp.speedIdx+1, len(replaySpeeds)-1)
+}
+
+// Slower decreases the playback speed, if possible.
+func (rp *Replay) Slower() {
+	rp.speedIdx = max(rp.speedIdx-1, 0)
+}
+
+// Advance returns the records that become due at time now.
+//
+// There is one record per run, holding every point of its series that
+// falls within the steps passed over.
+func (rp *Replay) Advance(now time.Time) []HistoryMsg {
+	if !rp.playing || rp.Done() {
+		return nil
+	}
+	if rp.lastTick.IsZero() {
+		rp.lastTick = now
+		return nil
+	}
+
+	elapsed := now.Sub(rp.lastTick)
+	rp.lastTick = now
+	if elapsed <= 0 {
+		return nil
+	}
+
+	perSecond := float64(len(rp.steps)) / replayBaseDuration.Seconds()
+	rp.carry += perSecond * rp.Speed() * elapsed.Seconds()
+
+	n := int(rp.carry)
+	if n == 0 {
+		return nil
+	}
+	rp.carry -= float64(n)
+
+	return rp.advanceTo(min(rp.cursor+n, len(rp.steps)))
+}
+
+// Remaining returns all records not yet fed and moves the cursor to the end.
+func (rp *Replay) Remaining() []HistoryMsg {
+	return rp.advanceTo(len(rp.steps))
+}
+
+// advanceTo moves the cursor to end and returns the points of the steps
+// in between, grouped by run.
+func (rp *Replay) advanceTo(end int) []HistoryMsg {
+	if end <= rp.cursor {
+		return nil
+	}
+	from := rp.steps[rp.cursor]
+	to := rp.steps[end-1]
+	rp.cursor = end
+
+	var records []HistoryMsg
+	byRun := make(map[string]int)
+	for _, tr := range rp.tracks {
+		xs := tr.data.X
+		lo := sort.SearchFloat64s(xs, from)
+		hi := sort.Search(len(xs), func(i int) bool { return xs[i] > to })
+		if lo >= hi {
+			continue
+		}
+
+		idx, ok := byRun[tr.runPath]
+		if !ok {
+			idx = len(records)
+			byRun[tr.runPath] = idx
+			records = append(records, HistoryMsg{
+				RunPath: tr.runPath,
+				Metrics: make(map[string]MetricData),
+			})
+		}
+		records[idx].Metrics[tr.title] = MetricData{
+			X: xs[lo:hi:hi],
+			Y: tr.data.Y[lo:hi:hi],
+		}
+		records[idx].Keys = append(records[idx].Keys, tr.title)
+	}
+	return records
+}
+
+// Status returns a compact status bar label.
+func (rp *Replay) Status() string {
+	state := "▶"
+	if !rp.playing {
+		state = "⏸"
+	}
+	return fmt.Sprintf("Replay %s %gx [%d/%d] (p: play/pause, +/-: speed, v: stop)",
+		state, rp.Speed(), rp.cursor, len(rp.steps))
+}
+
+// nextTick schedules the next replay tick unless one is already pending.
+func (rp *Replay) nextTick() tea.Cmd {
+	if rp.ticking || !rp.playing || rp.Done() {
+		return nil
+	}
+	rp.ticking = true
+	return tea.Tick(ReplayTickInterval, func(t time.Time) tea.Msg {
+		return ReplayTickMsg{Time: t}
+	})
+}
+
+// replayTracks captures every chart series for replay.
+func (mg *MetricsGrid) replayTracks() []replayTrack {
+	mg.mu.RLock()
+	defer mg.mu.RUnlock()
+
+	var tracks []replayTrack
+	for _, ch := range mg.all {
+		for _, seriesKey := range ch.order {
+			s := ch.data[seriesKey]
+			if s == nil || len(s.X) == 0 {
+				continue
+			}
+			tracks = append(tracks, replayTrack{
+				title:   ch.Title(),
+				runPath: seriesKey,
+				data:    s.stepData(),
+			})
+		}
+	}
+	return tracks
+}
+
+// reset drops all charts while keeping layout, filter, and color settings.
+func (mg *MetricsGrid) reset() {
+	mg.clearFocus()
+
+	mg.mu.Lock()
+	defer mg.mu.Unlock()
+
+	for ch := range mg.lastDrawnCharts {
+		ch.Park()
+	}
+	mg.lastDrawnCharts = nil
+	mg.all = make([]*EpochLineChart, 0)
+	mg.byTitle = make(map[string]*EpochLineChart)
+	mg.loggedOrder = make(map[string]int)
+	mg.loggedSeq = 0
+	mg.xAxes = make(map[string]map[string]*epochAxis)
+	mg.overflow = make(metricsOverflow)
+	mg.applyFilterNoLock()
+}
@@ -304,7 +304,5 @@ func (rs *RightSidebar) buildNavigationInfo() string {
 
 // animationCmd returns a command to continue the animation.
 func (rs *RightSidebar) animationCmd() tea.Cmd {
-	return tea.Tick(AnimationFrame, func(t time.Time) tea.Msg {
-		return RightSidebarAnimationMsg{}
-	})
+	return animationFrameCmd(RightSidebarAnimationMsg{})
 }
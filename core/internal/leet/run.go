@@ -3,6 +3,7 @@ package leet
 import (
 	"context"
 	"fmt"
+	"path/filepath"
 	"runtime/debug"
 	"strings"
 	"sync"
@@ -96,6 +97,24 @@ type Run struct {
 	animationMu sync.Mutex
 	animating   bool
 
+	// replay re-feeds a finished run's history into the charts;
+	// nil when not replaying.
+	replay *Replay
+
+	// configExport is the status bar submenu for exporting the run config.
+	configExport configExportMenu
+
+	// runPath keys the run's series in the metrics grid; it is taken
+	// from the first history message.
+	runPath string
+
+	// notice is a one-off status bar message, shown for runNoticeTTL.
+	notice   string
+	noticeAt time.Time
+
+	// clipboard is the text most recently copied to the clipboard.
+	clipboard string
+
 	// Loading progress.
 	recordsLoaded int
 	loadStartTime time.Time
@@ -138,6 +157,9 @@ func NewRun(
 	metricsGrid := NewMetricsGrid(cfg, cfg.MetricsGrid, focus, logger)
 	metricsGrid.SetSingleSeriesColorMode(cfg.SingleRunColorMode())
 
+	consoleLogs := NewRunConsoleLogs()
+	consoleLogs.SetSampleThreshold(cfg.ConsoleLogSampleThreshold())
+
 	mediaStore := NewMediaStore()
 
 	run := &Run{
@@ -151,7 +173,7 @@ func NewRun(
 		runOverview:          ro,
 		leftSidebar:          NewRunOverviewSidebar(cfg, runOverviewAnimState, ro, SidebarSideLeft),
 		rightSidebar:         NewRightSidebar(cfg, focus, logger),
-		consoleLogs:          NewRunConsoleLogs(),
+		consoleLogs:          consoleLogs,
 		consoleLogsPane:      NewConsoleLogsPane(consoleLogsPaneAnimState),
 		mediaStore:           mediaStore,
 		mediaPane:            NewMediaPane(mediaPaneAnimState, cfg.MediaGrid),
@@ -160,6 +182,8 @@ func NewRun(
 		logger:               logger,
 	}
 	run.focusMgr = run.buildRunFocusManager()
+	run.consoleLogsPane.ResetScroll(cfg.ConsoleLogsStart() == ConsoleLogsStartTail)
+	run.consoleLogsPane.SetTimestampsHidden(!cfg.ConsoleLogTimestamps())
 	return run
 }
 
@@ -230,12 +254,14 @@ func (r *Run) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		return r, r.mediaPane.handlePrepareMsg()
 
 	case tea.KeyPressMsg:
+		cmds = append(cmds, r.metricsGrid.NoteInput())
 		if c := r.handleKeyPressMsg(t); c != nil {
 			cmds = append(cmds, c)
 		}
 		return r, tea.Batch(cmds...)
 
 	case tea.MouseMsg:
+		cmds = append(cmds, r.metricsGrid.NoteInput())
 		if c := r.handleMouseMsg(t); c != nil {
 			cmds = append(cmds, c)
 		}
@@ -283,6 +309,9 @@ func (r *Run) dispatch(msg tea.Msg) []tea.Cmd {
 	switch t := msg.(type) {
 	case InitMsg:
 		return r.handleInit(t)
+	case OutputLogMsg:
+		r.consoleLogs.AddFileLines(t.Lines)
+		return nil
 	case ChunkedBatchMsg:
 		return r.handleChunkedBatch(t)
 	case BatchedRecordsMsg:
@@ -301,6 +330,8 @@ func (r *Run) dispatch(msg tea.Msg) []tea.Cmd {
 		return r.handleMediaPaneAnimation()
 	case MetricsGridAnimationMsg:
 		return r.handleMetricsGridAnimation()
+	case ReplayTickMsg:
+		return r.handleReplayTick(t)
 	default:
 		// History/Run/Summary/Stats/SystemInfo/FileComplete/Error
 		if cmd := r.handleRecordMsg(msg); cmd != nil {
@@ -351,8 +382,8 @@ func (r *Run) renderMainView() string {
 
 		if r.metricsGridAnimState.IsVisible() && layout.height > 0 {
 			if r.metricsGrid.ChartCount() == 0 {
-				sections = append(sections,
-					renderMetricsEmptyState(w, layout.height, "No scalar metrics logged."))
+				sections = append(sections, renderMetricsEmptyState(w, layout.height,
+					noMetricsHint(len(r.consoleLogs.Items()) > 0, layout.consoleLogsHeight > 0)))
 			} else {
 				dims := r.metricsGrid.CalculateChartDimensions(w, layout.height)
 				sections = append(sections, r.metricsGrid.View(dims))
@@ -366,6 +397,11 @@ func (r *Run) renderMainView() string {
 		}
 		if layout.consoleLogsHeight > 0 {
 			r.consoleLogsPane.SetConsoleLogs(r.consoleLogs.Items())
+			if r.config.ConsoleLogCommand() {
+				r.consoleLogsPane.SetCommand(r.runOverview.LaunchCommand())
+			} else {
+				r.consoleLogsPane.SetCommand("")
+			}
 			sections = append(sections, r.consoleLogsPane.View(w, "", ""))
 		}
 
@@ -386,10 +422,39 @@ func (r *Run) renderMainView() string {
 	)
 	statusBar := r.renderStatusBar()
 
-	fullView := lipgloss.JoinVertical(lipgloss.Left, mainView, statusBar)
+	fullView := lipgloss.JoinVertical(lipgloss.Left, r.renderTitleBar(), mainView, statusBar)
 	return lipgloss.Place(r.width, r.height, lipgloss.Left, lipgloss.Top, fullView)
 }
 
+// renderTitleBar renders the run's entity/project/id path and display name
+// above the sidebars and charts.
+func (r *Run) renderTitleBar() string {
+	var path []string
+	for _, part := range []string{
+		r.runOverview.Entity(),
+		r.runOverview.Project(),
+		r.runOverview.ID(),
+	} {
+		if part != "" {
+			path = append(path, part)
+		}
+	}
+
+	title := strings.Join(path, " / ")
+	if name := r.runOverview.DisplayName(); name != "" {
+		if title != "" {
+			title += " • "
+		}
+		title += runTitleBarNameStyle.Render(name)
+	}
+
+	return runTitleBarStyle.
+		Width(r.width).
+		MaxWidth(r.width).
+		MaxHeight(RunTitleBarHeight).
+		Render(title)
+}
+
 // buildMainViewWithSidebars builds the main view with sidebars.
 func (r *Run) buildMainViewWithSidebars(
 	gridView string,
@@ -485,12 +550,18 @@ func (r *Run) buildStatusText() string {
 	if r.metricsGrid.IsFilterMode() {
 		return r.buildMetricsFilterStatus()
 	}
+	if r.metricsGrid.IsJumpMode() {
+		return buildJumpToStepStatus(r.metricsGrid)
+	}
 	if r.rightSidebar.IsFilterMode() {
 		return r.buildSystemMetricsFilterStatus()
 	}
 	if r.config.IsAwaitingGridConfig() {
 		return r.config.GridConfigStatus()
 	}
+	if r.configExport.IsActive() {
+		return r.configExport.Status()
+	}
 	if r.lastError != "" {
 		return "Error: " + r.lastError
 	}
@@ -551,36 +622,64 @@ func (r *Run) buildLoadingStatus() string {
 	return "Loading data..."
 }
 
-// buildActiveStatus builds status for active (non-loading, non-filter) mode.
-func (r *Run) buildActiveStatus() string {
-	var parts []string
+// activeFilters lists the run view's active filters.
+func (r *Run) activeFilters() []activeFilter {
+	var filters []activeFilter
 
-	// Add filter info if active.
 	if r.metricsGrid.IsFiltering() {
-		parts = append(parts, fmt.Sprintf(
-			"Filter (%s): %q [%d/%d] (/ to change, Ctrl+L to clear)",
-			r.metricsGrid.FilterMode().String(),
-			r.metricsGrid.FilterQuery(),
-			r.metricsGrid.FilteredChartCount(), r.metricsGrid.ChartCount()))
+		filters = append(filters, activeFilter{
+			region: "Metrics",
+			mode:   r.metricsGrid.FilterMode().String(),
+			query:  r.metricsGrid.FilterQuery(),
+			matches: fmt.Sprintf("%d/%d",
+				r.metricsGrid.FilteredChartCount(), r.metricsGrid.ChartCount()),
+			editKey:  "/",
+			clearKey: "ctrl+/",
+		})
+	}
+
+	if r.rightSidebar.IsFiltering() {
+		grid := r.rightSidebar.metricsGrid
+		filters = append(filters, activeFilter{
+			region: "System",
+			mode:   grid.FilterMode().String(),
+			query:  grid.FilterQuery(),
+			matches: fmt.Sprintf("%d/%d",
+				grid.FilteredChartCount(), grid.ChartCount()),
+			editKey:  "\\",
+			clearKey: "ctrl+\\",
+		})
 	}
 
-	// Add overview filter info if active.
 	if r.leftSidebar.IsFiltering() {
-		parts = append(parts, fmt.Sprintf("Overview: %q [%s] (o to change, Ctrl+K to clear)",
-			r.leftSidebar.FilterQuery(),
-			r.leftSidebar.FilterInfo(),
-		))
+		filters = append(filters, activeFilter{
+			region:   "Overview",
+			query:    r.leftSidebar.FilterQuery(),
+			matches:  r.leftSidebar.FilterInfo(),
+			editKey:  "o",
+			clearKey: "ctrl+o",
+		})
 	}
 
-	if r.rightSidebar.IsFiltering() {
-		grid := r.rightSidebar.metricsGrid
-		parts = append(parts, fmt.Sprintf(
-			"System filter (%s): %q [%d/%d] (\\ to change, Ctrl+\\ to clear)",
-			grid.FilterMode().String(),
-			grid.FilterQuery(),
-			grid.FilteredChartCount(),
-			grid.ChartCount(),
-		))
+	return filters
+}
+
+// buildActiveStatus builds status for active (non-loading, non-filter) mode.
+func (r *Run) buildActiveStatus() string {
+	var parts []string
+
+	if r.replay != nil {
+		parts = append(parts, r.replay.Status())
+	}
+	if r.configExport.result != "" {
+		parts = append(parts, r.configExport.result)
+	}
+	if r.notice != "" && time.Since(r.noticeAt) < runNoticeTTL {
+		parts = append(parts, r.notice)
+	}
+
+	if filters := activeFiltersStatus(r.activeFilters()); filters != "" {
+		parts = append(parts, filters)
 	}
 
 	// Add selected overview item if sidebar is visible.
@@ -638,10 +737,16 @@ func (r *Run) buildHelpText() string {
 
 func (r *Run) IsFiltering() bool {
 	return r.metricsGrid.IsFilterMode() ||
+		r.metricsGrid.IsJumpMode() ||
 		r.leftSidebar.IsFilterMode() ||
 		r.rightSidebar.IsFilterMode()
 }
 
+// IsExportingConfig reports whether the config export submenu is open.
+func (r *Run) IsExportingConfig() bool {
+	return r.configExport.IsActive()
+}
+
 func (r *Run) MediaFullscreen() bool {
 	r.stateMu.RLock()
 	defer r.stateMu.RUnlock()
@@ -664,7 +769,7 @@ func (r *Run) updateBottomPaneHeights(mediaVisible, logsVisible bool) {
 	}
 	sepLines := max(sectionCount-1, 0)
 
-	maxH := max(r.height-StatusBarHeight-sepLines, 0)
+	maxH := max(r.height-StatusBarHeight-RunTitleBarHeight-sepLines, 0)
 	lowerCount := 0
 	if mediaVisible {
 		lowerCount++
@@ -688,7 +793,8 @@ func (r *Run) updateBottomPaneHeights(mediaVisible, logsVisible bool) {
 		r.mediaPane.SetExpandedHeight(each)
 	}
 	if logsVisible {
-		r.consoleLogsPane.SetExpandedHeight(each)
+		r.consoleLogsPane.SetExpandedHeight(
+			consoleLogsHeight(r.config.ConsoleLogsHeight(), each, maxH))
 	}
 }
 
@@ -696,6 +802,15 @@ func (r *Run) IsRemote() bool {
 	return r.runParams != nil && r.runParams.Remote != nil
 }
 
+// runDir returns the directory holding the local run's .wandb file,
+// or "" for remote runs.
+func (r *Run) runDir() string {
+	if r.runParams == nil || r.runParams.RunFile == "" {
+		return ""
+	}
+	return filepath.Dir(r.runParams.RunFile)
+}
+
 // Layout represents the computed layout dimensions for the main UI.
 type Layout struct {
 	leftSidebarWidth       int
@@ -741,7 +856,7 @@ func (r *Run) effectiveSidebarWidths() (leftW, rightW int) {
 func (r *Run) computeViewports() Layout {
 	leftW, rightW := r.effectiveSidebarWidths()
 	contentW := max(r.width-leftW-rightW, 1)
-	totalH := max(r.height-StatusBarHeight, 0)
+	totalH := max(r.height-StatusBarHeight-RunTitleBarHeight, 0)
 
 	stack := computeVerticalStackLayout(
 		totalH,
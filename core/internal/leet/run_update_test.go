@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"strings"
 	"sync"
 	"testing"
 	"time"
@@ -54,6 +55,27 @@ func TestProcessRecordMsg_Run_Summary_System_FileComplete(t *testing.T) {
 	require.Equal(t, leet.RunStateFinished, model.TestRunState())
 }
 
+func TestRunView_TitleBarShowsRunIdentity(t *testing.T) {
+	logger := observability.NewNoOpLogger()
+	cfg := leet.NewConfigManager(filepath.Join(t.TempDir(), "config.json"), logger)
+	require.NoError(t, cfg.SetLeftSidebarVisible(false))
+	require.NoError(t, cfg.SetRightSidebarVisible(false))
+	var m tea.Model = leet.NewRun(&leet.RunParams{RunFile: "dummy"}, cfg, logger)
+	m, _ = m.Update(tea.WindowSizeMsg{Width: 120, Height: 30})
+
+	model := m.(*leet.Run)
+	model.TestHandleRecordMsg(leet.RunMsg{
+		ID:          "run_123",
+		Entity:      "team",
+		Project:     "proj",
+		DisplayName: "cool-run",
+	})
+
+	lines := strings.Split(stripANSI(model.View().Content), "\n")
+	require.Len(t, lines, 30, "title bar must not change the frame height")
+	require.Contains(t, lines[0], "team / proj / run_123 • cool-run")
+}
+
 func TestProcessRecordMsg_ErrorStopsLoading(t *testing.T) {
 	logger := observability.NewNoOpLogger()
 	cfg := leet.NewConfigManager(filepath.Join(t.TempDir(), "config.json"), logger)
@@ -549,6 +571,27 @@ func TestModel_HandleMouseMsg(t *testing.T) {
 				require.NotEmpty(t, fs.Title)
 			},
 		},
+		{
+			name: "right_drag_in_right_sidebar_reports_point_and_Y_copies_it",
+			setup: func(m *leet.Run) {
+				var model tea.Model = m
+				model.Update(leet.RunMsg{ID: "run1"})
+			},
+			events: []tea.Msg{
+				tea.MouseClickMsg{X: 110, Y: 10, Button: tea.MouseRight},
+				tea.MouseReleaseMsg{X: 110, Y: 10, Button: tea.MouseRight},
+			},
+			verify: func(t *testing.T, m *leet.Run) {
+				require.Empty(t, m.TestClipboard())
+				require.Contains(t, stripANSI(m.View().Content), "(Y to copy)")
+
+				var model tea.Model = m
+				_, cmd := model.Update(tea.KeyPressMsg{Code: 'Y', Text: "Y"})
+				require.NotNil(t, cmd)
+				require.NotEmpty(t, m.TestClipboard())
+				require.Contains(t, stripANSI(m.View().Content), "to clipboard")
+			},
+		},
 		{
 			name: "wheel_events_focus_chart_and_zoom",
 			events: []tea.Msg{
@@ -1,8 +1,12 @@
 package leet
 
 import (
+	"fmt"
+	"maps"
+	"slices"
 	"strings"
 	"time"
+	"unicode/utf8"
 
 	"github.com/wandb/wandb/core/internal/terminalemulator"
 )
@@ -23,6 +27,10 @@ const (
 	// Adapted from the structured format used by the filestreamWriter
 	// (rfc3339Micro), but shortened for compact TUI display.
 	consoleTimestampFormat = "15:04:05"
+
+	// consoleSampleBucket is the time bucket over which the console log
+	// rate is measured when sampling.
+	consoleSampleBucket = time.Second
 )
 
 // ConsoleLogLine is an assembled, display-ready line of console output.
@@ -58,12 +66,44 @@ type RunConsoleLogs struct {
 	// It is updated incrementally so View does not need to reformat every line on
 	// every render.
 	items []KeyValuePair
+
+	// errorIdx lists the indices of error lines among the first
+	// errorScanned lines, which have scrolled out of the terminal window
+	// and no longer change.
+	errorIdx     []int
+	errorScanned int
+
+	// sampleThreshold is the number of lines per consoleSampleBucket kept
+	// for each stream before sampling starts; 0 disables sampling.
+	sampleThreshold int
+
+	// now returns the wall-clock time used to bucket line arrivals.
+	now func() time.Time
+
+	// fileLines are lines read from a separate output.log file.
+	fileLines []ConsoleLogLine
+
+	// merged is lines and fileLines merged by timestamp. Once built, it is
+	// updated in place as stream lines arrive or change, and rebuilt only
+	// when mergedStale is set.
+	merged      []KeyValuePair
+	mergedStale bool
+
+	// mergedIdx holds the index in merged of each of lines.
+	mergedIdx []int
+
+	// streamCounts and fileCounts count lines and fileLines by content,
+	// to tell when a stream line changes which file lines are duplicates.
+	streamCounts, fileCounts map[string]int
+
+	// fileMaxTime is the latest timestamp of the file lines in merged.
+	fileMaxTime time.Time
 }
 
 // NewRunConsoleLogs creates an empty console log store with terminal
 // emulators for stdout and stderr.
 func NewRunConsoleLogs() *RunConsoleLogs {
-	cl := &RunConsoleLogs{}
+	cl := &RunConsoleLogs{now: time.Now}
 
 	cl.stdoutTerm = terminalemulator.NewTerminal(
 		&consoleLineSupplier{owner: cl, isStderr: false},
@@ -93,10 +133,21 @@ func (cl *RunConsoleLogs) ProcessRaw(text string, isStderr bool, ts time.Time) {
 	}
 }
 
+// SetSampleThreshold sets the number of lines per second kept before
+// further lines in that second are coalesced into the newest one.
+//
+// A value of 0 disables sampling.
+func (cl *RunConsoleLogs) SetSampleThreshold(n int) {
+	cl.sampleThreshold = max(n, 0)
+}
+
 // Items returns the assembled lines in [KeyValuePair] form.
 //
 // Callers must treat the returned slice as read-only.
 func (cl *RunConsoleLogs) Items() []KeyValuePair {
+	if len(cl.fileLines) > 0 {
+		return cl.mergedItems()
+	}
 	if len(cl.items) == len(cl.lines) {
 		return cl.items
 	}
@@ -114,9 +165,216 @@ func (cl *RunConsoleLogs) Items() []KeyValuePair {
 	return cl.items
 }
 
+// AddFileLines merges lines read from an output.log file into the log.
+//
+// Lines are interleaved with in-stream output by timestamp. A file line
+// whose content matches an in-stream line is dropped, since runs that
+// stream output_raw records may also write it to output.log.
+func (cl *RunConsoleLogs) AddFileLines(lines []ConsoleLogLine) {
+	cl.fileLines = append(cl.fileLines, lines...)
+	cl.mergedStale = true
+}
+
+// mergedItems returns lines and fileLines merged by timestamp, keeping
+// each source's own order.
+func (cl *RunConsoleLogs) mergedItems() []KeyValuePair {
+	if cl.mergeActive() {
+		return cl.merged
+	}
+
+	cl.streamCounts = make(map[string]int, len(cl.lines))
+	for _, line := range cl.lines {
+		cl.streamCounts[line.Content]++
+	}
+	cl.fileCounts = make(map[string]int)
+	seen := maps.Clone(cl.streamCounts)
+	fileLines := make([]ConsoleLogLine, 0, len(cl.fileLines))
+	cl.fileMaxTime = time.Time{}
+	for _, line := range cl.fileLines {
+		cl.fileCounts[line.Content]++
+		if seen[line.Content] > 0 {
+			seen[line.Content]--
+			continue
+		}
+		fileLines = append(fileLines, line)
+		if line.Timestamp.After(cl.fileMaxTime) {
+			cl.fileMaxTime = line.Timestamp
+		}
+	}
+
+	merged := make([]KeyValuePair, 0, len(cl.lines)+len(fileLines))
+	mergedIdx := make([]int, 0, len(cl.lines))
+	addLine := func(line ConsoleLogLine) {
+		mergedIdx = append(mergedIdx, len(merged))
+		merged = append(merged, consoleLogItem(line))
+	}
+	i, j := 0, 0
+	for i < len(cl.lines) && j < len(fileLines) {
+		if fileLines[j].Timestamp.Before(cl.lines[i].Timestamp) {
+			merged = append(merged, consoleLogItem(fileLines[j]))
+			j++
+		} else {
+			addLine(cl.lines[i])
+			i++
+		}
+	}
+	for ; i < len(cl.lines); i++ {
+		addLine(cl.lines[i])
+	}
+	for ; j < len(fileLines); j++ {
+		merged = append(merged, consoleLogItem(fileLines[j]))
+	}
+
+	cl.merged, cl.mergedIdx, cl.mergedStale = merged, mergedIdx, false
+	return cl.merged
+}
+
+// mergeActive reports whether merged is built and kept up to date.
+func (cl *RunConsoleLogs) mergeActive() bool {
+	return cl.merged != nil && !cl.mergedStale
+}
+
+// mergeAppend adds the newly appended stream line idx to merged, or marks
+// merged stale if the line cannot simply go at its end.
+func (cl *RunConsoleLogs) mergeAppend(idx int) {
+	if !cl.mergeActive() {
+		return
+	}
+	line := cl.lines[idx]
+	if cl.countStreamContent(line.Content, 1) ||
+		!cl.fileMaxTime.Before(line.Timestamp) {
+		cl.mergedStale = true
+		return
+	}
+	cl.mergedIdx = append(cl.mergedIdx, len(cl.merged))
+	cl.merged = append(cl.merged, consoleLogItem(line))
+}
+
+// mergeUpdate refreshes stream line idx in merged after its content
+// changed from prev, or marks merged stale if the line has to move or
+// the change affects which file lines are duplicates.
+func (cl *RunConsoleLogs) mergeUpdate(idx int, prev ConsoleLogLine) {
+	if !cl.mergeActive() {
+		return
+	}
+	line := cl.lines[idx]
+	pos := cl.mergedIdx[idx]
+	moved := !line.Timestamp.Equal(prev.Timestamp) &&
+		(pos != len(cl.merged)-1 || !cl.fileMaxTime.Before(line.Timestamp))
+	if moved ||
+		line.Content != prev.Content &&
+			(cl.countStreamContent(prev.Content, -1) ||
+				cl.countStreamContent(line.Content, 1)) {
+		cl.mergedStale = true
+		return
+	}
+	cl.merged[pos] = consoleLogItem(line)
+}
+
+// countStreamContent adds delta to the count of stream lines holding
+// content and reports whether that changes how many file lines with the
+// same content are dropped as duplicates.
+func (cl *RunConsoleLogs) countStreamContent(content string, delta int) bool {
+	n := cl.streamCounts[content]
+	if n+delta > 0 {
+		cl.streamCounts[content] = n + delta
+	} else {
+		delete(cl.streamCounts, content)
+	}
+	return min(cl.fileCounts[content], n) != min(cl.fileCounts[content], n+delta)
+}
+
+// consoleLogItem returns line in the KeyValuePair shape of Items.
+func consoleLogItem(line ConsoleLogLine) KeyValuePair {
+	return KeyValuePair{
+		Key:   line.Timestamp.Format(consoleTimestampFormat),
+		Value: line.Content,
+	}
+}
+
+// ErrorLines returns up to limit of the most recent error lines, oldest
+// first.
+//
+// A line is an error if it matches errorLinePattern. Unlike the run list's
+// last error, stderr output alone does not count: progress bars write there.
+func (cl *RunConsoleLogs) ErrorLines(limit int) []ConsoleLogLine {
+	if limit <= 0 {
+		return nil
+	}
+
+	// Lines still inside the terminal window may be rewritten, so only
+	// the ones above it are scanned once and remembered.
+	settled := max(len(cl.lines)-maxConsoleTermLines, 0)
+	for ; cl.errorScanned < settled; cl.errorScanned++ {
+		if errorLinePattern.MatchString(cl.lines[cl.errorScanned].Content) {
+			cl.errorIdx = append(cl.errorIdx, cl.errorScanned)
+		}
+	}
+
+	var out []ConsoleLogLine
+	for i := len(cl.lines) - 1; i >= settled && len(out) < limit; i-- {
+		if errorLinePattern.MatchString(cl.lines[i].Content) {
+			out = append(out, cl.lines[i])
+		}
+	}
+	for i := len(cl.errorIdx) - 1; i >= 0 && len(out) < limit; i-- {
+		out = append(out, cl.lines[cl.errorIdx[i]])
+	}
+	slices.Reverse(out)
+	return out
+}
+
 // appendLine is called by the line supplier when a new terminal line is
 // created. Returns the index for future PutChar callbacks.
-func (cl *RunConsoleLogs) appendLine(isStderr bool) int {
+//
+// Once more than sampleThreshold lines of one stream arrive within a
+// bucket, a notice line is added and every further line of that stream in
+// the bucket takes over a single slot after it, so only the newest is kept.
+func (cl *RunConsoleLogs) appendLine(sp *consoleSampler, line *consoleLine) int {
+	if cl.sampleThreshold <= 0 {
+		return cl.newLine(line.isStderr)
+	}
+
+	bucket := cl.now().Truncate(consoleSampleBucket)
+	if !bucket.Equal(sp.bucket) {
+		*sp = consoleSampler{bucket: bucket}
+	}
+	sp.lines++
+	if sp.lines <= cl.sampleThreshold {
+		return cl.newLine(line.isStderr)
+	}
+
+	var idx int
+	if sp.slot == nil {
+		sp.noticeIdx = cl.newLine(line.isStderr)
+		idx = cl.newLine(line.isStderr)
+	} else {
+		// Detach the previous owner so late writes to it are dropped.
+		idx = sp.slot.index
+		sp.slot.index = -1
+		cl.resetLine(idx, line.isStderr)
+	}
+	sp.slot = line
+
+	cl.onLineChanged(sp.noticeIdx, []rune(fmt.Sprintf(
+		"… sampling: %d lines over %d/s, showing newest",
+		sp.lines-cl.sampleThreshold, cl.sampleThreshold)))
+	return idx
+}
+
+// resetLine clears an existing line so it can hold new output.
+func (cl *RunConsoleLogs) resetLine(idx int, isStderr bool) {
+	if idx < cl.errorScanned {
+		cl.errorIdx, cl.errorScanned = nil, 0
+	}
+	prev := cl.lines[idx]
+	cl.lines[idx] = ConsoleLogLine{Timestamp: cl.currentTimestamp, IsStderr: isStderr}
+	cl.mergeUpdate(idx, prev)
+	cl.items[idx] = KeyValuePair{Key: cl.currentTimestamp.Format(consoleTimestampFormat)}
+}
+
+// newLine appends an empty line and returns its index.
+func (cl *RunConsoleLogs) newLine(isStderr bool) int {
 	idx := len(cl.lines)
 	cl.lines = append(cl.lines, ConsoleLogLine{
 		Timestamp: cl.currentTimestamp,
@@ -125,6 +383,7 @@ func (cl *RunConsoleLogs) appendLine(isStderr bool) int {
 	cl.items = append(cl.items, KeyValuePair{
 		Key: cl.currentTimestamp.Format(consoleTimestampFormat),
 	})
+	cl.mergeAppend(idx)
 	return idx
 }
 
@@ -134,29 +393,112 @@ func (cl *RunConsoleLogs) onLineChanged(idx int, content []rune) {
 	if idx < 0 || idx >= len(cl.lines) {
 		return
 	}
-	value := strings.TrimRight(string(content), " \t")
+	value := sanitizeConsoleLine(strings.TrimRight(string(content), " \t"))
+	prev := cl.lines[idx]
 	cl.lines[idx].Content = value
+	cl.mergeUpdate(idx, prev)
 	if idx < len(cl.items) {
 		cl.items[idx].Value = value
 	}
 }
 
+// sanitizeConsoleLine neutralizes terminal control sequences in assembled
+// console output, so that rendering a line cannot move the cursor, retitle
+// the terminal or ring the bell.
+//
+// The terminal emulator only interprets cursor up/down and passes any other
+// escape sequence through as text. Those are dropped here, tabs become
+// spaces, and other control characters are replaced with U+FFFD.
+func sanitizeConsoleLine(s string) string {
+	if !strings.ContainsFunc(s, isConsoleControl) {
+		return s
+	}
+
+	rs := []rune(s)
+	var b strings.Builder
+	b.Grow(len(s))
+	for i := 0; i < len(rs); i++ {
+		switch r := rs[i]; {
+		case r == '\x1b':
+			i = escapeSequenceEnd(rs, i)
+		case r == '\t':
+			b.WriteByte(' ')
+		case isConsoleControl(r):
+			b.WriteRune(utf8.RuneError)
+		default:
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
+
+// escapeSequenceEnd returns the index of the last rune of the escape
+// sequence that starts at rs[i], clamped to the end of rs.
+func escapeSequenceEnd(rs []rune, i int) int {
+	if i+1 >= len(rs) {
+		return i
+	}
+	switch rs[i+1] {
+	case '[':
+		// CSI: parameter and intermediate bytes up to a final byte.
+		for j := i + 2; j < len(rs); j++ {
+			if rs[j] >= 0x40 && rs[j] <= 0x7e {
+				return j
+			}
+		}
+	case ']', 'P', 'X', '^', '_':
+		// OSC, DCS, SOS, PM and APC: a string up to BEL or ST (ESC \).
+		for j := i + 2; j < len(rs); j++ {
+			if rs[j] == '\a' {
+				return j
+			}
+			if rs[j] == '\x1b' && j+1 < len(rs) && rs[j+1] == '\\' {
+				return j + 1
+			}
+		}
+	default:
+		return i + 1
+	}
+	return len(rs) - 1
+}
+
+// isConsoleControl reports whether r is a C0 or C1 control character.
+func isConsoleControl(r rune) bool {
+	return r < 0x20 || r == 0x7f || (r >= 0x80 && r < 0xa0)
+}
+
 // ---- Terminal emulator integration ----
 
+// consoleSampler is the per-stream sampling state for the current bucket.
+type consoleSampler struct {
+	bucket time.Time
+
+	// lines is the number of lines the stream created in the bucket.
+	lines int
+
+	// noticeIdx is the index of the sampling notice line.
+	noticeIdx int
+
+	// slot is the line holding the newest sampled output, or nil until
+	// sampling starts.
+	slot *consoleLine
+}
+
 // consoleLineSupplier implements [terminalemulator.LineSupplier].
 type consoleLineSupplier struct {
 	owner    *RunConsoleLogs
 	isStderr bool
+	sampler  consoleSampler
 }
 
 func (s *consoleLineSupplier) NextLine() terminalemulator.Line {
-	idx := s.owner.appendLine(s.isStderr)
-	return &consoleLine{
+	line := &consoleLine{
 		content:  terminalemulator.LineContent{MaxLength: maxConsoleLineLength},
 		owner:    s.owner,
-		index:    idx,
 		isStderr: s.isStderr,
 	}
+	line.index = s.owner.appendLine(&s.sampler, line)
+	return line
 }
 
 // consoleLine implements [terminalemulator.Line] for a single assembled line.
@@ -1,6 +1,7 @@
 package leet_test
 
 import (
+	"fmt"
 	"strings"
 	"testing"
 	"time"
@@ -51,3 +52,157 @@ func TestRunConsoleLogs_AssemblesAcrossCallsAndPreservesTimestamps(t *testing.T)
 
 	require.Less(t, i1, i2, "expected log lines to preserve arrival order")
 }
+
+func TestRunConsoleLogs_SamplesBurstAboveThreshold(t *testing.T) {
+	cl := leet.NewRunConsoleLogs()
+	cl.SetSampleThreshold(10)
+
+	now := time.Date(2026, time.February, 18, 10, 11, 12, 0, time.UTC)
+	cl.TestSetClock(func() time.Time { return now })
+
+	var b strings.Builder
+	for i := range 1000 {
+		fmt.Fprintf(&b, "line %d\n", i)
+	}
+	cl.ProcessRaw(b.String(), false, now)
+	cl.ProcessRaw("err line\n", true, now)
+
+	items := cl.Items()
+	require.LessOrEqual(t, len(items), 14,
+		"expected threshold lines plus a notice and a sampled slot")
+
+	_, noticeIdx, ok := findKV(items, "sampling")
+	require.True(t, ok, "expected a sampling notice")
+	_, firstIdx, ok := findKV(items, "line 0")
+	require.True(t, ok, "expected lines under the threshold to be kept")
+	require.Less(t, firstIdx, noticeIdx)
+	_, _, ok = findKV(items, "line 999")
+	require.True(t, ok, "expected the newest line to be kept")
+	_, _, ok = findKV(items, "err line")
+	require.True(t, ok, "expected stderr to be sampled separately from stdout")
+
+	// A new bucket resumes normal assembly.
+	now = now.Add(time.Second)
+	cl.ProcessRaw("after\n", false, now)
+	_, _, ok = findKV(cl.Items(), "after")
+	require.True(t, ok, "expected logging to resume in the next bucket")
+}
+
+func TestRunConsoleLogs_ErrorLines_ReturnsNewestErrorsInOrder(t *testing.T) {
+	cl := leet.NewRunConsoleLogs()
+	ts := time.Date(2026, time.February, 18, 10, 11, 12, 0, time.UTC)
+
+	cl.ProcessRaw("ValueError: first\n", false, ts)
+	var b strings.Builder
+	for i := range 100 {
+		fmt.Fprintf(&b, "step %d\n", i)
+	}
+	cl.ProcessRaw(b.String(), false, ts)
+	cl.ProcessRaw("progress 50%\n", true, ts)
+	cl.ProcessRaw("RuntimeError: second\n", true, ts)
+	cl.ProcessRaw("KeyError: third\n", false, ts)
+
+	contents := func(lines []leet.ConsoleLogLine) []string {
+		out := make([]string, len(lines))
+		for i, line := range lines {
+			out[i] = line.Content
+		}
+		return out
+	}
+	require.Equal(t,
+		[]string{"ValueError: first", "RuntimeError: second", "KeyError: third"},
+		contents(cl.ErrorLines(10)))
+	require.Equal(t,
+		[]string{"RuntimeError: second", "KeyError: third"},
+		contents(cl.ErrorLines(2)))
+}
+
+func TestRunConsoleLogs_NeutralizesControlSequences(t *testing.T) {
+	cl := leet.NewRunConsoleLogs()
+	cl.ProcessRaw(
+		"ok \x1b[31mred\x1b[0m \x1b]0;pwned\x07bell\x07 tab\there\x00end\x1b[2J\n",
+		false, time.Now())
+
+	items := cl.Items()
+	require.Len(t, items, 1)
+	require.Equal(t, "ok red bell� tab here�end", items[0].Value)
+
+	clp := leet.NewConsoleLogsPane(leet.NewAnimatedValue(false, leet.ConsoleLogsPaneMinHeight))
+	expandConsoleLogsPane(t, clp, 4)
+	clp.SetConsoleLogs(items)
+	out := clp.View(80, "", "")
+	for _, bad := range []string{"\x07", "\x00", "\t", "pwned", "\x1b[2J", "\x1b]"} {
+		require.NotContains(t, out, bad)
+	}
+	require.Contains(t, stripANSI(out), "ok red bell� tab here�end")
+}
+
+func TestRunConsoleLogs_AddFileLines_MergesByTimestampAndDropsDuplicates(t *testing.T) {
+	cl := leet.NewRunConsoleLogs()
+	ts := time.Date(2026, time.February, 18, 10, 0, 0, 0, time.UTC)
+
+	cl.ProcessRaw("stream a\n", false, ts.Add(time.Second))
+	cl.ProcessRaw("stream b\n", false, ts.Add(3*time.Second))
+	cl.AddFileLines([]leet.ConsoleLogLine{
+		{Timestamp: ts, Content: "file first"},
+		{Timestamp: ts.Add(time.Second), Content: "stream a"},
+		{Timestamp: ts.Add(2 * time.Second), Content: "file middle"},
+	})
+
+	var values []string
+	for _, kv := range cl.Items() {
+		if kv.Value != "" {
+			values = append(values, kv.Value)
+		}
+	}
+	require.Equal(t, []string{"file first", "stream a", "file middle", "stream b"}, values)
+
+	// Later stream output is still merged in.
+	cl.ProcessRaw("stream c\n", false, ts.Add(4*time.Second))
+	_, idx, ok := findKV(cl.Items(), "stream c")
+	require.True(t, ok)
+	_, prev, _ := findKV(cl.Items(), "stream b")
+	require.Greater(t, idx, prev)
+}
+
+func TestRunConsoleLogs_AddFileLines_LiveMergeMatchesRebuild(t *testing.T) {
+	ts := time.Date(2026, time.February, 18, 10, 0, 0, 0, time.UTC)
+	clock := func() time.Time { return ts }
+	live, rebuilt := leet.NewRunConsoleLogs(), leet.NewRunConsoleLogs()
+	for _, cl := range []*leet.RunConsoleLogs{live, rebuilt} {
+		cl.TestSetClock(clock)
+		cl.SetSampleThreshold(4)
+		cl.AddFileLines([]leet.ConsoleLogLine{
+			{Timestamp: ts, Content: "file first"},
+			{Timestamp: ts.Add(2 * time.Second), Content: "epoch 1"},
+		})
+	}
+
+	// live is read after every write, so it merges incrementally;
+	// rebuilt is merged once at the end.
+	writes := []struct {
+		text   string
+		stderr bool
+		at     time.Duration
+	}{
+		{"stream a\n", false, time.Second},
+		{"progress 10%\rprogress 50%", true, time.Second},
+		{"\rprogress 100%\n", true, 2 * time.Second},
+		{"epoch 1\n", false, 3 * time.Second}, // duplicates a file line
+		{"a\nb\nc\nd\ne\nf\n", false, 4 * time.Second},
+		{"epoch 1\n", false, 5 * time.Second},
+	}
+	for _, w := range writes {
+		live.ProcessRaw(w.text, w.stderr, ts.Add(w.at))
+		_ = live.Items()
+		rebuilt.ProcessRaw(w.text, w.stderr, ts.Add(w.at))
+	}
+
+	require.Equal(t, rebuilt.Items(), live.Items())
+	var values []string
+	for _, kv := range live.Items() {
+		values = append(values, kv.Value)
+	}
+	require.Equal(t, []string{"file first", "stream a", "progress 100%"}, values[:3])
+	require.Equal(t, 2, strings.Count(strings.Join(values, "\n"), "epoch 1"))
+}
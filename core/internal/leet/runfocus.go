@@ -175,3 +175,20 @@ func (r *Run) cycleRunOverviewSection(direction int) bool {
 	r.leftSidebar.navigateSection(direction)
 	return true
 }
+
+// jumpToOverviewQuickView moves focus to the next overview quick view
+// (Summary, Config), focusing the overview sidebar if needed.
+func (r *Run) jumpToOverviewQuickView() {
+	if !r.overviewFocusAvailable() {
+		return
+	}
+	focused := r.focusMgr.IsTarget(FocusTargetOverview)
+	idx := r.leftSidebar.nextQuickViewSection(focused)
+	if idx == -1 {
+		return
+	}
+	if !focused {
+		r.focusMgr.SetTarget(FocusTargetOverview, 1)
+	}
+	r.leftSidebar.setActiveSection(idx)
+}
@@ -67,6 +67,7 @@ func (r *Run) handleRecordMsg(msg tea.Msg) tea.Cmd {
 		}
 		r.syncLiveRunning()
 		r.runOverview.SetRunState(r.runState)
+		r.runOverview.SetExitCode(msg.ExitCode)
 		r.leftSidebar.Sync()
 
 		r.logger.Debug("model: stopping heartbeats and finishing watcher")
@@ -95,10 +96,17 @@ func (r *Run) handleRecordMsg(msg tea.Msg) tea.Cmd {
 func (r *Run) handleHistoryMsg(msg HistoryMsg) {
 	defer timeit(r.logger, "Model.handleHistoryMsg")()
 
+	if msg.RunPath != "" {
+		r.runPath = msg.RunPath
+	}
 	shouldDraw := r.metricsGrid.ProcessHistory(msg)
 	if r.mediaStore.ProcessHistory(msg) {
 		r.mediaPane.SetStore(r.mediaStore)
 	}
+	if len(msg.Histograms) > 0 {
+		r.runOverview.ProcessHistograms(msg.Histograms)
+		r.leftSidebar.Sync()
+	}
 	if shouldDraw && !r.suppressDraw {
 		r.metricsGrid.drawVisible()
 	}
@@ -108,6 +116,12 @@ func (r *Run) handleHistoryMsg(msg HistoryMsg) {
 func (r *Run) handleMouseMsg(msg tea.MouseMsg) tea.Cmd {
 	defer timeit(r.logger, "Model.handleMouseMsg")()
 
+	// Everything below is laid out under the title bar.
+	if msg.Mouse().Y < RunTitleBarHeight {
+		return nil
+	}
+	msg = offsetMouseY(msg, RunTitleBarHeight)
+
 	layout := r.computeViewports()
 
 	if r.isInLeftSidebar(msg, layout) {
@@ -121,6 +135,26 @@ func (r *Run) handleMouseMsg(msg tea.MouseMsg) tea.Cmd {
 	return r.handleMainContentMouse(msg, layout)
 }
 
+// offsetMouseY returns msg with its Y coordinate moved up by dy rows.
+func offsetMouseY(msg tea.MouseMsg, dy int) tea.MouseMsg {
+	switch m := msg.(type) {
+	case tea.MouseClickMsg:
+		m.Y -= dy
+		return m
+	case tea.MouseReleaseMsg:
+		m.Y -= dy
+		return m
+	case tea.MouseMotionMsg:
+		m.Y -= dy
+		return m
+	case tea.MouseWheelMsg:
+		m.Y -= dy
+		return m
+	default:
+		return msg
+	}
+}
+
 // isInLeftSidebar checks if mouse position is in the left sidebar region.
 func (r *Run) isInLeftSidebar(msg tea.MouseMsg, layout Layout) bool {
 	mouse := msg.Mouse()
@@ -196,7 +230,11 @@ func (r *Run) handleRightSidebarMouse(msg tea.MouseMsg, layout Layout) tea.Cmd {
 		}
 	case tea.MouseReleaseMsg:
 		if m.Button == tea.MouseRight {
+			p, ok := r.rightSidebar.metricsGrid.InspectedPoint()
 			r.rightSidebar.EndInspection()
+			if ok {
+				r.setNotice("%s: %s (Y to copy)", p.Title, p)
+			}
 		}
 	case tea.MouseWheelMsg:
 		r.metricsGrid.clearFocus()
@@ -287,7 +325,8 @@ func (r *Run) handleMainContentMouse(msg tea.MouseMsg, layout Layout) tea.Cmd {
 
 // handleKeyPressMsg processes keyboard events using the centralized key bindings.
 func (r *Run) handleKeyPressMsg(msg tea.KeyPressMsg) tea.Cmd {
-	// Filter modes take priority.
+	// Filter modes take priority, even over grid config capture: digits
+	// typed into a filter are query text, never a grid size.
 	if r.leftSidebar.IsFilterMode() {
 		r.leftSidebar.HandleFilterKey(msg)
 		return nil
@@ -296,6 +335,12 @@ func (r *Run) handleKeyPressMsg(msg tea.KeyPressMsg) tea.Cmd {
 		r.metricsGrid.handleFilterKey(msg)
 		return nil
 	}
+	if r.metricsGrid.IsJumpMode() {
+		if err := r.metricsGrid.handleJumpKey(msg); err != nil {
+			r.setNotice("Jump failed: %v", err)
+		}
+		return nil
+	}
 	if r.rightSidebar.IsFilterMode() {
 		r.rightSidebar.HandleFilterKey(msg)
 		return nil
@@ -306,9 +351,18 @@ func (r *Run) handleKeyPressMsg(msg tea.KeyPressMsg) tea.Cmd {
 		return r.handleConfigNumberKey(msg)
 	}
 
+	if r.configExport.IsActive() {
+		return r.configExport.HandleKey(
+			msg, r.runOverview, r.runOverview.ID(), r.runDir())
+	}
+	r.configExport.result = ""
+
 	// Focus-aware key dispatch: route to the currently focused component.
 	switch r.focusMgr.Current() {
 	case FocusTargetMetricsGrid, FocusTargetSystemMetrics:
+		if r.focusMgr.Current() == FocusTargetMetricsGrid && r.metricsGrid.handleScrubKey(msg) {
+			return nil
+		}
 		if cmd := r.handleGridNav(msg); cmd != nil {
 			return cmd
 		}
@@ -316,6 +370,18 @@ func (r *Run) handleKeyPressMsg(msg tea.KeyPressMsg) tea.Cmd {
 		if handled, cmd := r.mediaPane.HandleKey(msg); handled {
 			return cmd
 		}
+	case FocusTargetConsoleLogs:
+		if delta, ok := consoleLogsResizeDelta(msg); ok {
+			r.resizeConsoleLogsPane(delta)
+			return nil
+		}
+	case FocusTargetOverview:
+		if isSectionToggleKey(msg) && r.leftSidebar.IsVisible() {
+			if err := r.leftSidebar.toggleActiveSectionCollapsed(); err != nil {
+				r.logger.Error(fmt.Sprintf("run: failed to save overview section state: %v", err))
+			}
+			return nil
+		}
 	}
 
 	// Dispatch to key map.
@@ -498,6 +564,48 @@ func (r *Run) handleCycleFocusedChartMode(tea.KeyPressMsg) tea.Cmd {
 	return nil
 }
 
+func (r *Run) handleToggleSystemMetricsAggregation(tea.KeyPressMsg) tea.Cmd {
+	if r.rightSidebar != nil && r.rightSidebar.metricsGrid != nil {
+		r.rightSidebar.metricsGrid.ToggleAggregation()
+	}
+	return nil
+}
+
+func (r *Run) handleToggleRawXTicks(msg tea.KeyPressMsg) tea.Cmd {
+	r.metricsGrid.toggleRawXTicks()
+	return nil
+}
+
+func (r *Run) handleCycleChartObjective(msg tea.KeyPressMsg) tea.Cmd {
+	r.metricsGrid.cycleFocusedChartObjective()
+	return nil
+}
+
+func (r *Run) handleToggleChartInvertY(msg tea.KeyPressMsg) tea.Cmd {
+	r.metricsGrid.toggleFocusedChartInvertY()
+	return nil
+}
+
+func (r *Run) handleCycleChartSecondary(msg tea.KeyPressMsg) tea.Cmd {
+	r.metricsGrid.cycleFocusedChartSecondary()
+	return nil
+}
+
+func (r *Run) handleToggleChartHero(msg tea.KeyPressMsg) tea.Cmd {
+	r.metricsGrid.toggleFocusedChartHero()
+	return nil
+}
+
+func (r *Run) handleToggleMinMaxBand(msg tea.KeyPressMsg) tea.Cmd {
+	r.metricsGrid.toggleMinMaxBand()
+	return nil
+}
+
+func (r *Run) handleToggleMetricsSortOrder(msg tea.KeyPressMsg) tea.Cmd {
+	r.metricsGrid.toggleSortOrder()
+	return nil
+}
+
 func (r *Run) handleEnterMetricsFilter(msg tea.KeyPressMsg) tea.Cmd {
 	r.metricsGrid.EnterFilterMode()
 	return nil
@@ -513,11 +621,64 @@ func (r *Run) handleClearMetricsFilter(msg tea.KeyPressMsg) tea.Cmd {
 	return nil
 }
 
+func (r *Run) handleSaveMetricsFilterPreset(msg tea.KeyPressMsg) tea.Cmd {
+	pos, total, ok := r.metricsGrid.saveFilterPreset()
+	if !ok {
+		r.setNotice("Apply a metrics filter to save it as a preset")
+		return nil
+	}
+	r.setNotice("Saved filter preset %d of %d", pos, total)
+	return nil
+}
+
+func (r *Run) handleCycleMetricsFilterPreset(msg tea.KeyPressMsg) tea.Cmd {
+	query, pos, total, ok := r.metricsGrid.cycleFilterPreset()
+	if !ok {
+		r.setNotice("No filter presets; press W to save the applied filter")
+		return nil
+	}
+	if r.focusMgr.Current() == FocusTargetMetricsGrid {
+		r.metricsGrid.NavigateFocus(0, 0)
+	}
+	r.setNotice("Filter preset %d of %d: %s", pos, total, query)
+	return nil
+}
+
 func (r *Run) handleEnterOverviewFilter(msg tea.KeyPressMsg) tea.Cmd {
 	r.leftSidebar.EnterFilterMode()
 	return nil
 }
 
+func (r *Run) handleJumpOverviewQuickView(msg tea.KeyPressMsg) tea.Cmd {
+	r.jumpToOverviewQuickView()
+	return nil
+}
+
+func (r *Run) handleOpenConfigExport(msg tea.KeyPressMsg) tea.Cmd {
+	r.configExport.Open()
+	return nil
+}
+
+func (r *Run) handleExportOverview(msg tea.KeyPressMsg) tea.Cmd {
+	r.exportOverview()
+	return nil
+}
+
+func (r *Run) handleToggleScrub(msg tea.KeyPressMsg) tea.Cmd {
+	r.metricsGrid.ToggleScrub()
+	return nil
+}
+
+func (r *Run) handleEnterJumpToStep(msg tea.KeyPressMsg) tea.Cmd {
+	r.metricsGrid.EnterJumpMode()
+	return nil
+}
+
+func (r *Run) handleExportMetrics(msg tea.KeyPressMsg) tea.Cmd {
+	r.exportMetrics()
+	return nil
+}
+
 func (r *Run) handleClearOverviewFilter(msg tea.KeyPressMsg) tea.Cmd {
 	if r.leftSidebar.IsFiltering() {
 		r.leftSidebar.ClearFilter()
@@ -558,10 +719,30 @@ func (r *Run) handleMetricsGridAnimation() []tea.Cmd {
 	return nil
 }
 
+// resumeAnimationsCmd restarts the animation loops of panes that were left
+// in flight when animations were frozen.
+func (r *Run) resumeAnimationsCmd() tea.Cmd {
+	var cmds []tea.Cmd
+	if r.leftSidebar.IsAnimating() {
+		cmds = append(cmds, r.leftSidebar.animationCmd())
+	}
+	if r.rightSidebar.IsAnimating() {
+		cmds = append(cmds, r.rightSidebar.animationCmd())
+	}
+	if r.mediaPane.IsAnimating() {
+		cmds = append(cmds, r.mediaPaneAnimationCmd())
+	}
+	if r.consoleLogsPane.IsAnimating() {
+		cmds = append(cmds, r.consoleLogsPaneAnimationCmd())
+	}
+	if r.metricsGridAnimState.IsAnimating() {
+		cmds = append(cmds, r.metricsGridAnimationCmd())
+	}
+	return tea.Batch(cmds...)
+}
+
 func (r *Run) metricsGridAnimationCmd() tea.Cmd {
-	return tea.Tick(AnimationFrame, func(time.Time) tea.Msg {
-		return MetricsGridAnimationMsg{}
-	})
+	return animationFrameCmd(MetricsGridAnimationMsg{})
 }
 
 func (r *Run) handleGridNav(msg tea.KeyPressMsg) tea.Cmd {
@@ -749,9 +930,40 @@ func (r *Run) handleMediaPaneAnimation() []tea.Cmd {
 }
 
 func (r *Run) mediaPaneAnimationCmd() tea.Cmd {
-	return tea.Tick(AnimationFrame, func(time.Time) tea.Msg {
-		return MediaPaneAnimationMsg{}
-	})
+	return animationFrameCmd(MediaPaneAnimationMsg{})
+}
+
+// resizeConsoleLogsPane grows or shrinks the expanded console logs pane
+// by delta rows and persists its new height.
+func (r *Run) resizeConsoleLogsPane(delta int) {
+	h := max(r.consoleLogsPane.ExpandedHeight()+delta, ConsoleLogsPaneMinHeight)
+	if err := r.config.SetConsoleLogsHeight(h); err != nil {
+		r.logger.Error(fmt.Sprintf("runhandlers: failed to save console logs height: %v", err))
+	}
+	r.updateBottomPaneHeights(
+		r.mediaPane.animState.TargetVisible(), r.consoleLogsPane.animState.TargetVisible())
+
+	// Persist the height actually applied, so growing past the limit
+	// doesn't take extra presses to undo.
+	if applied := r.consoleLogsPane.ExpandedHeight(); applied != h {
+		if err := r.config.SetConsoleLogsHeight(applied); err != nil {
+			r.logger.Error(fmt.Sprintf("runhandlers: failed to save console logs height: %v", err))
+		}
+	}
+
+	layout := r.computeViewports()
+	r.metricsGrid.UpdateDimensions(layout.mainContentAreaWidth, layout.height)
+}
+
+// handleToggleConsoleLogTimestamps shows or hides the console logs
+// timestamp column and persists the choice.
+func (r *Run) handleToggleConsoleLogTimestamps(msg tea.KeyPressMsg) tea.Cmd {
+	show := r.consoleLogsPane.TimestampsHidden()
+	r.consoleLogsPane.SetTimestampsHidden(!show)
+	if err := r.config.SetConsoleLogTimestamps(show); err != nil {
+		r.logger.Error(fmt.Sprintf("runhandlers: failed to save console log timestamps: %v", err))
+	}
+	return nil
 }
 
 // handleToggleConsoleLogsPane toggles the console logs bottom bar and resolves
@@ -793,9 +1005,7 @@ func (r *Run) handleConsoleLogsPaneAnimation() []tea.Cmd {
 }
 
 func (r *Run) consoleLogsPaneAnimationCmd() tea.Cmd {
-	return tea.Tick(AnimationFrame, func(time.Time) tea.Msg {
-		return ConsoleLogsPaneAnimationMsg{}
-	})
+	return animationFrameCmd(ConsoleLogsPaneAnimationMsg{})
 }
 
 func (r *Run) readChunkCmd(
@@ -870,9 +1080,15 @@ func (r *Run) handleInit(msg InitMsg) []tea.Cmd {
 	r.historySource = msg.Source
 	r.loadStartTime = time.Now()
 
-	return []tea.Cmd{
+	cmds := []tea.Cmd{
 		r.readChunkCmd(r.historySource, BootLoadChunkSize, BootLoadMaxTime),
 	}
+	if !r.IsRemote() {
+		if cmd := readOutputLogCmd(r.runParams.RunFile, r.logger); cmd != nil {
+			cmds = append(cmds, cmd)
+		}
+	}
+	return cmds
 }
 
 // handleChunkedBatch handles boot-load chunked batches.
@@ -1028,3 +1244,95 @@ func (r *Run) handleSidebarPageNav(msg tea.KeyPressMsg) tea.Cmd {
 	}
 	return nil
 }
+
+// handleToggleReplay starts replaying a finished run from its first step,
+// or stops an active replay and restores the full history.
+func (r *Run) handleToggleReplay(msg tea.KeyPressMsg) tea.Cmd {
+	if r.replay != nil {
+		for _, rec := range r.replay.Remaining() {
+			r.metricsGrid.ProcessHistory(rec)
+		}
+		r.replay = nil
+		r.metricsGrid.drawVisible()
+		return nil
+	}
+
+	if r.isLoading || r.runState == RunStateRunning || r.runState == RunStateUnknown {
+		return nil
+	}
+	tracks := r.metricsGrid.replayTracks()
+	if len(tracks) == 0 {
+		return nil
+	}
+
+	r.metricsGrid.reset()
+	r.replay = newReplay(tracks)
+	return r.replay.nextTick()
+}
+
+func (r *Run) handleReplayPlayPause(msg tea.KeyPressMsg) tea.Cmd {
+	if r.replay == nil {
+		return nil
+	}
+	r.replay.TogglePause()
+	return r.replay.nextTick()
+}
+
+func (r *Run) handleReplayFaster(msg tea.KeyPressMsg) tea.Cmd {
+	if r.replay != nil {
+		r.replay.Faster()
+	}
+	return nil
+}
+
+func (r *Run) handleReplaySlower(msg tea.KeyPressMsg) tea.Cmd {
+	if r.replay != nil {
+		r.replay.Slower()
+	}
+	return nil
+}
+
+// handleReplayTick feeds the records that became due and re-arms the tick.
+//
+// The replay ends by itself once the last record has been fed.
+func (r *Run) handleReplayTick(msg ReplayTickMsg) []tea.Cmd {
+	if r.replay == nil {
+		return nil
+	}
+	r.replay.ticking = false
+
+	due := r.replay.Advance(msg.Time)
+	for _, rec := range due {
+		r.metricsGrid.ProcessHistory(rec)
+	}
+	if len(due) > 0 {
+		r.metricsGrid.drawVisible()
+	}
+
+	if r.replay.Done() {
+		r.replay = nil
+		return nil
+	}
+	if cmd := r.replay.nextTick(); cmd != nil {
+		return []tea.Cmd{cmd}
+	}
+	return nil
+}
+
+// handleCopyInspectedPoint copies the system metrics point last read off
+// with right-click inspection.
+func (r *Run) handleCopyInspectedPoint(msg tea.KeyPressMsg) tea.Cmd {
+	p, ok := r.rightSidebar.metricsGrid.LastInspectedPoint()
+	if !ok {
+		return nil
+	}
+	r.setNotice("Copied %s (%s) to clipboard", p.Title, p)
+	r.clipboard = p.String()
+	return tea.SetClipboard(r.clipboard)
+}
+
+// setNotice shows a one-off status bar message for runNoticeTTL.
+func (r *Run) setNotice(format string, args ...any) {
+	r.notice = fmt.Sprintf(format, args...)
+	r.noticeAt = time.Now()
+}
@@ -2,7 +2,9 @@ package leet_test
 
 import (
 	"path/filepath"
+	"strings"
 	"testing"
+	"time"
 
 	tea "charm.land/bubbletea/v2"
 
@@ -243,9 +245,162 @@ func TestRun_UnifiedNav_GridUsesCanonicalDirectionalKeys(t *testing.T) {
 		"the secondary down binding should advance chart focus vertically")
 }
 
+func TestRun_OverviewQuickView_JumpsBetweenSummaryAndConfig(t *testing.T) {
+	r := newRunForHandlerTest(t)
+	sidebar := r.TestGetLeftSidebar()
+
+	// Start away from the overview; the first jump lands on Summary.
+	r.TestSetFocusTarget(int(leet.FocusTargetNone))
+	r.Update(keyRune('i'))
+	require.Equal(t, 2, r.TestLeftSidebarActiveSectionIdx())
+	require.Equal(t, "loss", mustSelectedItem(t, sidebar))
+	require.Contains(t, stripANSI(sidebar.View(50).Content), "Summary [1 items]")
+
+	// Then alternate with Config.
+	r.Update(keyRune('i'))
+	require.Equal(t, 1, r.TestLeftSidebarActiveSectionIdx())
+	require.Contains(t, []string{"lr", "epochs"}, mustSelectedItem(t, sidebar))
+
+	r.Update(keyRune('i'))
+	require.Equal(t, 2, r.TestLeftSidebarActiveSectionIdx())
+}
+
 func mustSelectedItem(t *testing.T, sidebar *leet.RunOverviewSidebar) string {
 	t.Helper()
 	key, _ := sidebar.SelectedItem()
 	require.NotEmpty(t, key)
 	return key
 }
+
+func TestRun_Replay_FeedsFinishedRunProgressively(t *testing.T) {
+	r := newRunForHandlerTest(t)
+	for step := 1; step <= 60; step++ {
+		r.TestHandleRecordMsg(leet.HistoryMsg{
+			RunPath: "testdata/fake.wandb",
+			Metrics: map[string]leet.MetricData{
+				"loss": {X: []float64{float64(step)}, Y: []float64{1 / float64(step)}},
+			},
+		})
+	}
+
+	// Replay is only available once the run has finished.
+	r.Update(keyPressMsg('v'))
+	require.NotContains(t, stripANSI(r.View().Content), "Replay")
+
+	r.TestHandleRecordMsg(leet.FileCompleteMsg{ExitCode: 0})
+	r.Update(keyPressMsg('v'))
+	require.Contains(t, stripANSI(r.View().Content), "Replay ▶ 1x [0/60]")
+
+	maxX := func() float64 {
+		ch := r.TestMetricsGrid().TestChartAt(0, 0)
+		if ch == nil {
+			return 0
+		}
+		_, xMax, _, _ := ch.TestBounds()
+		return xMax
+	}
+
+	t0 := time.Now()
+	r.Update(leet.ReplayTickMsg{Time: t0})
+	require.Zero(t, maxX())
+
+	r.Update(leet.ReplayTickMsg{Time: t0.Add(5 * time.Second)})
+	first := maxX()
+	require.Greater(t, first, 0.0)
+	require.Less(t, first, 60.0)
+
+	r.Update(leet.ReplayTickMsg{Time: t0.Add(10 * time.Second)})
+	require.Greater(t, maxX(), first)
+
+	// Play/pause works regardless of which pane has focus.
+	r.Update(keyPressMsg('p'))
+	require.Contains(t, stripANSI(r.View().Content), "Replay ⏸")
+	r.Update(keyPressMsg('p'))
+	require.Contains(t, stripANSI(r.View().Content), "Replay ▶")
+
+	// Stopping restores the full history.
+	r.Update(keyPressMsg('v'))
+	require.NotContains(t, stripANSI(r.View().Content), "Replay")
+	require.GreaterOrEqual(t, maxX(), 60.0)
+}
+
+func TestRun_OverviewSection_CollapseHidesItemsAndReflows(t *testing.T) {
+	r := newRunForHandlerTest(t)
+	sidebar := r.TestGetLeftSidebar()
+	require.Equal(t, 1, r.TestLeftSidebarActiveSectionIdx(), "Config starts focused")
+
+	lineOf := func(lines []string, substr string) int {
+		for i, line := range lines {
+			if strings.Contains(line, substr) {
+				return i
+			}
+		}
+		return -1
+	}
+
+	before := strings.Split(stripANSI(sidebar.View(50).Content), "\n")
+	require.NotEqual(t, -1, lineOf(before, "epochs"))
+	summaryBefore := lineOf(before, "Summary")
+
+	r.Update(keyRune('z'))
+
+	after := strings.Split(stripANSI(sidebar.View(50).Content), "\n")
+	require.NotEqual(t, -1, lineOf(after, "▸ Config"), "header should remain")
+	require.Equal(t, -1, lineOf(after, "epochs"), "items should be hidden")
+	require.NotEqual(t, -1, lineOf(after, "linux"), "other sections keep their items")
+	require.Less(t, lineOf(after, "Summary"), summaryBefore, "later sections should move up")
+
+	// Pressing z again expands it.
+	r.Update(keyRune('z'))
+	expanded := strings.Split(stripANSI(sidebar.View(50).Content), "\n")
+	require.NotEqual(t, -1, lineOf(expanded, "epochs"))
+	require.Equal(t, summaryBefore, lineOf(expanded, "Summary"))
+}
+
+func TestRun_KeyHandling_FilterDigitsNeverReachGridConfig(t *testing.T) {
+	logger := observability.NewNoOpLogger()
+	cfg := leet.NewConfigManager(filepath.Join(t.TempDir(), "config.json"), logger)
+	require.NoError(t, cfg.SetMetricsRows(1))
+	require.NoError(t, cfg.SetMetricsCols(1))
+
+	r := leet.NewRun(&leet.RunParams{
+		RunFile: "testdata/fake.wandb",
+	}, cfg, logger)
+	r.Update(tea.WindowSizeMsg{Width: 200, Height: 60})
+
+	for _, k := range "/c2r3" {
+		r.Update(keyRune(k))
+	}
+	require.True(t, r.IsFiltering())
+	require.False(t, cfg.IsAwaitingGridConfig())
+	require.Equal(t, "c2r3", r.TestMetricsGrid().FilterQuery())
+
+	rows, cols := cfg.MetricsGrid()
+	require.Equal(t, 1, rows)
+	require.Equal(t, 1, cols)
+	r.Update(tea.KeyPressMsg{Code: tea.KeyEsc})
+
+	r.Update(keyRune('c'))
+	require.True(t, cfg.IsAwaitingGridConfig())
+	r.Update(keyRune('/'))
+	require.False(t, cfg.IsAwaitingGridConfig())
+	require.False(t, r.IsFiltering())
+
+	rows, cols = cfg.MetricsGrid()
+	require.Equal(t, 1, rows)
+	require.Equal(t, 1, cols)
+}
+
+func TestRun_FileComplete_NonzeroExitCodeShownInOverview(t *testing.T) {
+	r := newRunForHandlerTest(t)
+	sidebar := r.TestGetLeftSidebar()
+	require.NotContains(t, stripANSI(sidebar.View(50).Content), "Exit code")
+
+	r.TestHandleRecordMsg(leet.FileCompleteMsg{ExitCode: 137})
+	sidebar.Sync()
+
+	view := stripANSI(sidebar.View(50).Content)
+	require.Contains(t, view, "State: Failed")
+	require.Contains(t, view, "Exit code: 137 (SIGKILL)")
+	require.Equal(t, leet.RunStateFailed, r.TestRunState())
+}
@@ -0,0 +1,116 @@
+package leet
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+// maxRunErrorLen caps the stored error snippet; the run list truncates it
+// further to fit the sidebar.
+const maxRunErrorLen = 200
+
+// maxRunErrorLines is how many of each selected run's latest error lines
+// the aggregate error log shows.
+const maxRunErrorLines = 50
+
+// minRunErrorWidth is the narrowest error snippet worth showing in the
+// run list.
+const minRunErrorWidth = 8
+
+// runErrorMark prefixes the last error shown next to a failed run.
+const runErrorMark = "✗ "
+
+// errorLinePattern detects error lines written to stdout, such as
+// "ValueError: ..." or "Error: ...".
+var errorLinePattern = regexp.MustCompile(`(?i)(error|exception)\b`)
+
+// lastConsoleLine returns the last non-blank line of console text.
+//
+// Carriage returns are treated as line breaks so that progress bars
+// redrawn in place resolve to their final state.
+func lastConsoleLine(text string) string {
+	lines := strings.FieldsFunc(text, func(r rune) bool {
+		return r == '\n' || r == '\r'
+	})
+	for i := len(lines) - 1; i >= 0; i-- {
+		if line := strings.TrimSpace(lines[i]); line != "" {
+			return line
+		}
+	}
+	return ""
+}
+
+// noteConsoleError records the run's last stderr line or detected error line.
+func (r *WorkspaceRun) noteConsoleError(text string, isStderr bool) {
+	line := lastConsoleLine(text)
+	if line == "" || (!isStderr && !errorLinePattern.MatchString(line)) {
+		return
+	}
+	if len(line) > maxRunErrorLen {
+		line = truncateValue(line, maxRunErrorLen)
+	}
+	r.lastError = line
+}
+
+// runErrorSnippet returns the error to show next to runKey in the run list,
+// or "" unless the run has failed.
+func (w *Workspace) runErrorSnippet(runKey string) string {
+	run := w.runsByKey[runKey]
+	if run == nil || run.state != RunStateFailed {
+		return ""
+	}
+	return run.lastError
+}
+
+// selectedRunErrorItems merges the latest error lines of all selected runs
+// in time order, each prefixed with the run's label.
+func (w *Workspace) selectedRunErrorItems() []KeyValuePair {
+	type taggedLine struct {
+		ConsoleLogLine
+		tag string
+	}
+
+	var lines []taggedLine
+	for runKey := range w.selectedRuns {
+		cl := w.consoleLogs[runKey]
+		if cl == nil {
+			continue
+		}
+		tag := w.shortRunLabel(runKey)
+		for _, line := range cl.ErrorLines(maxRunErrorLines) {
+			lines = append(lines, taggedLine{line, tag})
+		}
+	}
+
+	// Stable, so each run's lines keep their order on timestamp ties.
+	slices.SortStableFunc(lines, func(a, b taggedLine) int {
+		if c := a.Timestamp.Compare(b.Timestamp); c != 0 {
+			return c
+		}
+		return strings.Compare(a.tag, b.tag)
+	})
+
+	items := make([]KeyValuePair, len(lines))
+	for i, line := range lines {
+		items[i] = KeyValuePair{
+			Key:   line.Timestamp.Format(consoleTimestampFormat),
+			Value: fmt.Sprintf("[%s] %s", line.tag, line.Content),
+		}
+	}
+	return items
+}
+
+// consoleLogsLabel returns the console logs pane's header label.
+func (w *Workspace) consoleLogsLabel(runLabel string) string {
+	switch {
+	case w.systemCompareTitle != "":
+		return fmt.Sprintf("%s across %d selected runs",
+			w.systemCompareTitle, len(w.selectedRuns))
+	case w.consoleErrorsView:
+		return fmt.Sprintf("errors in %d selected runs", len(w.selectedRuns))
+	default:
+		return runLabel
+	}
+}
@@ -0,0 +1,73 @@
+package leet
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// runListMetricNames returns the summary metric names of all known runs,
+// sorted, leaving out internal keys such as "_runtime".
+func (w *Workspace) runListMetricNames() []string {
+	seen := make(map[string]struct{})
+	for _, ro := range w.runOverview {
+		if ro == nil {
+			continue
+		}
+		for _, item := range ro.SummaryItems() {
+			if !strings.HasPrefix(item.Key, "_") {
+				seen[item.Key] = struct{}{}
+			}
+		}
+	}
+
+	names := make([]string, 0, len(seen))
+	for name := range seen {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	return names
+}
+
+// cycleRunListMetric shows the next summary metric in the runs list.
+//
+// The cycle runs through the metric names in order and then back to
+// showing none.
+func (w *Workspace) cycleRunListMetric() {
+	names := w.runListMetricNames()
+	if len(names) == 0 {
+		w.setRunNotice("No summary metrics to show in the runs list")
+		return
+	}
+
+	next := names[0]
+	if i := slices.Index(names, w.config.RunListMetric()); i >= 0 {
+		next = ""
+		if i+1 < len(names) {
+			next = names[i+1]
+		}
+	}
+
+	if err := w.config.SetRunListMetric(next); err != nil {
+		w.logger.Error(fmt.Sprintf("workspace: failed to save run list metric: %v", err))
+	}
+	if next == "" {
+		w.setRunNotice("Runs list metric: off")
+		return
+	}
+	w.setRunNotice("Runs list metric: %s", next)
+}
+
+// runListMetricValue returns the value of the runs list metric for runKey,
+// or "" if no metric is chosen or the run has not logged it.
+func (w *Workspace) runListMetricValue(runKey string) string {
+	if w.config == nil {
+		return ""
+	}
+	name := w.config.RunListMetric()
+	ro := w.runOverview[runKey]
+	if name == "" || ro == nil {
+		return ""
+	}
+	return lookupItem(ro.SummaryItems(), name)
+}
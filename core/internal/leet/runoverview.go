@@ -2,8 +2,12 @@ package leet
 
 import (
 	"fmt"
+	"math"
 	"slices"
 	"sort"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/wandb/wandb/core/internal/runconfig"
 	"github.com/wandb/wandb/core/internal/runenvironment"
@@ -13,6 +17,10 @@ import (
 
 const runOverviewHeader = "Run Overview"
 
+// maxInlineListItems is the number of elements shown for a list of
+// scalars rendered on one line; longer lists are truncated.
+const maxInlineListItems = 8
+
 // RunState indicates the current state of the run.
 type RunState int32
 
@@ -22,6 +30,10 @@ const (
 	RunStateFinished
 	RunStateFailed
 	RunStateCrashed
+
+	// RunStateStale marks a running run that has stopped writing records
+	// and is probably hung.
+	RunStateStale
 )
 
 // KeyValuePair represents a single key-value item to display.
@@ -30,28 +42,110 @@ type KeyValuePair struct {
 
 	// Path is the full path for nested items.
 	Path []string
+
+	// TypeHint names the decoded type of the leaf value ("int", "float",
+	// "bool", "str", "null"), so 32 and 32.0 can be told apart.
+	TypeHint string
+}
+
+// ValueFormat controls how numeric overview values are rendered.
+type ValueFormat struct {
+	// Precision is the number of significant digits shown for floats.
+	Precision int
+
+	// SciExponent switches floats to scientific notation once the
+	// magnitude of their decimal exponent reaches it (5: 1e-05, 1.5e+05).
+	SciExponent int
+}
+
+// DefaultValueFormat is used until a config-provided format is set.
+var DefaultValueFormat = ValueFormat{
+	Precision:   DefaultOverviewValuePrecision,
+	SciExponent: DefaultOverviewSciExponent,
+}
+
+// Format renders an overview leaf value. Non-float values use fmt.Sprint.
+func (f ValueFormat) Format(v any) string {
+	switch val := v.(type) {
+	case float64:
+		return f.formatFloat(val)
+	case float32:
+		return f.formatFloat(float64(val))
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+func (f ValueFormat) formatFloat(v float64) string {
+	if v == 0 || math.IsNaN(v) || math.IsInf(v, 0) {
+		return fmt.Sprint(v)
+	}
+	prec := max(f.Precision, 1)
+
+	// Take the exponent after rounding to prec digits, so that values
+	// such as 99999.96 that round up to the next power of ten switch
+	// notation along with it.
+	sci := strconv.FormatFloat(v, 'e', prec-1, 64)
+	mantissa, exponent, _ := strings.Cut(sci, "e")
+	exp, _ := strconv.Atoi(exponent)
+	if f.SciExponent > 0 && (exp >= f.SciExponent || exp <= -f.SciExponent) {
+		return trimFractionZeros(mantissa) + "e" + exponent
+	}
+
+	decimals := max(prec-1-exp, 0)
+	return trimFractionZeros(strconv.FormatFloat(v, 'f', decimals, 64))
+}
+
+// trimFractionZeros drops trailing zeros (and a bare dot) after a decimal point.
+func trimFractionZeros(s string) string {
+	if !strings.Contains(s, ".") {
+		return s
+	}
+	return strings.TrimSuffix(strings.TrimRight(s, "0"), ".")
 }
 
 // RunOverview processes and stores run metadata.
 type RunOverview struct {
 	runID          string
 	displayName    string
+	entity         string
 	project        string
 	notes          string
 	tags           []string
 	runConfig      *runconfig.RunConfig
 	runEnvironment *runenvironment.RunEnvironment
+	launchCommand  string
 	runSummary     *runsummary.RunSummary
 	runState       RunState
+	valueFormat    ValueFormat
+
+	// exitCode is the run's exit code; exited reports whether it was set.
+	exitCode int32
+	exited   bool
+
+	// runtime caches the summary runtime; hasRuntime reports whether
+	// the summary holds one. Both are refreshed on each summary update.
+	runtime    time.Duration
+	hasRuntime bool
+
+	// histograms holds the latest stats of each histogram logged to
+	// history, such as wandb.watch's per-layer gradients.
+	histograms map[string]HistogramStats
 }
 
 func NewRunOverview() *RunOverview {
 	return &RunOverview{
-		runConfig:  runconfig.New(),
-		runSummary: runsummary.New(),
+		runConfig:   runconfig.New(),
+		runSummary:  runsummary.New(),
+		valueFormat: DefaultValueFormat,
 	}
 }
 
+// SetValueFormat sets how numeric config and summary values are rendered.
+func (ro *RunOverview) SetValueFormat(f ValueFormat) {
+	ro.valueFormat = f
+}
+
 // StateString returns a string representation from the data model.
 func (ro *RunOverview) StateString() string {
 	switch ro.State() {
@@ -63,6 +157,8 @@ func (ro *RunOverview) StateString() string {
 		return "Failed"
 	case RunStateCrashed:
 		return "Error"
+	case RunStateStale:
+		return "Stale"
 	default:
 		return "Unknown"
 	}
@@ -72,6 +168,7 @@ func (ro *RunOverview) StateString() string {
 func (ro *RunOverview) ProcessRunMsg(msg RunMsg) {
 	ro.runID = msg.ID
 	ro.displayName = msg.DisplayName
+	ro.entity = msg.Entity
 	ro.project = msg.Project
 	ro.notes = msg.Notes
 	ro.tags = dedupStrings(msg.Tags)
@@ -80,6 +177,9 @@ func (ro *RunOverview) ProcessRunMsg(msg RunMsg) {
 	if msg.Config != nil {
 		ro.runConfig.ApplyChangeRecord(msg.Config, func(err error) {})
 	}
+	if len(msg.Summary.GetUpdate()) > 0 {
+		ro.ProcessSummaryMsg([]*spb.SummaryRecord{msg.Summary})
+	}
 }
 
 // ProcessSystemInfoMsg processes system/environment information.
@@ -90,6 +190,15 @@ func (ro *RunOverview) ProcessSystemInfoMsg(record *spb.EnvironmentRecord) {
 	if ro.runEnvironment != nil {
 		ro.runEnvironment.ProcessRecord(record)
 	}
+	if program := record.GetProgram(); program != "" {
+		ro.launchCommand = strings.Join(append([]string{program}, record.GetArgs()...), " ")
+	}
+}
+
+// LaunchCommand returns the program and arguments that started the run,
+// or "" if the environment record did not include them.
+func (ro *RunOverview) LaunchCommand() string {
+	return ro.launchCommand
 }
 
 // ProcessSummaryMsg processes summary data.
@@ -98,6 +207,9 @@ func (ro *RunOverview) ProcessSummaryMsg(summary []*spb.SummaryRecord) {
 		_ = runsummary.FromProto(s).Apply(ro.runSummary)
 	}
 
+	seconds, ok := runsummary.ParseRuntime(ro.runSummary.ToNestedMaps())
+	ro.runtime = time.Duration(seconds) * time.Second
+	ro.hasRuntime = ok
 }
 
 // SetRunState sets the run state.
@@ -105,6 +217,12 @@ func (ro *RunOverview) SetRunState(state RunState) {
 	ro.runState = state
 }
 
+// SetExitCode records the exit code from the run's exit record.
+func (ro *RunOverview) SetExitCode(code int32) {
+	ro.exitCode = code
+	ro.exited = true
+}
+
 // Data accessors
 
 // ID returns the run ID.
@@ -117,6 +235,46 @@ func (ro *RunOverview) DisplayName() string {
 	return ro.displayName
 }
 
+// Runtime returns the run's runtime as recorded in its summary.
+func (ro *RunOverview) Runtime() (time.Duration, bool) {
+	return ro.runtime, ro.hasRuntime
+}
+
+// ExitCode returns the run's exit code, if the run has exited.
+func (ro *RunOverview) ExitCode() (int32, bool) {
+	return ro.exitCode, ro.exited
+}
+
+// ExitCodeString describes a nonzero exit code, naming the signal for codes
+// above 128 (e.g. "137 (SIGKILL)"). Returns "" for clean or pending exits.
+func (ro *RunOverview) ExitCodeString() string {
+	if !ro.exited || ro.exitCode == 0 {
+		return ""
+	}
+	if name, ok := exitSignalNames[ro.exitCode-128]; ok && ro.exitCode > 128 {
+		return fmt.Sprintf("%d (%s)", ro.exitCode, name)
+	}
+	return strconv.Itoa(int(ro.exitCode))
+}
+
+// exitSignalNames names common POSIX signals; shells report a process
+// killed by signal N with exit code 128+N.
+var exitSignalNames = map[int32]string{
+	1:  "SIGHUP",
+	2:  "SIGINT",
+	3:  "SIGQUIT",
+	6:  "SIGABRT",
+	9:  "SIGKILL",
+	11: "SIGSEGV",
+	13: "SIGPIPE",
+	15: "SIGTERM",
+}
+
+// Entity returns the entity (user or team) that owns the run.
+func (ro *RunOverview) Entity() string {
+	return ro.entity
+}
+
 // Project returns the project name.
 func (ro *RunOverview) Project() string {
 	return ro.project
@@ -127,6 +285,20 @@ func (ro *RunOverview) Notes() string {
 	return ro.notes
 }
 
+// NotesItems returns the run notes one line per item, keyed by line number
+// and skipping blank lines.
+func (ro *RunOverview) NotesItems() []KeyValuePair {
+	items := make([]KeyValuePair, 0)
+	for i, line := range strings.Split(ro.notes, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+		items = append(items, KeyValuePair{Key: strconv.Itoa(i + 1), Value: line})
+	}
+	return items
+}
+
 // Tags returns a defensive copy of the run tags.
 func (ro *RunOverview) Tags() []string {
 	return slices.Clone(ro.tags)
@@ -154,7 +326,7 @@ func (ro *RunOverview) ConfigItems() []KeyValuePair {
 	}
 
 	items := make([]KeyValuePair, 0)
-	flattenMap(ro.runConfig.CloneTree(), "", &items, []string{})
+	flattenMap(ro.runConfig.CloneTree(), "", &items, []string{}, ro.valueFormat.Format)
 	return items
 }
 
@@ -165,15 +337,23 @@ func (ro *RunOverview) SummaryItems() []KeyValuePair {
 	}
 
 	items := make([]KeyValuePair, 0)
-	flattenMap(ro.runSummary.ToNestedMaps(), "", &items, []string{})
+	flattenMap(ro.runSummary.ToNestedMaps(), "", &items, []string{}, ro.valueFormat.Format)
 	return items
 }
 
 // flattenMap converts nested maps to flat key-value pairs.
 //
 // - Map keys are sorted (deterministic).
-// - Slices are flattened using bracketed indices (e.g., a[0].b).
-func flattenMap(data map[string]any, prefix string, result *[]KeyValuePair, path []string) {
+// - Slices of scalars are rendered inline (e.g., [64, 128, 256]).
+// - Other slices are flattened using bracketed indices (e.g., a[0].b).
+// - Leaf values are rendered with format.
+func flattenMap(
+	data map[string]any,
+	prefix string,
+	result *[]KeyValuePair,
+	path []string,
+	format func(any) string,
+) {
 	if data == nil {
 		return
 	}
@@ -195,21 +375,48 @@ func flattenMap(data map[string]any, prefix string, result *[]KeyValuePair, path
 
 		switch val := v.(type) {
 		case map[string]any:
-			flattenMap(val, fullKey, result, currentPath)
+			if isHistogramObject(val) {
+				// Summarize rather than list every bin.
+				if mean, std, ok := histogramStats(val); ok {
+					*result = append(*result, KeyValuePair{
+						Key:   fullKey,
+						Value: formatHistogramStats(mean, std),
+						Path:  currentPath,
+					})
+				}
+				continue
+			}
+			flattenMap(val, fullKey, result, currentPath, format)
 		case []any:
-			flattenSlice(val, fullKey, result, currentPath)
+			if isScalarList(val) {
+				*result = append(*result, KeyValuePair{
+					Key:      fullKey,
+					Value:    formatInlineList(val, format),
+					Path:     currentPath,
+					TypeHint: "list",
+				})
+				continue
+			}
+			flattenSlice(val, fullKey, result, currentPath, format)
 		default:
 			*result = append(*result, KeyValuePair{
-				Key:   fullKey,
-				Value: fmt.Sprint(v),
-				Path:  currentPath,
+				Key:      fullKey,
+				Value:    format(v),
+				Path:     currentPath,
+				TypeHint: valueTypeHint(v),
 			})
 		}
 	}
 }
 
 // flattenSlice handles []any by emitting `prefix[i]` and recursing as needed.
-func flattenSlice(list []any, prefix string, result *[]KeyValuePair, path []string) {
+func flattenSlice(
+	list []any,
+	prefix string,
+	result *[]KeyValuePair,
+	path []string,
+	format func(any) string,
+) {
 	for i, elem := range list {
 		idxFrag := fmt.Sprintf("[%d]", i)
 		fullKey := prefix + idxFrag
@@ -217,19 +424,91 @@ func flattenSlice(list []any, prefix string, result *[]KeyValuePair, path []stri
 
 		switch e := elem.(type) {
 		case map[string]any:
-			flattenMap(e, fullKey, result, idxPath)
+			flattenMap(e, fullKey, result, idxPath, format)
 		case []any:
-			flattenSlice(e, fullKey, result, idxPath)
+			if isScalarList(e) {
+				*result = append(*result, KeyValuePair{
+					Key:      fullKey,
+					Value:    formatInlineList(e, format),
+					Path:     idxPath,
+					TypeHint: "list",
+				})
+				continue
+			}
+			flattenSlice(e, fullKey, result, idxPath, format)
 		default:
 			*result = append(*result, KeyValuePair{
-				Key:   fullKey,
-				Value: fmt.Sprint(e),
-				Path:  idxPath,
+				Key:      fullKey,
+				Value:    format(e),
+				Path:     idxPath,
+				TypeHint: valueTypeHint(e),
 			})
 		}
 	}
 }
 
+// isScalarList reports whether list holds no maps or nested lists.
+func isScalarList(list []any) bool {
+	for _, elem := range list {
+		switch elem.(type) {
+		case map[string]any, []any:
+			return false
+		}
+	}
+	return true
+}
+
+// formatInlineList renders a list of scalars on one line, such as
+// [64, 128, 256].
+//
+// Strings are quoted so that elements containing commas stay readable.
+// Lists longer than maxInlineListItems are cut off and their length
+// appended, such as [1, 2, …] (20 items).
+func formatInlineList(list []any, format func(any) string) string {
+	var b strings.Builder
+	b.WriteByte('[')
+	for i, elem := range list {
+		if i == maxInlineListItems {
+			b.WriteString(", …")
+			break
+		}
+		if i > 0 {
+			b.WriteString(", ")
+		}
+		if s, ok := elem.(string); ok {
+			b.WriteString(strconv.Quote(s))
+		} else {
+			b.WriteString(format(elem))
+		}
+	}
+	b.WriteByte(']')
+	if len(list) > maxInlineListItems {
+		fmt.Fprintf(&b, " (%d items)", len(list))
+	}
+	return b.String()
+}
+
+// valueTypeHint returns a short name for the type of a decoded JSON value.
+//
+// Integers and floats are distinct here because config values are decoded
+// as int64 or float64 depending on how they were logged.
+func valueTypeHint(v any) string {
+	switch v.(type) {
+	case nil:
+		return "null"
+	case bool:
+		return "bool"
+	case int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64:
+		return "int"
+	case float32, float64:
+		return "float"
+	case string:
+		return "str"
+	default:
+		return ""
+	}
+}
+
 // processEnvironmentData handles special processing for environment data.
 //
 // The run's transaction log should only contain info about a single writer.
@@ -257,7 +536,7 @@ func processEnvironmentData(data map[string]any) []KeyValuePair {
 
 	if valueMap, ok := firstValue.(map[string]any); ok {
 		result := make([]KeyValuePair, 0)
-		flattenMap(valueMap, "", &result, []string{})
+		flattenMap(valueMap, "", &result, []string{}, func(v any) string { return fmt.Sprint(v) })
 		return result
 	}
 
@@ -4,6 +4,7 @@ import (
 	"slices"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -118,3 +119,102 @@ func TestRunOverview_Config_ListOfMaps_Flattens(t *testing.T) {
 	require.Equal(t, "2", items[1].Value)
 	require.Equal(t, []string{"a", "[1]", "c"}, items[1].Path)
 }
+
+func TestRunOverview_Config_ScalarListsRenderInline(t *testing.T) {
+	ro := leet.NewRunOverview()
+	ro.ProcessRunMsg(leet.RunMsg{
+		Config: &spb.ConfigRecord{
+			Update: []*spb.ConfigItem{
+				{NestedKey: []string{"layers"}, ValueJson: `[64, 128, 256]`},
+				{NestedKey: []string{"acts"}, ValueJson: `["relu", "gelu"]`},
+				{NestedKey: []string{"schedule"}, ValueJson: `[1,2,3,4,5,6,7,8,9,10]`},
+			},
+		},
+	})
+
+	items := ro.ConfigItems()
+	require.Len(t, items, 3)
+	require.Equal(t, leet.KeyValuePair{
+		Key:      "acts",
+		Value:    `["relu", "gelu"]`,
+		Path:     []string{"acts"},
+		TypeHint: "list",
+	}, items[0])
+	require.Equal(t, "layers", items[1].Key)
+	require.Equal(t, "[64, 128, 256]", items[1].Value)
+	require.Equal(t, "schedule", items[2].Key)
+	require.Equal(t, "[1, 2, 3, 4, 5, 6, 7, 8, …] (10 items)", items[2].Value)
+}
+
+func TestRunOverview_ValueFormat_PrecisionAndSciNotation(t *testing.T) {
+	ro := leet.NewRunOverview()
+	ro.ProcessRunMsg(leet.RunMsg{
+		Config: &spb.ConfigRecord{
+			Update: []*spb.ConfigItem{
+				{NestedKey: []string{"lr"}, ValueJson: "1e-05"},
+				{NestedKey: []string{"momentum"}, ValueJson: "0.30000000000000004"},
+				{NestedKey: []string{"pi"}, ValueJson: "3.14159265358979"},
+				{NestedKey: []string{"tokens"}, ValueJson: "2.5e9"},
+				{NestedKey: []string{"steps"}, ValueJson: "1000000"},
+			},
+		},
+	})
+	ro.ProcessSummaryMsg([]*spb.SummaryRecord{{
+		Update: []*spb.SummaryItem{{NestedKey: []string{"loss"}, ValueJson: "0.000123456"}},
+	}})
+
+	values := func() map[string]string {
+		out := map[string]string{}
+		for _, it := range slices.Concat(ro.ConfigItems(), ro.SummaryItems()) {
+			out[it.Key] = it.Value
+		}
+		return out
+	}
+
+	require.Equal(t, map[string]string{
+		"lr":       "1e-05",
+		"momentum": "0.3",
+		"pi":       "3.14159",
+		"tokens":   "2.5e+09",
+		"steps":    "1000000", // integers are shown as is
+		"loss":     "0.000123456",
+	}, values())
+
+	ro.SetValueFormat(leet.ValueFormat{Precision: 3, SciExponent: 3})
+	require.Equal(t, map[string]string{
+		"lr":       "1e-05",
+		"momentum": "0.3",
+		"pi":       "3.14",
+		"tokens":   "2.5e+09",
+		"steps":    "1000000",
+		"loss":     "1.23e-04",
+	}, values())
+}
+
+func TestRunOverview_Runtime_FromSummaryEncodings(t *testing.T) {
+	ro := leet.NewRunOverview()
+	_, ok := ro.Runtime()
+	require.False(t, ok)
+
+	ro.ProcessSummaryMsg([]*spb.SummaryRecord{{
+		Update: []*spb.SummaryItem{{NestedKey: []string{"_runtime"}, ValueJson: `"125.9"`}},
+	}})
+	runtime, ok := ro.Runtime()
+	require.True(t, ok)
+	require.Equal(t, 125*time.Second, runtime)
+
+	ro.ProcessSummaryMsg([]*spb.SummaryRecord{{
+		Update: []*spb.SummaryItem{{NestedKey: []string{"_wandb", "runtime"}, ValueJson: "3600"}},
+	}})
+	runtime, ok = ro.Runtime()
+	require.True(t, ok)
+	require.Equal(t, time.Hour, runtime)
+}
+
+func TestValueFormat_RoundingUpSwitchesToSciNotation(t *testing.T) {
+	f := leet.ValueFormat{Precision: 6, SciExponent: 5}
+
+	require.Equal(t, "1e+05", f.Format(99999.96))
+	require.Equal(t, "99999.9", f.Format(99999.94))
+	require.Equal(t, "1e-05", f.Format(9.9999999e-06))
+}
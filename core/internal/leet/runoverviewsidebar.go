@@ -23,6 +23,9 @@ const (
 // for wrapped tags and notes.
 const minSidebarHeaderLines = 6
 
+// collapsedSectionMark prefixes the header of a collapsed overview section.
+const collapsedSectionMark = "▸ "
+
 // RunOverviewSidebar stores and displays run metadata.
 //
 // It handles presentation concerns: sections, filtering, navigation, layout, and rendering.
@@ -52,18 +55,22 @@ func NewRunOverviewSidebar(
 	runOverview *RunOverview,
 	side SidebarSide,
 ) *RunOverviewSidebar {
-	es := PagedList{Title: "Environment", Active: true}
+	es := PagedList{Title: "Environment", Active: true, MaxHeight: sectionMaxHeightEnvironment}
 	es.SetItemsPerPage(10)
-	cs := PagedList{Title: "Config"}
+	cs := PagedList{Title: "Config", MaxHeight: sectionMaxHeightConfig}
 	cs.SetItemsPerPage(15)
-	ss := PagedList{Title: "Summary"}
+	ss := PagedList{Title: "Summary", MaxHeight: sectionMaxHeightSummary}
 	ss.SetItemsPerPage(20)
+	ns := PagedList{Title: "Notes", MaxHeight: sectionMaxHeightNotes}
+	ns.SetItemsPerPage(5)
+	hs := PagedList{Title: "Histograms", MaxHeight: sectionMaxHeightHistograms}
+	hs.SetItemsPerPage(10)
 
 	return &RunOverviewSidebar{
 		config:        config,
 		animState:     animState,
 		runOverview:   runOverview,
-		sections:      []PagedList{es, cs, ss},
+		sections:      []PagedList{es, cs, ss, ns, hs},
 		activeSection: 0,
 		filter:        NewFilter(),
 		side:          side,
@@ -205,9 +212,12 @@ func (s *RunOverviewSidebar) Sync() {
 		selectedKey, _ = s.SelectedItem()
 	}
 
+	s.runOverview.SetValueFormat(s.config.OverviewValueFormat())
 	s.sections[0].Items = s.runOverview.EnvironmentItems()
 	s.sections[1].Items = s.runOverview.ConfigItems()
 	s.sections[2].Items = s.runOverview.SummaryItems()
+	s.sections[3].Items = s.runOverview.NotesItems()
+	s.sections[4].Items = s.runOverview.HistogramItems()
 
 	if s.IsFilterMode() || s.IsFiltering() {
 		s.ApplyFilter()
@@ -263,7 +273,7 @@ func (s *RunOverviewSidebar) SelectedItem() (key, value string) {
 	}
 
 	section := &s.sections[s.activeSection]
-	if len(section.FilteredItems) == 0 {
+	if len(section.FilteredItems) == 0 || s.isSectionCollapsed(s.activeSection) {
 		return "", ""
 	}
 
@@ -280,15 +290,13 @@ func (s *RunOverviewSidebar) SelectedItem() (key, value string) {
 
 // animationCmd returns a command to continue the animation on section toggle.
 func (s *RunOverviewSidebar) animationCmd() tea.Cmd {
-	return tea.Tick(AnimationFrame, func(t time.Time) tea.Msg {
-		switch s.side {
-		case SidebarSideLeft:
-			return LeftSidebarAnimationMsg{}
-		case SidebarSideRight:
-			return RightSidebarAnimationMsg{}
-		}
-		return nil
-	})
+	switch s.side {
+	case SidebarSideLeft:
+		return animationFrameCmd(LeftSidebarAnimationMsg{})
+	case SidebarSideRight:
+		return animationFrameCmd(RightSidebarAnimationMsg{})
+	}
+	return nil
 }
 
 // truncateValue truncates string values that do not fit into available width.
@@ -332,9 +340,15 @@ func (s *RunOverviewSidebar) buildHeaderLines(contentWidth int) []string {
 	lines := make([]string, 0, 8)
 
 	if s.runOverview.State() != RunStateUnknown {
+		stateStyle := runOverviewSidebarValueStyle
+		if s.runOverview.State() == RunStateStale && s.config != nil {
+			stateStyle = lipgloss.NewStyle().Foreground(lipgloss.Color(s.config.StaleRunColor()))
+		}
 		lines = slices.Concat(
 			lines,
-			s.renderWrappedHeaderValue("State: ", s.runOverview.StateString(), contentWidth),
+			s.renderStyledHeaderValue(
+				"State: ", s.runOverview.StateString(), contentWidth, stateStyle),
+			s.renderWrappedHeaderValue("Exit code: ", s.runOverview.ExitCodeString(), contentWidth),
 		)
 	}
 
@@ -343,8 +357,8 @@ func (s *RunOverviewSidebar) buildHeaderLines(contentWidth int) []string {
 		s.renderWrappedHeaderValue("ID: ", s.runOverview.ID(), contentWidth),
 		s.renderWrappedHeaderValue("Name: ", s.runOverview.DisplayName(), contentWidth),
 		s.renderWrappedHeaderValue("Project: ", s.runOverview.Project(), contentWidth),
+		s.renderWrappedHeaderValue("Runtime: ", s.runtimeText(), contentWidth),
 		s.renderTagHeaderValue("Tags: ", s.runOverview.Tags(), contentWidth),
-		s.renderWrappedHeaderValue("Notes: ", s.runOverview.Notes(), contentWidth),
 	)
 
 	if len(lines) > 0 {
@@ -354,10 +368,27 @@ func (s *RunOverviewSidebar) buildHeaderLines(contentWidth int) []string {
 	return lines
 }
 
+// runtimeText formats the run's summary runtime, or "" if unknown.
+func (s *RunOverviewSidebar) runtimeText() string {
+	d, ok := s.runOverview.Runtime()
+	if !ok {
+		return ""
+	}
+	return compactDuration(d)
+}
+
 // renderWrappedHeaderValue renders a single metadata field, wrapping the value
 // onto continuation lines when needed.
 func (s *RunOverviewSidebar) renderWrappedHeaderValue(
 	prefix, value string, width int,
+) []string {
+	return s.renderStyledHeaderValue(prefix, value, width, runOverviewSidebarValueStyle)
+}
+
+// renderStyledHeaderValue is renderWrappedHeaderValue with the value
+// rendered in valueStyle.
+func (s *RunOverviewSidebar) renderStyledHeaderValue(
+	prefix, value string, width int, valueStyle lipgloss.Style,
 ) []string {
 	if strings.TrimSpace(value) == "" {
 		return nil
@@ -371,7 +402,7 @@ func (s *RunOverviewSidebar) renderWrappedHeaderValue(
 
 	lines := make([]string, 0, len(wrapped))
 	for i, line := range wrapped {
-		renderedValue := runOverviewSidebarValueStyle.Render(line)
+		renderedValue := valueStyle.Render(line)
 		if i == 0 {
 			lines = append(lines, prefixText+renderedValue)
 			continue
@@ -463,10 +494,21 @@ func (s *RunOverviewSidebar) buildSectionLines(contentWidth int) []string {
 func (s *RunOverviewSidebar) renderSection(idx, width int) string {
 	section := &s.sections[idx]
 
-	if len(section.FilteredItems) == 0 || section.Height == 0 {
+	if section.Height == 0 {
+		return ""
+	}
+	if s.showsEmptySection(idx) {
+		return s.renderSectionHeader(section) + navInfoStyle.Render(" [empty]")
+	}
+	if len(section.FilteredItems) == 0 {
 		return ""
 	}
 
+	// Collapsed sections keep only their header.
+	if s.isSectionCollapsed(idx) {
+		return navInfoStyle.Render(collapsedSectionMark) + s.renderSectionHeader(section)
+	}
+
 	var lines []string
 
 	// Render section header.
@@ -534,6 +576,10 @@ func (s *RunOverviewSidebar) renderSectionItems(section *PagedList, width int) [
 
 	itemsToRender := min(endIdx-startIdx, section.ItemsPerPage())
 
+	// Only config values carry type hints; summary and environment
+	// values are self-explanatory.
+	showTypeHints := section.Title == "Config"
+
 	lines := make([]string, 0, itemsToRender)
 	for i := range itemsToRender {
 		itemIdx := startIdx + i
@@ -542,6 +588,9 @@ func (s *RunOverviewSidebar) renderSectionItems(section *PagedList, width int) [
 		}
 
 		item := section.FilteredItems[itemIdx]
+		if !showTypeHints {
+			item.TypeHint = ""
+		}
 		line := s.renderItem(item, i, section, maxKeyWidth, maxValueWidth)
 		lines = append(lines, line)
 	}
@@ -565,18 +614,36 @@ func (s *RunOverviewSidebar) renderItem(
 		valueStyle = runOverviewSidebarHighlightedItem
 	}
 
+	// Reserve room for the type hint only when the value can spare it.
+	hint := ""
+	if item.TypeHint != "" && maxValueWidth > 2*(len(item.TypeHint)+1) {
+		hint = item.TypeHint
+	}
+	valueWidth := maxValueWidth
+	if hint != "" {
+		valueWidth -= len(hint) + 1
+	}
+
 	key := truncateValue(item.Key, maxKeyWidth)
-	value := truncateValue(item.Value, maxValueWidth)
+	value := truncateValue(item.Value, valueWidth)
 
 	renderedKey := keyStyle.Width(maxKeyWidth).Render(key)
 
 	gap := " "
 	if isHighlighted {
 		gap = runOverviewSidebarHighlightedItem.Render(" ")
+		if hint != "" {
+			value += " " + hint
+		}
 		renderedValue := valueStyle.Width(maxValueWidth).Render(value)
 		return renderedKey + gap + renderedValue
 	}
-	return renderedKey + gap + valueStyle.MaxWidth(maxValueWidth).Render(value)
+
+	renderedValue := valueStyle.Render(value)
+	if hint != "" {
+		renderedValue += " " + runOverviewSidebarTypeHintStyle.Render(hint)
+	}
+	return renderedKey + gap + lipgloss.NewStyle().MaxWidth(maxValueWidth).Render(renderedValue)
 }
 
 // hasNextVisibleSection returns true if there's another visible section after idx.
@@ -613,11 +680,15 @@ func (s *RunOverviewSidebar) activateSelection() {
 
 // focusableSectionBounds returns the first and last sections that currently have
 // visible items and can accept navigation. If none exist, it returns (-1, -1).
+//
+// Collapsed sections with items stay focusable so they can be expanded again.
+// Empty sections are skipped, whether hidden or shown as a header.
 func (s *RunOverviewSidebar) focusableSectionBounds() (first, last int) {
 	first, last = -1, -1
 	for i := range s.sections {
 		sec := &s.sections[i]
-		if sec.ItemsPerPage() == 0 || len(sec.FilteredItems) == 0 {
+		if len(sec.FilteredItems) == 0 ||
+			(sec.ItemsPerPage() == 0 && !s.isSectionCollapsed(i)) {
 			continue
 		}
 		if first == -1 {
@@ -628,6 +699,51 @@ func (s *RunOverviewSidebar) focusableSectionBounds() (first, last int) {
 	return first, last
 }
 
+// isSectionToggleKey reports whether msg collapses/expands the focused
+// overview section.
+func isSectionToggleKey(msg tea.KeyPressMsg) bool {
+	return msg.String() == "z"
+}
+
+// isSectionCollapsed reports whether section idx is collapsed to its header.
+func (s *RunOverviewSidebar) isSectionCollapsed(idx int) bool {
+	if idx < 0 || idx >= len(s.sections) {
+		return false
+	}
+	return s.config.OverviewSectionCollapsed(s.sections[idx].Title)
+}
+
+// showsEmptySection reports whether section idx has no items but still
+// shows its header because empty sections are not hidden.
+//
+// Sections whose items are all filtered out stay hidden either way.
+func (s *RunOverviewSidebar) showsEmptySection(idx int) bool {
+	if idx < 0 || idx >= len(s.sections) || s.config == nil {
+		return false
+	}
+	return len(s.sections[idx].Items) == 0 && !s.config.HideEmptyOverviewSections()
+}
+
+// isHeaderOnly reports whether section idx renders as its header line
+// alone, being collapsed or shown empty.
+func (s *RunOverviewSidebar) isHeaderOnly(idx int) bool {
+	return s.isSectionCollapsed(idx) || s.showsEmptySection(idx)
+}
+
+// toggleActiveSectionCollapsed collapses or expands the active section
+// and reflows the remaining sections into the freed (or needed) space.
+//
+// Returns the error from persisting the new state, if any.
+func (s *RunOverviewSidebar) toggleActiveSectionCollapsed() error {
+	if !s.isValidActiveSection() || len(s.sections[s.activeSection].FilteredItems) == 0 {
+		return nil
+	}
+	title := s.sections[s.activeSection].Title
+	err := s.config.SetOverviewSectionCollapsed(title, !s.isSectionCollapsed(s.activeSection))
+	s.updateSectionHeights()
+	return err
+}
+
 // sidebarContentWidth returns the width available for text content
 // after subtracting border and padding.
 func (s *RunOverviewSidebar) sidebarContentWidth(width int) int {
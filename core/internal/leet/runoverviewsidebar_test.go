@@ -272,7 +272,7 @@ func TestSidebar_TruncateValue(t *testing.T) {
 	require.Contains(t, view, "...")
 }
 
-func TestSidebar_View_RendersTagsAndNotesBeforeSections(t *testing.T) {
+func TestSidebar_View_RendersTagsInHeaderAndNotesAsSection(t *testing.T) {
 	ro, s := testRunOverviewSidebar(t, false)
 	expandSidebar(t, s, 120, false)
 
@@ -291,14 +291,35 @@ func TestSidebar_View_RendersTagsAndNotesBeforeSections(t *testing.T) {
 
 	s.Sync()
 
-	view := stripANSI(s.View(18).Content)
+	view := stripANSI(s.View(30).Content)
 	require.Contains(t, view, "Tags:")
 	require.Contains(t, view, "wandb")
 	require.Contains(t, view, "leet")
 	require.Contains(t, view, "transformer")
-	require.Contains(t, view, "Notes:")
+	require.NotContains(t, view, "Notes:")
+	require.Contains(t, view, "Notes [1 item")
 	require.Contains(t, view, "Baseline note")
-	require.Contains(t, view, "Config")
+	require.Less(t, strings.Index(view, "Tags:"), strings.Index(view, "Config"))
+}
+
+func TestSidebar_View_RendersNotesSection(t *testing.T) {
+	ro, s := testRunOverviewSidebar(t, false)
+	expandSidebar(t, s, 120, false)
+
+	ro.ProcessRunMsg(leet.RunMsg{
+		ID:    "run-42",
+		Notes: "Warm start from the v3 checkpoint.\n\nLR halved after step 10k.",
+	})
+	require.Equal(t, []leet.KeyValuePair{
+		{Key: "1", Value: "Warm start from the v3 checkpoint."},
+		{Key: "3", Value: "LR halved after step 10k."},
+	}, ro.NotesItems())
+
+	s.Sync()
+
+	view := stripANSI(s.View(30).Content)
+	require.Contains(t, view, "Notes [2 items]")
+	require.Contains(t, view, "3                LR halved after")
 }
 
 func TestSidebar_View_StaysWithinRequestedBounds(t *testing.T) {
@@ -414,3 +435,101 @@ func TestSidebar_Pagination_ResizeFromLaterPage(t *testing.T) {
 		require.NotEmpty(t, view)
 	})
 }
+
+func TestSidebar_View_ConfigValuesShowTypeHints(t *testing.T) {
+	ro, s := testRunOverviewSidebar(t, false)
+	expandSidebar(t, s, 160, false)
+
+	ro.ProcessRunMsg(leet.RunMsg{
+		ID: "run-types",
+		Config: &spb.ConfigRecord{
+			Update: []*spb.ConfigItem{
+				{NestedKey: []string{"batch"}, ValueJson: "32"},
+				{NestedKey: []string{"scale"}, ValueJson: "32.0"},
+				{NestedKey: []string{"shuffle"}, ValueJson: "true"},
+				{NestedKey: []string{"optim"}, ValueJson: `"adam"`},
+			},
+		},
+	})
+	ro.ProcessSummaryMsg([]*spb.SummaryRecord{{
+		Update: []*spb.SummaryItem{{NestedKey: []string{"loss"}, ValueJson: "0.5"}},
+	}})
+	s.Sync()
+
+	hints := map[string]string{}
+	for _, item := range ro.ConfigItems() {
+		hints[item.Key] = item.TypeHint
+	}
+	require.Equal(t, map[string]string{
+		"batch":   "int",
+		"scale":   "float",
+		"shuffle": "bool",
+		"optim":   "str",
+	}, hints)
+
+	view := stripANSI(s.View(30).Content)
+	require.Regexp(t, `batch\s+32 int`, view)
+	require.Regexp(t, `scale\s+32 float`, view)
+	require.Regexp(t, `shuffle\s+true bool`, view)
+	require.Regexp(t, `optim\s+adam str`, view)
+	require.Regexp(t, `loss\s+0\.5`, view)
+	require.NotRegexp(t, `loss\s+0\.5 float`, view, "summary values have no type hint")
+}
+
+func TestSidebar_EmptySummary_HiddenAndSkippedByFocus(t *testing.T) {
+	cfg := leet.NewConfigManager(filepath.Join(t.TempDir(), "config.json"), nil)
+	require.False(t, cfg.HideEmptyOverviewSections())
+	ro := leet.NewRunOverview()
+	s := leet.NewRunOverviewSidebar(cfg, leet.NewAnimatedValue(false, 120), ro, leet.SidebarSideLeft)
+	expandSidebar(t, s, 120, false)
+
+	ro.ProcessRunMsg(leet.RunMsg{
+		ID: "run-1",
+		Config: &spb.ConfigRecord{
+			Update: []*spb.ConfigItem{
+				{NestedKey: []string{"lr"}, ValueJson: "0.1"},
+			},
+		},
+		Notes: "No summary yet.",
+	})
+	s.Sync()
+
+	// Shown empty sections get a header line but take no focus.
+	view := stripANSI(s.View(30).Content)
+	require.Contains(t, view, "Config [1 items]")
+	require.Contains(t, view, "Summary [empty]")
+	first, last := s.TestFocusableSectionBounds()
+	require.Equal(t, 1, first)
+	require.Equal(t, 3, last)
+
+	require.NoError(t, cfg.SetHideEmptyOverviewSections(true))
+	s.Sync()
+	view = stripANSI(s.View(30).Content)
+	require.Contains(t, view, "Config [1 items]")
+	require.NotContains(t, view, "Summary")
+
+	// Tab from Config lands on Notes, past the hidden Summary.
+	first, last = s.TestFocusableSectionBounds()
+	require.Equal(t, 1, first)
+	require.Equal(t, 3, last)
+	s.Update(tea.KeyPressMsg{Code: tea.KeyTab})
+	key, val := s.SelectedItem()
+	require.Equal(t, "1", key)
+	require.Equal(t, "No summary yet.", val)
+}
+
+func TestSidebar_RendersHistogramsSection(t *testing.T) {
+	ro, s := testRunOverviewSidebar(t, true)
+	ro.ProcessSummaryMsg([]*spb.SummaryRecord{
+		{Update: []*spb.SummaryItem{{NestedKey: []string{"acc"}, ValueJson: "0.9"}}},
+	})
+	ro.ProcessHistograms(map[string]leet.HistogramStats{
+		"gradients/layer1.weight": {Step: 1, Mean: 0.5, Std: 0.1},
+		"gradients/layer2.weight": {Step: 1, Mean: -0.25, Std: 0.2},
+	})
+	s.Sync()
+
+	view := stripANSI(s.View(40).Content)
+	require.Contains(t, view, "Histograms [2 items]")
+	require.Contains(t, view, "layer1.weight")
+}
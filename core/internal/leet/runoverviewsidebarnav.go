@@ -6,6 +6,8 @@ const (
 	sectionMaxHeightEnvironment = 12
 	sectionMaxHeightConfig      = 20
 	sectionMaxHeightSummary     = 25
+	sectionMaxHeightNotes       = 8
+	sectionMaxHeightHistograms  = 12
 
 	// Minimum section height when visible (title + 1 item).
 	sectionMinHeight = 2
@@ -50,16 +52,29 @@ func (s *RunOverviewSidebar) availableHeight() int {
 		spacingBetweenSections = activeSections - 1
 	}
 
-	// Ensure minimum space for all active sections.
-	minRequired := activeSections * sectionMinHeight
+	// Ensure minimum space for all active sections; collapsed ones
+	// only need their header line.
+	minRequired := 0
+	for i := range s.sections {
+		switch {
+		case s.showsEmptySection(i):
+			minRequired++
+		case len(s.sections[i].FilteredItems) == 0:
+		case s.isSectionCollapsed(i):
+			minRequired++
+		default:
+			minRequired += sectionMinHeight
+		}
+	}
 	return max(availableHeight-spacingBetweenSections, minRequired)
 }
 
-// countActiveSections returns the number of sections with items.
+// countActiveSections returns the number of sections with items or
+// shown empty.
 func (s *RunOverviewSidebar) countActiveSections() int {
 	count := 0
 	for i := range s.sections {
-		if len(s.sections[i].FilteredItems) > 0 {
+		if len(s.sections[i].FilteredItems) > 0 || s.showsEmptySection(i) {
 			count++
 		}
 	}
@@ -68,12 +83,6 @@ func (s *RunOverviewSidebar) countActiveSections() int {
 
 // calculateDesiredHeights calculates the desired height for each section.
 func (s *RunOverviewSidebar) calculateDesiredHeights() []int {
-	maxHeights := []int{
-		sectionMaxHeightEnvironment,
-		sectionMaxHeightConfig,
-		sectionMaxHeightSummary,
-	}
-
 	desired := make([]int, len(s.sections))
 
 	for i := range s.sections {
@@ -81,11 +90,18 @@ func (s *RunOverviewSidebar) calculateDesiredHeights() []int {
 		if itemCount == 0 {
 			s.sections[i].Height = 0
 			desired[i] = 0
+			if s.showsEmptySection(i) {
+				desired[i] = 1
+			}
+			continue
+		}
+		if s.isSectionCollapsed(i) {
+			desired[i] = 1
 			continue
 		}
 
 		// Desired height is item count + 1 (for title), capped at max.
-		maxHeight := maxHeights[i]
+		maxHeight := s.sections[i].MaxHeight
 		desired[i] = max(min(itemCount+1, maxHeight), sectionMinHeight)
 	}
 
@@ -103,12 +119,26 @@ func (s *RunOverviewSidebar) sumDesiredHeights(desired []int) int {
 
 // scaleHeightsProportionally scales section heights when total exceeds available.
 func (s *RunOverviewSidebar) scaleHeightsProportionally(desired []int, totalAvailable int) {
-	totalDesired := s.sumDesiredHeights(desired)
-	scaleFactor := float64(totalAvailable) / float64(totalDesired)
+	// Collapsed and empty sections keep their single header line; only
+	// the expanded ones are scaled into what remains.
+	collapsed := 0
+	for i := range s.sections {
+		if desired[i] > 0 && s.isHeaderOnly(i) {
+			collapsed++
+		}
+	}
+	totalDesired := s.sumDesiredHeights(desired) - collapsed
+	scaleFactor := 0.0
+	if totalDesired > 0 {
+		scaleFactor = float64(totalAvailable-collapsed) / float64(totalDesired)
+	}
 
 	allocated := 0
 	for i := range s.sections {
-		if desired[i] > 0 {
+		if desired[i] > 0 && s.isHeaderOnly(i) {
+			s.sections[i].Height = 1
+			allocated++
+		} else if desired[i] > 0 {
 			scaled := int(float64(desired[i]) * scaleFactor)
 			// Enforce minimum height for visible sections.
 			if scaled < sectionMinHeight && len(s.sections[i].FilteredItems) > 0 {
@@ -137,18 +167,12 @@ func (s *RunOverviewSidebar) allocateDesiredHeights(desired []int) {
 
 // distributeExtraSpace distributes unused space to sections that can use it.
 func (s *RunOverviewSidebar) distributeExtraSpace(totalAvailable, totalDesired int) {
-	maxHeights := []int{
-		sectionMaxHeightEnvironment,
-		sectionMaxHeightConfig,
-		sectionMaxHeightSummary,
-	}
-
 	extraSpace := totalAvailable - totalDesired
 
-	// Try to expand sections from bottom to top (summary, config, env).
-	for i := 2; i >= 0 && extraSpace > 0; i-- {
+	// Try to expand sections from bottom to top (notes, summary, config, env).
+	for i := len(s.sections) - 1; i >= 0 && extraSpace > 0; i-- {
 		section := &s.sections[i]
-		if section.Height == 0 {
+		if section.Height == 0 || s.isSectionCollapsed(i) {
 			continue
 		}
 
@@ -157,7 +181,7 @@ func (s *RunOverviewSidebar) distributeExtraSpace(totalAvailable, totalDesired i
 
 		// Only expand if we have more items to show.
 		if currentItems < itemCount {
-			maxIncrease := min(maxHeights[i]-section.Height, itemCount+1-section.Height)
+			maxIncrease := min(section.MaxHeight-section.Height, itemCount+1-section.Height)
 			increase := min(maxIncrease, extraSpace)
 
 			section.Height += increase
@@ -169,8 +193,9 @@ func (s *RunOverviewSidebar) distributeExtraSpace(totalAvailable, totalDesired i
 // allocateRemainder distributes remaining space to the last section with items.
 func (s *RunOverviewSidebar) allocateRemainder(remainder int) {
 	// Try sections from bottom to top.
-	for i := 2; i >= 0; i-- {
-		if len(s.sections[i].FilteredItems) > 0 && s.sections[i].Height > 0 {
+	for i := len(s.sections) - 1; i >= 0; i-- {
+		if len(s.sections[i].FilteredItems) > 0 && s.sections[i].Height > 0 &&
+			!s.isSectionCollapsed(i) {
 			s.sections[i].Height += remainder
 			return
 		}
@@ -191,7 +216,7 @@ func (s *RunOverviewSidebar) updateItemsPerPage() {
 
 // navigateUp moves cursor up within the active section.
 func (s *RunOverviewSidebar) navigateUp() {
-	if !s.isValidActiveSection() {
+	if !s.isValidActiveSection() || s.isSectionCollapsed(s.activeSection) {
 		return
 	}
 
@@ -201,7 +226,7 @@ func (s *RunOverviewSidebar) navigateUp() {
 
 // navigateDown moves cursor down within the active section.
 func (s *RunOverviewSidebar) navigateDown() {
-	if !s.isValidActiveSection() {
+	if !s.isValidActiveSection() || s.isSectionCollapsed(s.activeSection) {
 		return
 	}
 
@@ -243,9 +268,41 @@ func (s *RunOverviewSidebar) navigateSection(direction int) {
 	s.sections[prev].Active = true
 }
 
+// overviewQuickViews lists the section titles the quick-view key cycles
+// through, in order.
+var overviewQuickViews = []string{"Summary", "Config"}
+
+// nextQuickViewSection returns the index of the quick-view section that
+// follows the active one, skipping empty sections.
+//
+// When focus is outside the quick views, the first non-empty one is
+// returned. Returns -1 if all quick views are empty.
+func (s *RunOverviewSidebar) nextQuickViewSection(focused bool) int {
+	start := 0
+	if focused && s.isValidActiveSection() {
+		title := s.sections[s.activeSection].Title
+		for i, qv := range overviewQuickViews {
+			if qv == title {
+				start = i + 1
+				break
+			}
+		}
+	}
+
+	for i := range len(overviewQuickViews) {
+		title := overviewQuickViews[(start+i)%len(overviewQuickViews)]
+		for idx := range s.sections {
+			if s.sections[idx].Title == title && len(s.sections[idx].FilteredItems) > 0 {
+				return idx
+			}
+		}
+	}
+	return -1
+}
+
 // navigatePageUp changes page to previous within active section.
 func (s *RunOverviewSidebar) navigatePageUp() {
-	if !s.isValidActiveSection() {
+	if !s.isValidActiveSection() || s.isSectionCollapsed(s.activeSection) {
 		return
 	}
 
@@ -255,7 +312,7 @@ func (s *RunOverviewSidebar) navigatePageUp() {
 
 // navigatePageDown changes page to next within active section.
 func (s *RunOverviewSidebar) navigatePageDown() {
-	if !s.isValidActiveSection() {
+	if !s.isValidActiveSection() || s.isSectionCollapsed(s.activeSection) {
 		return
 	}
 
@@ -265,7 +322,7 @@ func (s *RunOverviewSidebar) navigatePageDown() {
 
 // navigateHome jumps to the first item of the active section.
 func (s *RunOverviewSidebar) navigateHome() {
-	if !s.isValidActiveSection() {
+	if !s.isValidActiveSection() || s.isSectionCollapsed(s.activeSection) {
 		return
 	}
 
@@ -275,7 +332,7 @@ func (s *RunOverviewSidebar) navigateHome() {
 
 // navigateEnd jumps to the last item of the active section.
 func (s *RunOverviewSidebar) navigateEnd() {
-	if !s.isValidActiveSection() {
+	if !s.isValidActiveSection() || s.isSectionCollapsed(s.activeSection) {
 		return
 	}
 
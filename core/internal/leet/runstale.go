@@ -0,0 +1,53 @@
+package leet
+
+import (
+	"time"
+
+	"charm.land/lipgloss/v2"
+)
+
+// staleRunAfter is how long a live-streamed run may go without writing a
+// record before it is shown as stale.
+const staleRunAfter = 10 * time.Minute
+
+// staleRunMark prefixes the name of a stale run in the run list.
+const staleRunMark = "⧗ "
+
+// isStale reports whether the run is still running and streamed but has
+// not written a record within staleRunAfter of now.
+func (r *WorkspaceRun) isStale(now time.Time) bool {
+	return r.state == RunStateRunning &&
+		r.watcher != nil &&
+		!r.lastRecordAt.IsZero() &&
+		now.Sub(r.lastRecordAt) >= staleRunAfter
+}
+
+// syncStaleRuns marks running runs that went quiet as stale in their
+// overview, and returns them to running once they write again.
+//
+// The run itself stays in RunStateRunning so it keeps being read.
+func (w *Workspace) syncStaleRuns(now time.Time) {
+	for key, run := range w.runsByKey {
+		ro := w.runOverview[key]
+		if run == nil || ro == nil {
+			continue
+		}
+		switch state := ro.State(); {
+		case run.isStale(now):
+			ro.SetRunState(RunStateStale)
+		case state == RunStateStale:
+			ro.SetRunState(run.state)
+		}
+	}
+}
+
+// isRunStale reports whether runKey is shown as stale.
+func (w *Workspace) isRunStale(runKey string) bool {
+	ro := w.runOverview[runKey]
+	return ro != nil && ro.State() == RunStateStale
+}
+
+// staleRunStyle returns the configured style of stale run markers.
+func (w *Workspace) staleRunStyle(style lipgloss.Style) lipgloss.Style {
+	return style.Foreground(lipgloss.Color(w.config.StaleRunColor()))
+}
@@ -0,0 +1,199 @@
+package leet
+
+import (
+	"errors"
+	"io"
+	"maps"
+	"os"
+	"time"
+
+	"github.com/wandb/wandb/core/internal/observability"
+	"github.com/wandb/wandb/core/internal/transactionlog"
+	spb "github.com/wandb/wandb/core/pkg/service_go_proto"
+)
+
+const (
+	// runStatusTailBytes is how much of the end of a .wandb file the run
+	// status scan reads. The exit record is among the last records a run
+	// writes, so the tail is enough to find it.
+	runStatusTailBytes = 64 * 1024
+
+	// runStatusBlockSize is the .wandb file's block size; the scan starts
+	// at a block boundary so that the reader can resync on the next record.
+	runStatusBlockSize = 32 * 1024
+
+	// maxRunStatusRecords bounds the records read by one status scan.
+	maxRunStatusRecords = 10_000
+)
+
+// Badges shown after a selected run's name in the runs list.
+const (
+	liveRunBadge   = " •"
+	failedRunBadge = " ✗"
+)
+
+// runStatus is a run's state as seen by the cheap status scan.
+type runStatus struct {
+	state   RunState
+	modTime time.Time // of the .wandb file when scanned
+}
+
+// final reports whether the run's state can no longer change.
+func (s runStatus) final() bool {
+	return s.state == RunStateFinished || s.state == RunStateFailed
+}
+
+// scanRunStatuses returns the status of each run whose .wandb file changed
+// since the cached scan and which has not already finished.
+func scanRunStatuses(
+	wandbDir string,
+	runKeys []string,
+	cached map[string]runStatus,
+	now time.Time,
+) map[string]runStatus {
+	statuses := make(map[string]runStatus)
+	for _, runKey := range runKeys {
+		path := runWandbFile(wandbDir, runKey)
+		if path == "" {
+			continue
+		}
+		info, err := os.Stat(path)
+		if err != nil {
+			continue
+		}
+
+		prev, ok := cached[runKey]
+		if ok && prev.final() {
+			continue
+		}
+
+		// An unchanged file has no new exit record, so it keeps its last
+		// scanned state; only its liveness can have changed.
+		status := prev
+		if !ok || !prev.modTime.Equal(info.ModTime()) {
+			status = runStatus{state: scanRunState(path), modTime: info.ModTime()}
+		}
+		if !status.final() {
+			status.state = RunStateUnknown
+			if now.Sub(info.ModTime()) < runLiveWindow {
+				status.state = RunStateRunning
+			}
+		}
+		if ok && prev == status {
+			continue
+		}
+		statuses[runKey] = status
+	}
+	return statuses
+}
+
+// scanRunState reads the tail of a .wandb file for the run's exit record.
+//
+// It returns RunStateUnknown if the run has not exited or the file
+// cannot be read.
+func scanRunState(path string) RunState {
+	info, err := os.Stat(path)
+	if err != nil {
+		return RunStateUnknown
+	}
+
+	reader, err := transactionlog.OpenReader(path, observability.NewNoOpLogger())
+	if err != nil {
+		return RunStateUnknown
+	}
+	defer reader.Close()
+
+	if offset := info.Size() - runStatusTailBytes; offset > 0 {
+		offset -= offset % runStatusBlockSize
+		if err := reader.SeekRecord(offset); err != nil {
+			return RunStateUnknown
+		}
+	}
+
+	state := RunStateUnknown
+	for range maxRunStatusRecords {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+			break
+		}
+		if err != nil {
+			// The first record after a seek may be a partial one.
+			continue
+		}
+		if exit, ok := record.RecordType.(*spb.Record_Exit); ok {
+			state = RunStateFinished
+			if exit.Exit.GetExitCode() != 0 {
+				state = RunStateFailed
+			}
+		}
+	}
+	return state
+}
+
+// mergeRunStatuses updates the status cache with a scan's results and
+// drops runs that are no longer listed.
+func (w *Workspace) mergeRunStatuses(runKeys []string, statuses map[string]runStatus) {
+	if w.runStatuses == nil {
+		w.runStatuses = make(map[string]runStatus)
+	}
+	maps.Copy(w.runStatuses, statuses)
+
+	present := make(map[string]struct{}, len(runKeys))
+	for _, runKey := range runKeys {
+		present[runKey] = struct{}{}
+	}
+	maps.DeleteFunc(w.runStatuses, func(runKey string, _ runStatus) bool {
+		_, ok := present[runKey]
+		return !ok
+	})
+}
+
+// runListState returns the state used to color a run's mark in the runs
+// list: the loaded run's state when known, else the last status scan.
+func (w *Workspace) runListState(runKey string) RunState {
+	if run := w.runsByKey[runKey]; run != nil && run.state != RunStateUnknown {
+		return run.state
+	}
+	return w.runStatuses[runKey].state
+}
+
+// runListMarkColor returns the color of a run's mark in the runs list.
+//
+// Selected runs use their chart color; other runs show their state.
+func (w *Workspace) runListMarkColor(runKey string) AdaptiveColor {
+	color := w.runColorForKey(runKey)
+	if w.selectedRuns[runKey] || w.pinnedRun == runKey {
+		return color
+	}
+	return runStateMarkColor(w.runListState(runKey), color)
+}
+
+// runStateBadge returns the badge shown after a selected run's name in
+// the runs list, and its color.
+//
+// Selected runs' marks carry their chart color, so the badge is what
+// tells a live run from a finished one. Finished and unknown runs get none.
+func runStateBadge(state RunState) (string, AdaptiveColor) {
+	switch state {
+	case RunStateRunning:
+		return liveRunBadge, colorRunRunning
+	case RunStateFailed, RunStateCrashed:
+		return failedRunBadge, colorError
+	default:
+		return "", AdaptiveColor{}
+	}
+}
+
+// runStateMarkColor returns the color of an unselected run's mark.
+func runStateMarkColor(state RunState, fallback AdaptiveColor) AdaptiveColor {
+	switch state {
+	case RunStateRunning:
+		return colorRunRunning
+	case RunStateFinished:
+		return colorSubtle
+	case RunStateFailed, RunStateCrashed:
+		return colorError
+	default:
+		return fallback
+	}
+}
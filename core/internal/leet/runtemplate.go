@@ -0,0 +1,119 @@
+package leet
+
+import "strings"
+
+// runTemplate is a parsed run list label template such as
+// "{id} — {summary.loss}".
+//
+// Placeholders name a run field in braces and "{{" and "}}" stand for
+// literal braces. Rendering only looks fields up, so a template loaded
+// from the config file cannot do anything else.
+type runTemplate []runTemplatePart
+
+// runTemplatePart is either literal text or a field placeholder.
+type runTemplatePart struct {
+	text  string
+	field string
+}
+
+// parseRunTemplate parses s into literal text and field placeholders.
+//
+// An unterminated "{" is kept as text.
+func parseRunTemplate(s string) runTemplate {
+	var parts runTemplate
+	var text strings.Builder
+
+	flush := func() {
+		if text.Len() > 0 {
+			parts = append(parts, runTemplatePart{text: text.String()})
+			text.Reset()
+		}
+	}
+
+	for len(s) > 0 {
+		switch {
+		case strings.HasPrefix(s, "{{"), strings.HasPrefix(s, "}}"):
+			text.WriteByte(s[0])
+			s = s[2:]
+		case s[0] == '{':
+			end := strings.IndexByte(s, '}')
+			if end < 0 {
+				text.WriteString(s)
+				s = ""
+				continue
+			}
+			flush()
+			parts = append(parts, runTemplatePart{field: strings.TrimSpace(s[1:end])})
+			s = s[end+1:]
+		default:
+			text.WriteByte(s[0])
+			s = s[1:]
+		}
+	}
+	flush()
+
+	return parts
+}
+
+// render expands the template, filling each placeholder with lookup.
+func (t runTemplate) render(lookup func(field string) string) string {
+	var b strings.Builder
+	for _, p := range t {
+		if p.field == "" {
+			b.WriteString(p.text)
+			continue
+		}
+		b.WriteString(lookup(p.field))
+	}
+	return b.String()
+}
+
+// runTemplateField returns the value of a run list template field for
+// runKey, or "" if the field is unknown or the run lacks it.
+//
+// Fields are key, id, name, project, entity, state, and summary.<key> or
+// config.<key> with the dotted keys shown in the run overview.
+func (w *Workspace) runTemplateField(runKey, field string) string {
+	ro := w.runOverview[runKey]
+
+	switch field {
+	case "key":
+		return runKey
+	case "id":
+		if ro != nil && ro.ID() != "" {
+			return ro.ID()
+		}
+		return extractRunID(runKey)
+	}
+	if ro == nil {
+		return ""
+	}
+
+	switch field {
+	case "name":
+		return ro.DisplayName()
+	case "project":
+		return ro.Project()
+	case "entity":
+		return ro.Entity()
+	case "state":
+		return ro.StateString()
+	}
+	if key, ok := strings.CutPrefix(field, "summary."); ok {
+		return lookupItem(ro.SummaryItems(), key)
+	}
+	if key, ok := strings.CutPrefix(field, "config."); ok {
+		return lookupItem(ro.ConfigItems(), key)
+	}
+	return ""
+}
+
+// lookupItem returns the value of the item with the given key, or "".
+func lookupItem(items []KeyValuePair, key string) string {
+	for _, item := range items {
+		if item.Key == key {
+			return item.Value
+		}
+	}
+	return ""
+}
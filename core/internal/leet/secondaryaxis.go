@@ -0,0 +1,88 @@
+package leet
+
+import (
+	"charm.land/lipgloss/v2"
+	"github.com/NimbleMarkets/ntcharts/v2/canvas"
+)
+
+// SetSecondary overlays other's series on a right-hand Y axis with its
+// own linear scale, so differently-scaled metrics such as loss and
+// learning rate can share a chart. nil removes the overlay.
+func (c *EpochLineChart) SetSecondary(other *EpochLineChart) {
+	if other == c {
+		other = nil
+	}
+	if c.secondary == other {
+		return
+	}
+	c.secondary = other
+	c.dirty = true
+}
+
+// Secondary returns the chart overlaid on the right-hand Y axis, or nil.
+func (c *EpochLineChart) Secondary() *EpochLineChart { return c.secondary }
+
+// secondaryChanged reports whether the secondary chart gained data
+// since the last draw.
+func (c *EpochLineChart) secondaryChanged() bool {
+	return c.secondary != nil && c.secondary.totalPoints() != c.secondaryPoints
+}
+
+// secondaryYRange returns the padded range of the right-hand axis.
+func (c *EpochLineChart) secondaryYRange() (lo, hi float64, ok bool) {
+	s := c.secondary
+	if s == nil || !isFinite(s.yMin) || !isFinite(s.yMax) {
+		return 0, 0, false
+	}
+	padding := s.calculatePadding(s.yMax - s.yMin)
+	lo, hi = s.yMin-padding, s.yMax+padding
+	if s.yMin >= 0 && lo < 0 {
+		lo = 0
+	}
+	return lo, hi, true
+}
+
+// drawSecondary draws the secondary chart's series and the right-hand
+// axis labels over the graph.
+func (c *EpochLineChart) drawSecondary(graphStartX int, sample bool) {
+	if c.secondary == nil {
+		c.secondaryPoints = 0
+		return
+	}
+	c.secondaryPoints = c.secondary.totalPoints()
+	lo, hi, ok := c.secondaryYRange()
+	if !ok {
+		return
+	}
+
+	axis := yAxis{
+		scale: func(y float64) (float64, bool) { return y, isFinite(y) },
+		min:   lo,
+		max:   hi,
+	}
+	for _, key := range c.secondary.order {
+		c.drawSeries(c.secondary.data[key], secondaryAxisStyle, axis, graphStartX, sample)
+	}
+
+	// Label the top and bottom rows of the right-hand axis.
+	right := graphStartX + c.GraphWidth()
+	for _, tick := range []struct {
+		row   int
+		value float64
+	}{{0, hi}, {c.GraphHeight() - 1, lo}} {
+		s := c.formatSecondaryTick(tick.value)
+		c.Canvas.SetStringWithStyle(
+			canvas.Point{X: right - lipgloss.Width(s), Y: tick.row},
+			s, secondaryAxisStyle,
+		)
+	}
+}
+
+// formatSecondaryTick formats a right-hand axis value in the secondary
+// chart's units.
+func (c *EpochLineChart) formatSecondaryTick(v float64) string {
+	if c.secondary.yTickFormatter != nil {
+		return c.secondary.yTickFormatter(v)
+	}
+	return UnitScalar.Format(v)
+}
@@ -0,0 +1,76 @@
+package leet
+
+import (
+	"math"
+
+	"github.com/NimbleMarkets/ntcharts/v2/canvas"
+	"github.com/NimbleMarkets/ntcharts/v2/canvas/graph"
+)
+
+// maxStepPlotStates is the most distinct values a metric may take to be
+// drawn as a step plot, e.g. a boolean flag or a small categorical code.
+const maxStepPlotStates = 8
+
+// stateTracker records the distinct values a chart has seen while they
+// still look like a small set of discrete states.
+type stateTracker struct {
+	states     map[float64]struct{}
+	continuous bool
+}
+
+// observe records ys, switching to continuous for good once a value is
+// fractional or there are more than maxStepPlotStates distinct values.
+func (t *stateTracker) observe(ys []float64) {
+	if t.continuous {
+		return
+	}
+	for _, y := range ys {
+		if !isFinite(y) {
+			continue
+		}
+		if y != math.Trunc(y) {
+			t.continuous, t.states = true, nil
+			return
+		}
+		if t.states == nil {
+			t.states = make(map[float64]struct{})
+		}
+		t.states[y] = struct{}{}
+		if len(t.states) > maxStepPlotStates {
+			t.continuous, t.states = true, nil
+			return
+		}
+	}
+}
+
+// discrete reports whether every value seen so far is one of a few states.
+func (t *stateTracker) discrete() bool {
+	return !t.continuous && len(t.states) > 0
+}
+
+// ObserveStates feeds newly logged values to the chart's discrete-state
+// detection.
+func (c *EpochLineChart) ObserveStates(ys []float64) {
+	was := c.states.discrete()
+	c.states.observe(ys)
+	if c.states.discrete() != was {
+		c.dirty = true
+	}
+}
+
+// IsStepPlot reports whether the chart draws its series as step plots
+// because its values are a small set of discrete states.
+func (c *EpochLineChart) IsStepPlot() bool {
+	return c.states.discrete()
+}
+
+// drawSteps connects consecutive points with a horizontal run at the
+// earlier value followed by a vertical jump, so that a state holds until
+// the next sample instead of being interpolated.
+func drawSteps(bGrid *graph.BrailleGrid, points []canvas.Float64Point) {
+	for i := range len(points) - 1 {
+		corner := canvas.Float64Point{X: points[i+1].X, Y: points[i].Y}
+		drawLine(bGrid, bGrid.GridPoint(points[i]), bGrid.GridPoint(corner))
+		drawLine(bGrid, bGrid.GridPoint(corner), bGrid.GridPoint(points[i+1]))
+	}
+}
@@ -100,6 +100,8 @@ func getOddRunStyleColor() color.Color {
 // Immutable UI constants.
 const (
 	StatusBarHeight = 1
+	// Height of the single-run view's title bar.
+	RunTitleBarHeight = 1
 	// Horizontal padding for the status bar (left and right).
 	StatusBarPadding = 1
 
@@ -253,6 +255,18 @@ var (
 		Dark:  lipgloss.Color("#d0d0d0"),
 	}
 
+	// Color for errors, such as a failed run's last error line.
+	colorError = AdaptiveColor{
+		Light: lipgloss.Color("#D14D4D"),
+		Dark:  lipgloss.Color("#FF7A7A"),
+	}
+
+	// Color for the runs list mark of a running run that is not selected.
+	colorRunRunning = AdaptiveColor{
+		Light: lipgloss.Color("#3A9A5B"),
+		Dark:  lipgloss.Color("#6FCF8E"),
+	}
+
 	// Color used for the selected line in lists.
 	colorSelected = AdaptiveColor{
 		Dark:  lipgloss.Color("#FCBC32"),
@@ -499,15 +513,18 @@ func colorSchemeOrDefault(scheme, fallback string) []AdaptiveColor {
 // GraphColors returns the palette for the requested scheme.
 //
 // If the scheme is unknown, it falls back to DefaultColorScheme.
+//
+// On terminals with limited color support (see [SetColorProfile]), the
+// palette is downgraded to colors the terminal can show.
 func GraphColors(scheme string) []AdaptiveColor {
-	return colorSchemeOrDefault(scheme, DefaultColorScheme)
+	return categoricalPalette(colorSchemeOrDefault(scheme, DefaultColorScheme))
 }
 
 // FrenchFriesColors returns the palette for the requested French Fries heatmap scheme.
 //
 // If the scheme is unknown, it falls back to DefaultFrenchFriesColorScheme.
 func FrenchFriesColors(scheme string) []AdaptiveColor {
-	return colorSchemeOrDefault(scheme, DefaultFrenchFriesColorScheme)
+	return convertPalette(colorSchemeOrDefault(scheme, DefaultFrenchFriesColorScheme))
 }
 
 // Metrics grid styles.
@@ -523,7 +540,13 @@ var (
 
 	headerContainerStyle = lipgloss.NewStyle()
 
+	// summaryStripStyle is for the pinned run's values in the metrics header.
+	summaryStripStyle = lipgloss.NewStyle().Foreground(colorItemValue)
+
 	gridContainerStyle = lipgloss.NewStyle()
+
+	// idleDimStyle is for charts dimmed after a period without input.
+	idleDimStyle = lipgloss.NewStyle().Foreground(colorSubtle).Faint(true)
 )
 
 // Chart styles.
@@ -544,6 +567,12 @@ var (
 
 	inspectionLineStyle = lipgloss.NewStyle().Foreground(colorSubtle)
 
+	bestPointStyle = lipgloss.NewStyle().Foreground(colorAccent).Bold(true)
+
+	targetLineStyle = lipgloss.NewStyle().Foreground(colorAccent).Faint(true)
+
+	secondaryAxisStyle = lipgloss.NewStyle().Foreground(colorHeading)
+
 	inspectionLegendStyle = lipgloss.NewStyle().
 				Foreground(AdaptiveColor{
 			Light: lipgloss.Color("#111111"),
@@ -563,6 +592,20 @@ var (
 		Padding(0, StatusBarPadding)
 )
 
+// runTitleBarStyle renders the single-run view's title bar.
+var runTitleBarStyle = lipgloss.NewStyle().
+	Foreground(colorText).
+	Padding(0, StatusBarPadding)
+
+// runTitleBarNameStyle highlights the run display name in the title bar.
+var runTitleBarNameStyle = lipgloss.NewStyle().Foreground(colorAccent).Bold(true)
+
+// perfOverlayStyle renders the performance overlay label.
+var perfOverlayStyle = lipgloss.NewStyle().
+	Foreground(moon900).
+	Background(colorAccent).
+	Padding(0, 1)
+
 var errorStyle = lipgloss.NewStyle()
 
 // runOverviewTagLightText is the default (white) foreground for tag badges
@@ -662,6 +705,7 @@ var (
 	runOverviewSidebarSectionStyle    = lipgloss.NewStyle().Foreground(colorText).Bold(true)
 	runOverviewSidebarKeyStyle        = lipgloss.NewStyle().Foreground(colorItemKey)
 	runOverviewSidebarValueStyle      = lipgloss.NewStyle().Foreground(colorItemValue)
+	runOverviewSidebarTypeHintStyle   = lipgloss.NewStyle().Foreground(colorSubtle).Italic(true)
 	runOverviewSidebarHighlightedItem = lipgloss.NewStyle().
 						Foreground(colorDark).Background(colorSelected)
 )
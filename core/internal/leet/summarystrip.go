@@ -0,0 +1,84 @@
+package leet
+
+import "strings"
+
+// summaryStripSep separates the values in the summary strip.
+const summaryStripSep = " · "
+
+// SetSummaryStrip sets the text shown after the metrics header.
+func (mg *MetricsGrid) SetSummaryStrip(s string) {
+	mg.mu.Lock()
+	defer mg.mu.Unlock()
+	mg.summaryStrip = s
+}
+
+// recordLatest remembers the last finite value of each metric in a
+// history batch.
+func (r *WorkspaceRun) recordLatest(msg HistoryMsg) {
+	for key, data := range msg.Metrics {
+		for i := len(data.Y) - 1; i >= 0; i-- {
+			if !isFinite(data.Y[i]) {
+				continue
+			}
+			if r.latest == nil {
+				r.latest = make(map[string]float64)
+			}
+			r.latest[key] = data.Y[i]
+			break
+		}
+	}
+}
+
+// summaryStripRunKey returns the run whose values the summary strip
+// shows: the pinned run, else the only selected run, else "".
+func (w *Workspace) summaryStripRunKey() string {
+	if w.pinnedRun != "" {
+		return w.pinnedRun
+	}
+	if len(w.selectedRuns) != 1 {
+		return ""
+	}
+	for runKey := range w.selectedRuns {
+		return runKey
+	}
+	return ""
+}
+
+// summaryStrip returns the configured summary strip keys with their
+// latest values for the strip's run, or "" if there is nothing to show.
+//
+// A key's value is its last logged history value, else the run's summary
+// value for it, else "-".
+func (w *Workspace) summaryStrip() string {
+	keys := w.config.SummaryStripKeys()
+	runKey := w.summaryStripRunKey()
+	if len(keys) == 0 || runKey == "" {
+		return ""
+	}
+
+	run := w.runsByKey[runKey]
+	ro := w.runOverview[runKey]
+
+	parts := make([]string, 0, len(keys))
+	for _, key := range keys {
+		value := "-"
+		if v, ok := run.latestValue(key); ok {
+			value = formatSigFigs(v, 4)
+		} else if ro != nil {
+			if s := lookupItem(ro.SummaryItems(), key); s != "" {
+				value = s
+			}
+		}
+		parts = append(parts, key+" "+value)
+	}
+	return strings.Join(parts, summaryStripSep)
+}
+
+// latestValue returns the last value the run logged for key.
+func (r *WorkspaceRun) latestValue(key string) (float64, bool) {
+	if r == nil {
+		return 0, false
+	}
+	v, ok := r.latest[key]
+	return v, ok
+}
@@ -0,0 +1,40 @@
+package leet
+
+import (
+	"fmt"
+	"strings"
+)
+
+// sweepProgressBarWidth is the width in cells of the sweep progress bar.
+const sweepProgressBarWidth = 10
+
+// sweepProgressStatus summarizes how many listed runs are done, e.g.
+// "███░░ 3/5 done, 1 running", when Config.SweepProgress is on.
+//
+// Finished, failed and crashed runs count as done.
+func (w *Workspace) sweepProgressStatus() string {
+	if !w.config.SweepProgress() || len(w.runs.Items) == 0 {
+		return ""
+	}
+
+	var done, running int
+	for _, item := range w.runs.Items {
+		switch w.runListState(item.Key) {
+		case RunStateFinished, RunStateFailed, RunStateCrashed:
+			done++
+		case RunStateRunning:
+			running++
+		}
+	}
+
+	total := len(w.runs.Items)
+	filled := done * sweepProgressBarWidth / total
+	bar := strings.Repeat("█", filled) +
+		strings.Repeat("░", sweepProgressBarWidth-filled)
+
+	status := fmt.Sprintf("%s %d/%d done", bar, done, total)
+	if running > 0 {
+		status += fmt.Sprintf(", %d running", running)
+	}
+	return status
+}
@@ -66,6 +66,8 @@ func NewSymon(params SymonParams) *Symon {
 		cfg = NewConfigManager(leetConfigPath(), logger)
 	}
 
+	applyColorProfile(cfg)
+
 	ctx, cancel := context.WithCancel(context.Background())
 	focus := NewFocus()
 	rows, cols := cfg.SymonGrid()
@@ -120,6 +122,9 @@ func (s *Symon) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	if handled, cmd := s.handleRestart(msg); handled {
 		return s, cmd
 	}
+	if handled, cmd := s.handleFreezeAnimations(msg); handled {
+		return s, cmd
+	}
 
 	switch msg := msg.(type) {
 	case tea.KeyPressMsg:
@@ -228,6 +233,18 @@ func (s *Symon) handleRestart(msg tea.Msg) (bool, tea.Cmd) {
 	return true, tea.Quit
 }
 
+// handleFreezeAnimations toggles the global animation freeze on alt+a.
+func (s *Symon) handleFreezeAnimations(msg tea.Msg) (bool, tea.Cmd) {
+	km, ok := msg.(tea.KeyPressMsg)
+	if !ok || km.String() != "alt+a" {
+		return false, nil
+	}
+
+	SetAnimationsFrozen(!AnimationsFrozen())
+	s.logger.Debug(fmt.Sprintf("symon: animations frozen: %v", AnimationsFrozen()))
+	return true, nil
+}
+
 func (s *Symon) handleQuit(tea.KeyPressMsg) tea.Cmd {
 	return tea.Quit
 }
@@ -273,6 +290,11 @@ func (s *Symon) handleCycleFocusedChartMode(tea.KeyPressMsg) tea.Cmd {
 	return nil
 }
 
+func (s *Symon) handleToggleSystemMetricsAggregation(tea.KeyPressMsg) tea.Cmd {
+	s.grid.ToggleAggregation()
+	return nil
+}
+
 func (s *Symon) handleToggleFocusedChartLogY(msg tea.KeyPressMsg) tea.Cmd {
 	return s.handleCycleFocusedChartMode(msg)
 }
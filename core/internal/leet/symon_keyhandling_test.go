@@ -109,3 +109,60 @@ func TestSymon_KeyYCyclesFocusedChartMode(t *testing.T) {
 	_, _ = s.Update(tea.KeyPressMsg{Code: 'y', Text: "y"})
 	require.Equal(t, "", grid.FocusedChartScaleLabel())
 }
+
+func TestSymon_KeyMTogglesMeanAcrossDevices(t *testing.T) {
+	logger := observability.NewNoOpLogger()
+	cfg := leet.NewConfigManager(filepath.Join(t.TempDir(), "config.json"), logger)
+	_ = cfg.SetSymonRows(1)
+	_ = cfg.SetSymonCols(1)
+
+	s := leet.NewSymon(leet.SymonParams{Config: cfg, Logger: logger})
+	defer s.Cleanup()
+
+	_, _ = s.Update(tea.WindowSizeMsg{Width: 140, Height: 45})
+
+	base := time.Now().Unix()
+	grid := s.TestGrid()
+	for i := range int64(3) {
+		grid.AddDataPoint("gpu.0.temp", base+i, 40)
+		grid.AddDataPoint("gpu.1.temp", base+i, 60)
+	}
+	chart := grid.TestChartAt(0, 0)
+	require.NotNil(t, chart)
+	require.Equal(t, []string{"GPU 0", "GPU 1"}, chart.DrawOrder())
+	require.Equal(t, "[2]", chart.TitleDetail())
+
+	_, _ = s.Update(tea.KeyPressMsg{Code: 'M', Text: "M"})
+	require.True(t, grid.IsAggregated())
+	require.Len(t, chart.DrawOrder(), 1, "aggregated chart draws a single mean series")
+	require.Equal(t, "[mean of 2]", chart.TitleDetail())
+
+	// New samples keep updating the mean while aggregated.
+	grid.AddDataPoint("gpu.0.temp", base+3, 50)
+	grid.AddDataPoint("gpu.1.temp", base+3, 70)
+	chart.InspectAtDataX(float64(base + 3))
+	_, y, active := chart.InspectionData()
+	require.True(t, active)
+	require.InDelta(t, 60.0, y, 1e-9)
+	chart.EndInspection()
+
+	_, _ = s.Update(tea.KeyPressMsg{Code: 'M', Text: "M"})
+	require.False(t, grid.IsAggregated())
+	require.Equal(t, []string{"GPU 0", "GPU 1"}, chart.DrawOrder())
+	require.Equal(t, "[2]", chart.TitleDetail())
+}
+
+func TestSymon_AltATogglesAnimationFreeze(t *testing.T) {
+	t.Cleanup(func() { leet.SetAnimationsFrozen(false) })
+	logger := observability.NewNoOpLogger()
+	cfg := leet.NewConfigManager(filepath.Join(t.TempDir(), "config.json"), logger)
+
+	s := leet.NewSymon(leet.SymonParams{Config: cfg, Logger: logger})
+	defer s.Cleanup()
+
+	altA := tea.KeyPressMsg{Code: 'a', Mod: tea.ModAlt}
+	_, _ = s.Update(altA)
+	require.True(t, leet.AnimationsFrozen())
+	_, _ = s.Update(altA)
+	require.False(t, leet.AnimationsFrozen())
+}
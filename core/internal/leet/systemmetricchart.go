@@ -28,4 +28,5 @@ type systemMetricChart interface {
 	IsInspecting() bool
 	InspectionData() (x, y float64, active bool)
 	InspectAtDataX(targetX float64)
+	SetAggregated(on bool)
 }
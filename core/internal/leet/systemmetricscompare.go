@@ -0,0 +1,134 @@
+package leet
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	tea "charm.land/bubbletea/v2"
+)
+
+// systemMetricStats tracks the latest and peak values of one system chart.
+//
+// Multi-device charts (e.g. one series per GPU) report their busiest
+// device: the peak is the maximum over all samples and the latest value
+// is the maximum over each series' latest sample.
+type systemMetricStats struct {
+	unit   UnitFormatter
+	peak   float64
+	latest map[string]timedValue
+}
+
+// timedValue is a sample with its Unix timestamp in seconds.
+type timedValue struct {
+	timestamp int64
+	value     float64
+}
+
+// record adds a sample of the named series.
+func (s *systemMetricStats) record(seriesName string, timestamp int64, value float64) {
+	if !isFinite(value) {
+		return
+	}
+	if len(s.latest) == 0 || value > s.peak {
+		s.peak = value
+	}
+	if prev, ok := s.latest[seriesName]; !ok || timestamp >= prev.timestamp {
+		s.latest[seriesName] = timedValue{timestamp, value}
+	}
+}
+
+// Latest returns the largest of the series' latest values.
+func (s *systemMetricStats) Latest() float64 {
+	var latest float64
+	first := true
+	for _, tv := range s.latest {
+		if first || tv.value > latest {
+			latest, first = tv.value, false
+		}
+	}
+	return latest
+}
+
+// recordStats updates the stats of the chart titled title.
+func (g *SystemMetricsGrid) recordStats(
+	def *MetricDef,
+	title, seriesName string,
+	timestamp int64,
+	value float64,
+) {
+	s := g.stats[title]
+	if s == nil {
+		s = &systemMetricStats{unit: def.Unit, latest: make(map[string]timedValue)}
+		g.stats[title] = s
+	}
+	s.record(seriesName, timestamp, value)
+}
+
+// MetricStats returns the latest and peak values of the chart titled
+// title, formatted in its unit, or ok=false if it has no samples.
+func (g *SystemMetricsGrid) MetricStats(title string) (latest, peak string, ok bool) {
+	s := g.stats[title]
+	if s == nil || len(s.latest) == 0 {
+		return "", "", false
+	}
+	return s.unit.Format(s.Latest()), s.unit.Format(s.peak), true
+}
+
+// handleToggleSystemMetricCompare switches the console logs pane between
+// the current run's logs and a table of the focused system chart's latest
+// and peak values across selected runs, opening the pane if needed.
+func (w *Workspace) handleToggleSystemMetricCompare(msg tea.KeyPressMsg) tea.Cmd {
+	if w.systemCompareTitle != "" {
+		w.systemCompareTitle = ""
+		return nil
+	}
+
+	title := w.systemMetricsFocus.Title
+	if w.systemMetricsFocus.Type != FocusSystemChart || title == "" {
+		w.setRunNotice("Focus a system metrics chart to compare it across runs")
+		return nil
+	}
+	w.systemCompareTitle = title
+	w.consoleErrorsView = false
+	w.consoleLogsPane.ScrollToEnd()
+	if !w.consoleLogsPane.animState.TargetVisible() {
+		return w.handleToggleConsoleLogsPane(msg)
+	}
+	return nil
+}
+
+// systemCompareItems returns one row per selected run with the latest and
+// peak values of the compared system chart, ordered by run label.
+func (w *Workspace) systemCompareItems() []KeyValuePair {
+	type row struct{ label, latest, peak string }
+
+	var rows []row
+	for runKey := range w.selectedRuns {
+		g := w.systemMetrics[runKey]
+		if g == nil {
+			continue
+		}
+		latest, peak, ok := g.MetricStats(w.systemCompareTitle)
+		if !ok {
+			continue
+		}
+		rows = append(rows, row{w.shortRunLabel(runKey), latest, peak})
+	}
+	slices.SortFunc(rows, func(a, b row) int { return strings.Compare(a.label, b.label) })
+
+	labelWidth, latestWidth := 0, 0
+	for _, r := range rows {
+		labelWidth = max(labelWidth, len(r.label))
+		latestWidth = max(latestWidth, len(r.latest))
+	}
+
+	items := make([]KeyValuePair, len(rows))
+	for i, r := range rows {
+		items[i] = KeyValuePair{
+			Value: fmt.Sprintf("%-*s  latest %-*s  peak %s",
+				labelWidth, r.label, latestWidth, r.latest, r.peak),
+		}
+	}
+	return items
+}
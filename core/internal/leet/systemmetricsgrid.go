@@ -2,7 +2,9 @@ package leet
 
 import (
 	"fmt"
+	"math"
 	"sort"
+	"strconv"
 	"time"
 
 	"charm.land/lipgloss/v2"
@@ -43,6 +45,17 @@ type SystemMetricsGrid struct {
 
 	// synchronized inspection session state (active only between press/release)
 	syncInspectActive bool
+
+	// lastInspected is the point under the crosshair when inspection last
+	// ended, kept so that it can be copied afterwards.
+	lastInspected    InspectedPoint
+	hasLastInspected bool
+
+	// aggregated shows multi-series charts as the mean across devices.
+	aggregated bool
+
+	// stats holds the latest and peak values of each chart by title.
+	stats map[string]*systemMetricStats
 }
 
 func NewSystemMetricsGrid(
@@ -57,6 +70,7 @@ func NewSystemMetricsGrid(
 		config:     config,
 		gridConfig: gridConfig,
 		byBaseKey:  make(map[string]systemMetricChart),
+		stats:      make(map[string]*systemMetricStats),
 		ordered:    make([]systemMetricChart, 0),
 		filtered:   make([]systemMetricChart, 0),
 		filter:     filter,
@@ -159,6 +173,8 @@ func (g *SystemMetricsGrid) createMetricChart(def *MetricDef) systemMetricChart
 		Now:           now,
 	})
 	lineChart.SetTailWindow(g.config.SystemTailWindow())
+	maxPoints := g.config.SystemMetricsMaxPoints()
+	lineChart.SetMaxPoints(maxPoints)
 
 	if !def.Percentage {
 		return lineChart
@@ -171,6 +187,7 @@ func (g *SystemMetricsGrid) createMetricChart(def *MetricDef) systemMetricChart
 		Colors: FrenchFriesColors(g.config.FrenchFriesColorScheme()),
 		Now:    now,
 	})
+	frenchFriesChart.SetMaxSamples(maxPoints)
 	return newFrenchFriesToggleChart(lineChart, frenchFriesChart)
 }
 
@@ -224,6 +241,7 @@ func (g *SystemMetricsGrid) addDataPoint(
 
 	chart, created := g.getOrCreateChart(baseKey, def)
 	chart.AddDataPoint(seriesName, timestamp, value)
+	g.recordStats(def, chart.Title(), seriesName, timestamp, value)
 	return created
 }
 
@@ -236,6 +254,9 @@ func (g *SystemMetricsGrid) getOrCreateChart(
 	if !exists {
 		g.logger.Debug(fmt.Sprintf("systemmetricsgrid: creating new chart for baseKey=%s", baseKey))
 		chart = g.createMetricChart(def)
+		if g.aggregated {
+			chart.SetAggregated(true)
+		}
 		g.byBaseKey[baseKey] = chart
 		g.addChart(chart)
 		return chart, true
@@ -759,6 +780,19 @@ func chartHeaderExtras(chart systemMetricChart) systemMetricHeaderExtras {
 	return extras
 }
 
+// ToggleAggregation flips multi-series charts between per-device series
+// and the mean across devices.
+func (g *SystemMetricsGrid) ToggleAggregation() {
+	g.aggregated = !g.aggregated
+	for _, chart := range g.ordered {
+		chart.SetAggregated(g.aggregated)
+	}
+	g.drawVisible()
+}
+
+// IsAggregated reports whether multi-series charts show the device mean.
+func (g *SystemMetricsGrid) IsAggregated() bool { return g.aggregated }
+
 // ChartCount returns the number of charts on the grid.
 func (g *SystemMetricsGrid) ChartCount() int {
 	return len(g.ordered)
@@ -885,6 +919,10 @@ func (g *SystemMetricsGrid) UpdateInspection(adjustedX, adjustedY, row, col int,
 // If a synchronized session is active, clears inspection on all visible charts;
 // otherwise clears only the focused chart.
 func (g *SystemMetricsGrid) EndInspection() {
+	if p, ok := g.InspectedPoint(); ok {
+		g.lastInspected, g.hasLastInspected = p, true
+	}
+
 	if g.syncInspectActive {
 		g.broadcastEndInspection()
 		g.syncInspectActive = false
@@ -899,6 +937,43 @@ func (g *SystemMetricsGrid) EndInspection() {
 	chart.DrawIfNeeded()
 }
 
+// InspectedPoint is a (timestamp, value) sample read off a system chart.
+type InspectedPoint struct {
+	Title     string
+	Timestamp time.Time
+	Value     float64
+}
+
+// String formats the point as "<RFC3339 timestamp>, <value>".
+func (p InspectedPoint) String() string {
+	return fmt.Sprintf("%s, %s",
+		p.Timestamp.Format(time.RFC3339), strconv.FormatFloat(p.Value, 'g', -1, 64))
+}
+
+// InspectedPoint returns the point under the focused chart's inspection
+// crosshair.
+func (g *SystemMetricsGrid) InspectedPoint() (InspectedPoint, bool) {
+	chart := g.focusedChart()
+	if chart == nil {
+		return InspectedPoint{}, false
+	}
+	x, y, active := chart.InspectionData()
+	if !active {
+		return InspectedPoint{}, false
+	}
+	return InspectedPoint{
+		Title:     chart.Title(),
+		Timestamp: time.Unix(int64(math.Round(x)), 0).UTC(),
+		Value:     y,
+	}, true
+}
+
+// LastInspectedPoint returns the point that was under the crosshair when
+// inspection last ended.
+func (g *SystemMetricsGrid) LastInspectedPoint() (InspectedPoint, bool) {
+	return g.lastInspected, g.hasLastInspected
+}
+
 // broadcastInspectAtDataX applies InspectAtDataX to all visible charts on the current page.
 func (g *SystemMetricsGrid) broadcastInspectAtDataX(anchorX float64) {
 	for row := range g.currentPage {
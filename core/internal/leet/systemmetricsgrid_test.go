@@ -149,3 +149,40 @@ func TestSystemMetricsGrid_NavigateWithPowerMetrics(t *testing.T) {
 	grid.Navigate(1)
 	require.NotEmpty(t, grid.FocusedChartTitle(), "first chart should be focused after navigation")
 }
+
+func TestSystemMetricsGrid_MaxPoints_KeepsNewestPoints(t *testing.T) {
+	logger := observability.NewNoOpLogger()
+	cfg := leet.NewConfigManager(filepath.Join(t.TempDir(), "config.json"), logger)
+	_, _ = cfg.SetSystemRows(1), cfg.SetSystemCols(1)
+	require.NoError(t, cfg.SetSystemMetricsMaxPoints(5))
+
+	grid := leet.NewSystemMetricsGrid(
+		2*leet.MinMetricChartWidth, 2*leet.MinMetricChartHeight,
+		cfg, cfg.SystemGrid, leet.NewFocus(), leet.NewFilter(), logger)
+
+	ts := time.Now().Unix()
+	for i := range 12 {
+		grid.AddDataPoint("gpu.0.temp", ts+int64(i), 40+float64(i))
+	}
+	grid.LoadCurrentPage()
+
+	chart := grid.TestChartAt(0, 0)
+	require.NotNil(t, chart)
+	order := chart.DrawOrder()
+	require.Len(t, order, 1)
+	require.Equal(t,
+		[]float64{
+			float64(ts + 7), float64(ts + 8), float64(ts + 9),
+			float64(ts + 10), float64(ts + 11),
+		},
+		chart.TestSeriesX(order[0]))
+
+	// Bounds follow the retained window, not the dropped points.
+	xMin, xMax, yMin, yMax := chart.TestBounds()
+	require.Equal(t, float64(ts+7), xMin)
+	require.Equal(t, float64(ts+11), xMax)
+	require.Equal(t, 47.0, yMin)
+	require.Equal(t, 51.0, yMax)
+	minValue, _ := chart.ValueBounds()
+	require.Equal(t, 47.0, minValue)
+}
@@ -0,0 +1,59 @@
+package leet
+
+import "github.com/NimbleMarkets/ntcharts/v2/canvas"
+
+// targetLineRune draws a metric's target line across its chart.
+const targetLineRune = '┄'
+
+// SetTarget sets the metric's goal value, drawn as a horizontal line.
+//
+// The Y range grows to keep the target in view. ok false clears it.
+func (c *EpochLineChart) SetTarget(target float64, ok bool) {
+	ok = ok && isFinite(target)
+	if c.hasTarget == ok && (!ok || c.target == target) {
+		return
+	}
+	c.target, c.hasTarget = target, ok
+	c.updateRanges()
+	c.dirty = true
+}
+
+// Target returns the metric's goal value, if one is set.
+func (c *EpochLineChart) Target() (float64, bool) {
+	return c.target, c.hasTarget
+}
+
+// withTarget widens the [lo, hi] value range to include the target.
+func (c *EpochLineChart) withTarget(lo, hi float64) (float64, float64) {
+	if !c.hasTarget {
+		return lo, hi
+	}
+	return min(lo, c.target), max(hi, c.target)
+}
+
+// drawTargetLine draws the target line under the series if it is in view.
+func (c *EpochLineChart) drawTargetLine(graphStartX int) {
+	if !c.hasTarget {
+		return
+	}
+	yValue, ok := c.scaleYValue(c.target)
+	if !ok {
+		return
+	}
+	yRange := c.ViewMaxY() - c.ViewMinY()
+	if yRange <= 0 {
+		return
+	}
+	y := (yValue - c.ViewMinY()) / yRange * float64(c.GraphHeight())
+	if y < 0 || y > float64(c.GraphHeight()) {
+		return
+	}
+
+	row := max(c.GraphHeight()-1-int(y), 0)
+	for col := range c.GraphWidth() {
+		c.Canvas.SetCell(
+			canvas.Point{X: graphStartX + col, Y: row},
+			canvas.NewCellWithStyle(targetLineRune, targetLineStyle),
+		)
+	}
+}
@@ -9,6 +9,7 @@ import (
 	tea "charm.land/bubbletea/v2"
 
 	"github.com/wandb/wandb/core/internal/observability"
+	"github.com/wandb/wandb/core/internal/watcher"
 	spb "github.com/wandb/wandb/core/pkg/service_go_proto"
 )
 
@@ -282,6 +283,24 @@ func (c *EpochLineChart) TestBounds() (xMin, xMax, yMin, yMax float64) {
 	return c.xMin, c.xMax, c.yMin, c.yMax
 }
 
+// TestSecondaryBounds exposes the padded right-hand axis range for testing.
+func (c *EpochLineChart) TestSecondaryBounds() (yMin, yMax float64, ok bool) {
+	return c.secondaryYRange()
+}
+
+// TestSeriesX returns the X values of the series with the given key.
+func (c *EpochLineChart) TestSeriesX(key string) []float64 {
+	if s, ok := c.data[key]; ok {
+		return s.X
+	}
+	return nil
+}
+
+// TestDrawnPoints returns the number of points plotted by the last draw.
+func (c *EpochLineChart) TestDrawnPoints() int {
+	return c.drawnPoints
+}
+
 // TestIsLogY reports whether the chart is using logarithmic Y scaling.
 func (c *EpochLineChart) TestIsLogY() bool {
 	return c.IsLogY()
@@ -292,6 +311,16 @@ func (c *EpochLineChart) TestFormatYTick(v float64) string {
 	return c.formatYTick(v)
 }
 
+// TestFormatXTick exposes X-axis label formatting for focused tests.
+func (c *EpochLineChart) TestFormatXTick(v float64) string {
+	return c.formatXTick(v)
+}
+
+// TestInspectionLabel exposes the inspection legend label for focused tests.
+func (c *EpochLineChart) TestInspectionLabel(x, y float64) string {
+	return c.formatInspectionLabel("", x, y)
+}
+
 // TestChartAt returns the chart at (row, col) on the current page (or nil).
 func (mg *MetricsGrid) TestChartAt(row, col int) *EpochLineChart {
 	mg.mu.RLock()
@@ -308,6 +337,11 @@ func (mg *MetricsGrid) TestSyncInspectActive() bool {
 	return mg.syncInspectActive
 }
 
+// TestToggleSortOrder switches between alphabetical and logged chart order.
+func (mg *MetricsGrid) TestToggleSortOrder() {
+	mg.toggleSortOrder()
+}
+
 // TestToggleFocusedChartLogY toggles log Y on the focused main chart.
 func (mg *MetricsGrid) TestToggleFocusedChartLogY() bool {
 	return mg.toggleFocusedChartLogY()
@@ -319,10 +353,24 @@ func (w *Workspace) TestSelectedRunCount() int {
 	return len(w.selectedRuns)
 }
 
+// TestClipboard returns the text most recently copied to the clipboard.
+func (w *Workspace) TestClipboard() string {
+	return w.clipboard
+}
+
+// TestClipboard returns the text most recently copied to the clipboard.
+func (r *Run) TestClipboard() string {
+	return r.clipboard
+}
+
 func TestNewWorkspaceRun(key string) *WorkspaceRun {
 	return &WorkspaceRun{Key: key}
 }
 
+func (r *WorkspaceRun) TestSetWandbPath(path string) {
+	r.wandbPath = path
+}
+
 func (r *WorkspaceRun) TestSetWatcherStarted(started bool) {
 	if r.watcher == nil {
 		r.watcher = NewWatcherManager(
@@ -331,12 +379,22 @@ func (r *WorkspaceRun) TestSetWatcherStarted(started bool) {
 	r.watcher.started = started
 }
 
+// TestSetFileWatcher replaces the manager's file watcher and the interval
+// of its polling fallback.
+func (wm *WatcherManager) TestSetFileWatcher(w watcher.Watcher, pollInterval time.Duration) {
+	wm.watcher = w
+	wm.pollInterval = pollInterval
+}
+
 func (r *WorkspaceRun) TestWatcherActive() bool {
 	return r.watcher != nil && r.watcher.started
 }
 
 func (w *Workspace) TestAttachRun(run *WorkspaceRun, selected bool) {
 	w.runsByKey[run.Key] = run
+	if run.wandbPath != "" {
+		w.runKeyByPath[run.wandbPath] = run.Key
+	}
 	if selected {
 		w.selectedRuns[run.Key] = true
 	} else {
@@ -348,6 +406,11 @@ func (w *Workspace) TestHandleWorkspaceRecord(run *WorkspaceRun, msg tea.Msg) {
 	w.handleWorkspaceRecord(run, msg)
 }
 
+// TestSyncStaleRuns checks for stale runs as if elapsed had passed.
+func (w *Workspace) TestSyncStaleRuns(elapsed time.Duration) {
+	w.syncStaleRuns(time.Now().Add(elapsed))
+}
+
 func (w *Workspace) TestHeartbeatTimerArmed() bool {
 	return w.heartbeatMgr != nil && w.heartbeatMgr.timer != nil
 }
@@ -370,6 +433,25 @@ func TestWorkspaceRunColorComponentRGB(component any) (uint8, uint8, uint8, bool
 	return workspaceRunColorComponentRGB(component)
 }
 
+// TestWorkspace returns the model's workspace.
+func (m *Model) TestWorkspace() *Workspace {
+	return m.workspace
+}
+
+// TestRunFile returns the .wandb file shown in single-run view, or "" when
+// the model is in workspace view.
+func (m *Model) TestRunFile() string {
+	if m.mode != viewModeRun || m.run == nil {
+		return ""
+	}
+	return m.run.runParams.RunFile
+}
+
+// TestPollWandbDir runs one immediate wandb directory scan.
+func (w *Workspace) TestPollWandbDir() tea.Msg {
+	return w.pollWandbDirCmd(0)()
+}
+
 func (w *Workspace) TestApplyRunKeys(runKeys []string) {
 	w.applyRunKeys(runKeys)
 }
@@ -386,6 +468,11 @@ func (w *Workspace) TestIsRunSelected(runKey string) bool {
 	return w.selectedRuns[runKey]
 }
 
+// TestSetClock sets the workspace's time source for live run status.
+func (w *Workspace) TestSetClock(now func() time.Time) {
+	w.now = now
+}
+
 func (w *Workspace) TestPinnedRun() string {
 	return w.pinnedRun
 }
@@ -502,6 +589,12 @@ func (w *Workspace) TestCurrentFocusRegion() int {
 	return int(w.focusMgr.Current())
 }
 
+// TestConsoleLogsPaneExpandedHeight returns the workspace bottom bar's
+// expanded height.
+func (w *Workspace) TestConsoleLogsPaneExpandedHeight() int {
+	return w.consoleLogsPane.ExpandedHeight()
+}
+
 // TestForceExpandConsoleLogsPane instantly expands the workspace bottom bar.
 func (w *Workspace) TestForceExpandConsoleLogsPane(h int) {
 	w.consoleLogsPane.SetExpandedHeight(h)
@@ -518,6 +611,12 @@ func (w *Workspace) TestForceExpandOverviewSidebar() {
 	w.runOverviewSidebar.animState.ForceExpand()
 }
 
+// TestOverviewSidebarTargetVisible reports whether the overview sidebar
+// is expanded or expanding.
+func (w *Workspace) TestOverviewSidebarTargetVisible() bool {
+	return w.runOverviewSidebar.animState.TargetVisible()
+}
+
 // TestForceCollapseRunsSidebar instantly collapses the runs sidebar.
 func (w *Workspace) TestForceCollapseRunsSidebar() {
 	w.runsAnimState.ForceCollapse()
@@ -600,6 +699,11 @@ func SystemMetricsPaneMinHeight() int {
 	return systemMetricsPaneMinHeight
 }
 
+// TestFocusSystemChart focuses the workspace system chart titled title.
+func (w *Workspace) TestFocusSystemChart(title string) {
+	w.systemMetricsFocus.Set(FocusSystemChart, 0, 0, title)
+}
+
 // TestForceExpandSystemMetricsPane instantly expands the system metrics pane.
 func (w *Workspace) TestForceExpandSystemMetricsPane(h int) {
 	w.systemMetricsPane.SetExpandedHeight(h)
@@ -616,6 +720,14 @@ func (w *Workspace) TestSystemMetricsPane() *SystemMetricsPane {
 	return w.systemMetricsPane
 }
 
+// TestSystemMetricsOrigin returns the screen position of the current run's
+// system metrics grid, i.e. where adjusted mouse coordinates are zero.
+func (w *Workspace) TestSystemMetricsOrigin() (x, y int) {
+	layout := w.computeViewports()
+	return layout.leftSidebarWidth + ContentPadding,
+		layout.systemMetricsY + systemMetricsPaneHeaderLines
+}
+
 // TestSystemMetrics returns the system metrics grids map for testing.
 func (w *Workspace) TestSystemMetrics() map[string]*SystemMetricsGrid {
 	return w.systemMetrics
@@ -669,3 +781,35 @@ func (w *Workspace) TestFilteredRunKeys() []string {
 	}
 	return keys
 }
+
+func (r *Run) TestMetricsGrid() *MetricsGrid {
+	return r.metricsGrid
+}
+
+// TestSetClock replaces the wall clock used to bucket console log arrivals.
+func (cl *RunConsoleLogs) TestSetClock(now func() time.Time) {
+	cl.now = now
+}
+
+// TestSetClock replaces the wall clock used to time idle chart dimming.
+func (mg *MetricsGrid) TestSetClock(now func() time.Time) {
+	mg.mu.Lock()
+	defer mg.mu.Unlock()
+	mg.now = now
+}
+
+// TestRunListState returns the state that colors the run's runs list mark.
+func (w *Workspace) TestRunListState(runKey string) RunState {
+	return w.runListState(runKey)
+}
+
+// TestRunListMarkColor returns the color of the run's runs list mark.
+func (w *Workspace) TestRunListMarkColor(runKey string) AdaptiveColor {
+	return w.runListMarkColor(runKey)
+}
+
+// TestRunStateMarkColor returns the mark color of an unselected run in
+// the given state, or the zero color if the state has none.
+func TestRunStateMarkColor(state RunState) AdaptiveColor {
+	return runStateMarkColor(state, AdaptiveColor{})
+}
@@ -48,6 +48,25 @@ type TimeSeriesLineChart struct {
 
 	lastUpdate         time.Time
 	minValue, maxValue float64
+
+	// aggregated requests the cross-device mean view; showingMean reports
+	// whether it is active (it needs more than one series).
+	aggregated, showingMean bool
+
+	// mean is the drawn series while aggregated, with the running sum and
+	// sample count of its last point.
+	mean      *Series
+	meanSum   float64
+	meanCount int
+
+	// deviceData and deviceOrder hold the per-device series while the
+	// mean is shown.
+	deviceData  map[string]*Series
+	deviceOrder []string
+
+	// maxPoints caps the points kept per series, dropping the oldest;
+	// 0 keeps every point.
+	maxPoints int
 }
 
 type TimeSeriesLineChartParams struct {
@@ -97,6 +116,12 @@ func (c *TimeSeriesLineChart) SetTailWindow(window time.Duration) {
 	c.applyRanges()
 }
 
+// SetMaxPoints caps the points kept per series, dropping the oldest
+// first. 0 keeps every point.
+func (c *TimeSeriesLineChart) SetMaxPoints(n int) {
+	c.maxPoints = max(n, 0)
+}
+
 // AddDataPoint adds a data point to this chart, creating series as needed.
 func (c *TimeSeriesLineChart) AddDataPoint(seriesName string, timestamp int64, value float64) {
 	seriesKey, created := c.ensureSeries(seriesName)
@@ -115,6 +140,7 @@ func (c *TimeSeriesLineChart) AddDataPoint(seriesName string, timestamp int64, v
 	if created {
 		style := lipgloss.NewStyle().Foreground(c.seriesColors[seriesKey])
 		c.SetSeriesStyle(seriesKey, &style)
+		c.syncMeanView()
 	}
 	c.applyRanges()
 }
@@ -187,6 +213,9 @@ func (c *TimeSeriesLineChart) TitleDetail() string {
 	if len(c.series) <= 1 {
 		return ""
 	}
+	if c.showingMean {
+		return c.meanTitleDetail()
+	}
 	return fmt.Sprintf("[%d]", len(c.series))
 }
 
@@ -252,21 +281,65 @@ func (c *TimeSeriesLineChart) ensureSeries(seriesName string) (seriesKey string,
 }
 
 func (c *TimeSeriesLineChart) addPoint(seriesKey string, x, y float64) {
-	s, ok := c.data[seriesKey]
+	data, order := c.data, &c.order
+	if c.showingMean {
+		data, order = c.deviceData, &c.deviceOrder
+	}
+
+	s, ok := data[seriesKey]
 	if !ok {
 		s = NewSeries(seriesKey, c.palette)
-		c.data[seriesKey] = s
-		c.order = append(c.order, seriesKey)
+		data[seriesKey] = s
+		*order = append(*order, seriesKey)
 	}
 
 	s.AddPoint(x, y)
-	c.xMin = min(c.xMin, x)
-	c.xMax = max(c.xMax, x)
-	c.yMin = min(c.yMin, y)
-	c.yMax = max(c.yMax, y)
+	trimmed := c.trimSeries(s)
+	if c.showingMean {
+		y = c.addMeanPoint(x, y)
+		trimmed = c.trimSeries(c.mean) || trimmed
+	}
+	if trimmed {
+		c.recomputeBounds()
+		c.recomputeValueBounds()
+	} else {
+		c.xMin = min(c.xMin, x)
+		c.xMax = max(c.xMax, x)
+		c.yMin = min(c.yMin, y)
+		c.yMax = max(c.yMax, y)
+	}
 	c.dirty = true
 }
 
+// trimSeries drops the oldest points of s beyond maxPoints and reports
+// whether any were dropped.
+func (c *TimeSeriesLineChart) trimSeries(s *Series) bool {
+	excess := len(s.X) - c.maxPoints
+	if c.maxPoints <= 0 || excess <= 0 {
+		return false
+	}
+	// Reslicing keeps appends amortized O(1): the dropped prefix is
+	// released the next time append reallocates.
+	s.X, s.Y = s.X[excess:], s.Y[excess:]
+	s.resetBounds()
+	return true
+}
+
+// recomputeValueBounds recomputes the observed raw value range from the
+// retained per-device points.
+func (c *TimeSeriesLineChart) recomputeValueBounds() {
+	data := c.data
+	if c.showingMean {
+		data = c.deviceData
+	}
+	c.minValue, c.maxValue = math.Inf(1), math.Inf(-1)
+	for _, s := range data {
+		_, _, yMin, yMax := s.Bounds()
+		c.minValue = min(c.minValue, yMin)
+		c.maxValue = max(c.maxValue, yMax)
+	}
+}
+
 func (c *TimeSeriesLineChart) nextSeriesColor() AdaptiveColor {
 	if c.colorProvider == nil {
 		return c.baseColor
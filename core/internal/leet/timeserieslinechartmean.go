@@ -0,0 +1,112 @@
+package leet
+
+import (
+	"fmt"
+	"slices"
+
+	"charm.land/lipgloss/v2"
+)
+
+// meanSeriesKey is the series shown in place of the per-device series
+// when a multi-series chart is aggregated.
+const meanSeriesKey = "mean"
+
+// SetAggregated switches between per-device series and a single series
+// holding their mean at each timestamp.
+//
+// Charts with a single series are unaffected.
+func (c *TimeSeriesLineChart) SetAggregated(on bool) {
+	c.aggregated = on
+	c.syncMeanView()
+}
+
+// IsAggregated reports whether the chart currently shows the device mean.
+func (c *TimeSeriesLineChart) IsAggregated() bool { return c.showingMean }
+
+// syncMeanView swaps the drawn series to match the requested aggregation.
+func (c *TimeSeriesLineChart) syncMeanView() {
+	want := c.aggregated && len(c.series) > 1
+	if want == c.showingMean {
+		return
+	}
+
+	if want {
+		c.rebuildMean(c.data)
+		c.deviceData, c.deviceOrder = c.data, c.order
+		c.data = map[string]*Series{meanSeriesKey: c.mean}
+		c.order = []string{meanSeriesKey}
+	} else {
+		c.data, c.order = c.deviceData, c.deviceOrder
+		c.deviceData, c.deviceOrder = nil, nil
+		c.mean = nil
+		for key, color := range c.seriesColors {
+			style := lipgloss.NewStyle().Foreground(color)
+			c.SetSeriesStyle(key, &style)
+		}
+	}
+	c.showingMean = want
+
+	c.recomputeBounds()
+	c.applyRanges()
+	c.dirty = true
+}
+
+// addMeanPoint folds a device sample into the mean series shown while
+// aggregated and returns the mean at x.
+//
+// Samples from one stats record share a timestamp, so they update the
+// last mean point in place.
+func (c *TimeSeriesLineChart) addMeanPoint(x, y float64) float64 {
+	m := c.mean
+	if n := len(m.X); n > 0 && m.X[n-1] == x {
+		c.meanSum += y
+		c.meanCount++
+		m.Y[n-1] = c.meanSum / float64(c.meanCount)
+		m.updateBounds(m.X[n-1:], m.Y[n-1:])
+		return m.Y[n-1]
+	}
+
+	c.meanSum, c.meanCount = y, 1
+	m.AddPoint(x, y)
+	return y
+}
+
+// rebuildMean recomputes the mean series from the given device series.
+func (c *TimeSeriesLineChart) rebuildMean(data map[string]*Series) {
+	type bucket struct {
+		sum   float64
+		count int
+	}
+	buckets := make(map[float64]*bucket)
+	for _, s := range data {
+		for i, x := range s.X {
+			b, ok := buckets[x]
+			if !ok {
+				b = &bucket{}
+				buckets[x] = b
+			}
+			b.sum += s.Y[i]
+			b.count++
+		}
+	}
+
+	xs := make([]float64, 0, len(buckets))
+	for x := range buckets {
+		xs = append(xs, x)
+	}
+	slices.Sort(xs)
+
+	m := NewSeries(meanSeriesKey, c.palette)
+	m.style.Store(lipgloss.NewStyle().Foreground(c.baseColor))
+	for _, x := range xs {
+		b := buckets[x]
+		m.AddPoint(x, b.sum/float64(b.count))
+		c.meanSum, c.meanCount = b.sum, b.count
+	}
+	c.mean = m
+}
+
+// meanTitleDetail returns the title suffix shown while aggregated.
+func (c *TimeSeriesLineChart) meanTitleDetail() string {
+	return fmt.Sprintf("[mean of %d]", len(c.series))
+}
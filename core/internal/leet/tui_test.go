@@ -719,6 +719,40 @@ func writeWorkspaceRunWandbFileWithStatsAndLogs(
 	return runFile
 }
 
+func TestWorkspace_ConsoleLogs_IngestsSiblingOutputLog(t *testing.T) {
+	if testing.Short() {
+		t.Skip("skipping in short mode")
+	}
+
+	logger := observability.NewNoOpLogger()
+	cfg := leet.NewConfigManager(filepath.Join(t.TempDir(), "config.json"), logger)
+	require.NoError(t, cfg.SetStartupMode(leet.StartupModeWorkspaceLatest))
+
+	wandbDir := t.TempDir()
+	runKey := "run-20260209_010101-outlog"
+	runFile := writeWorkspaceRunWandbFileWithStatsAndLogs(t, wandbDir, runKey, "outlog")
+	require.NoError(t, os.WriteFile(
+		filepath.Join(filepath.Dir(runFile), "output.log"),
+		[]byte("loading dataset from output.log\nepoch 1 complete\n"),
+		0o644,
+	))
+
+	const W, H = 240, 80
+	tm := newWorkspaceTestModel(t, cfg, wandbDir, W, H)
+
+	waitForPlainOutput(t, tm, []string{"loss", runKey}, nil)
+
+	tm.Type("4")
+	forceRepaint(tm, W+1, H)
+	waitForPlainOutput(t, tm,
+		[]string{"Console Logs", "loading dataset from output.log", "epoch 1 complete"},
+		nil,
+	)
+
+	tm.Type("q")
+	tm.WaitFinished(t, teatest.WithFinalTimeout(shortWait))
+}
+
 func TestWorkspace_SystemMetricsPaneAndConsoleLogs(t *testing.T) {
 	if testing.Short() {
 		t.Skip("skipping in short mode")
@@ -801,3 +835,64 @@ func TestWorkspace_SystemMetricsPaneAndConsoleLogs(t *testing.T) {
 	tm.Type("q")
 	tm.WaitFinished(t, teatest.WithFinalTimeout(shortWait))
 }
+
+func TestModel_DiagnosticsOverlay_ShowsLabeledCounters(t *testing.T) {
+	logger := observability.NewNoOpLogger()
+	cfg := leet.NewConfigManager(filepath.Join(t.TempDir(), "config.json"), logger)
+	m := leet.NewModel(leet.ModelParams{
+		WandbDir: t.TempDir(),
+		Config:   cfg,
+		Logger:   logger,
+	})
+	m.Update(tea.WindowSizeMsg{Width: 120, Height: 40})
+
+	require.NotContains(t, stripANSI(m.View().Content), "Heap in use")
+
+	m.Update(tea.KeyPressMsg{Code: 'm', Mod: tea.ModAlt})
+	view := stripANSI(m.View().Content)
+
+	for _, label := range []string{
+		"Diagnostics", "Heap in use", "Memory from OS", "Goroutines",
+		"Loaded runs", "Chart series", "Console log lines",
+	} {
+		require.Contains(t, view, label)
+	}
+	require.Regexp(t, regexp.MustCompile(`Heap in use\s+[1-9][0-9.]*[KMG]iB`), view)
+	require.Regexp(t, regexp.MustCompile(`Goroutines\s+[1-9]`), view)
+	require.Regexp(t, regexp.MustCompile(`Loaded runs\s+0`), view)
+
+	// Other keys are swallowed while the overlay is open; Esc closes it.
+	m.Update(tea.KeyPressMsg{Code: 'h', Text: "h"})
+	require.Contains(t, stripANSI(m.View().Content), "Heap in use")
+	m.Update(tea.KeyPressMsg{Code: tea.KeyEsc})
+	require.NotContains(t, stripANSI(m.View().Content), "Heap in use")
+
+	// Quit keys still quit while the overlay is open.
+	m.Update(tea.KeyPressMsg{Code: 'm', Mod: tea.ModAlt})
+	_, cmd := m.Update(tea.KeyPressMsg{Code: 'q', Text: "q"})
+	require.NotNil(t, cmd)
+	require.IsType(t, tea.QuitMsg{}, cmd())
+}
+
+func TestModel_PerfOverlay_ShowsTimingsWhenEnabled(t *testing.T) {
+	logger := observability.NewNoOpLogger()
+	cfg := leet.NewConfigManager(filepath.Join(t.TempDir(), "config.json"), logger)
+	m := leet.NewModel(leet.ModelParams{
+		WandbDir: t.TempDir(),
+		Config:   cfg,
+		Logger:   logger,
+	})
+	m.Update(tea.WindowSizeMsg{Width: 120, Height: 40})
+	require.NotContains(t, stripANSI(m.View().Content), "fps")
+
+	require.NoError(t, cfg.SetPerfOverlay(true))
+	m.Update(tea.WindowSizeMsg{Width: 120, Height: 40})
+	m.View()
+	view := stripANSI(m.View().Content)
+
+	firstLine, _, _ := strings.Cut(view, "\n")
+	require.Regexp(t,
+		regexp.MustCompile(`update \d+\.\dms │ frame \d+\.\dms │ \d+ fps\s*$`),
+		firstLine)
+	require.Len(t, strings.Split(view, "\n"), 40, "overlay must not change the frame height")
+}
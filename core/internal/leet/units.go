@@ -4,6 +4,7 @@ import (
 	"math"
 	"strconv"
 	"strings"
+	"unicode"
 )
 
 // formatSigFigs formats the float with 'prec' significant digits.
@@ -41,7 +42,8 @@ var UnitMHz UnitFormatter = unitMHz{}
 // Bytes in base units (value already in bytes).
 var UnitBytes UnitFormatter = unitBytes{factorToBytes: 1}
 
-// Bytes where value is provided in MiB/GiB but title wants base "B".
+// Bytes where value is provided in KiB/MiB/GiB but title wants base "B".
+var UnitKiB UnitFormatter = unitBytes{factorToBytes: 1024}
 var UnitMiB UnitFormatter = unitBytes{factorToBytes: 1024 * 1024}
 var UnitGiB UnitFormatter = unitBytes{factorToBytes: 1024 * 1024 * 1024}
 
@@ -133,6 +135,46 @@ func (u unitBytesPerSecond) Format(v float64) string {
 	return formatRateDecimal(v * u.factorToBps)
 }
 
+// byteUnits maps lowercase unit annotations to byte formatters.
+var byteUnits = map[string]UnitFormatter{
+	"b":     UnitBytes,
+	"bytes": UnitBytes,
+	"kib":   UnitKiB,
+	"mib":   UnitMiB,
+	"gib":   UnitGiB,
+}
+
+// ByteUnitFormatter returns the byte formatter for a unit name such as
+// "bytes", "KiB", "MiB" or "GiB" (case-insensitive).
+func ByteUnitFormatter(unit string) (UnitFormatter, bool) {
+	u, ok := byteUnits[strings.ToLower(unit)]
+	return u, ok
+}
+
+// MetricYUnit picks the Y axis unit for a metrics chart.
+//
+// An explicit unit (see [ByteUnitFormatter]) wins; otherwise the unit is
+// detected from a trailing annotation in the metric name, as in
+// "gpu/mem_bytes", "memory (MiB)" or "cache[GiB]". The bare "b" is
+// too ambiguous to detect and must be set explicitly.
+func MetricYUnit(name, unit string) UnitFormatter {
+	if u, ok := ByteUnitFormatter(unit); ok {
+		return u
+	}
+
+	fields := strings.FieldsFunc(name, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	if len(fields) < 2 {
+		return UnitScalar
+	}
+	last := strings.ToLower(fields[len(fields)-1])
+	if u, ok := byteUnits[last]; ok && last != "b" {
+		return u
+	}
+	return UnitScalar
+}
+
 // Binary prefixes: B, KiB, MiB, GiB, TiB.
 func formatBytesBinary(bytes float64) string {
 	units := []string{"B", "KiB", "MiB", "GiB", "TiB"}
@@ -0,0 +1,99 @@
+package leet
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/wandb/wandb/core/internal/observability"
+	"github.com/wandb/wandb/core/internal/transactionlog"
+	spb "github.com/wandb/wandb/core/pkg/service_go_proto"
+)
+
+// maxInspectWarnings bounds how many corrupt-record warnings
+// InspectWandbFile collects before giving up on a badly damaged file.
+const maxInspectWarnings = 100
+
+// FileReport summarizes the contents of a .wandb file.
+type FileReport struct {
+	Path  string
+	Bytes int64 // size of the file on disk
+
+	// Records counts records by type, keyed by the record_type field
+	// name ("run", "history", "summary", "stats", "output", "exit", ...).
+	//
+	// Raw and processed console output are both counted as "output".
+	Records map[string]int
+
+	// Total is the number of records that were read successfully.
+	Total int
+
+	// Warnings describes corrupt or truncated data that was skipped.
+	Warnings []string
+}
+
+// InspectWandbFile reads the .wandb file at path and reports how many
+// records of each type it holds.
+//
+// Corrupt records are skipped and reported as warnings rather than
+// failing the inspection. Only a missing file or a bad header is an error.
+func InspectWandbFile(path string) (FileReport, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return FileReport{}, fmt.Errorf("leet: inspecting %s: %w", path, err)
+	}
+
+	reader, err := transactionlog.OpenReader(path, observability.NewNoOpLogger())
+	if err != nil {
+		return FileReport{}, fmt.Errorf("leet: inspecting %s: %w", path, err)
+	}
+	defer reader.Close()
+
+	report := FileReport{
+		Path:    path,
+		Bytes:   info.Size(),
+		Records: make(map[string]int),
+	}
+
+	for {
+		record, err := reader.Read()
+		switch {
+		case err == nil:
+			report.Records[recordTypeName(record)]++
+			report.Total++
+			continue
+		case errors.Is(err, io.EOF):
+			return report, nil
+		case errors.Is(err, io.ErrUnexpectedEOF):
+			report.Warnings = append(report.Warnings,
+				fmt.Sprintf("truncated record at end of file: %v", err))
+			return report, nil
+		case report.Total == 0 && len(report.Warnings) == 0:
+			// The first read verifies the W&B header; failing it means
+			// this is not a .wandb file at all.
+			return FileReport{}, fmt.Errorf("leet: inspecting %s: %w", path, err)
+		}
+
+		report.Warnings = append(report.Warnings, err.Error())
+		if len(report.Warnings) >= maxInspectWarnings {
+			report.Warnings = append(report.Warnings,
+				"too many corrupt records, stopped reading")
+			return report, nil
+		}
+	}
+}
+
+// recordTypeName returns the record_type field name of record.
+func recordTypeName(record *spb.Record) string {
+	m := record.ProtoReflect()
+	field := m.WhichOneof(m.Descriptor().Oneofs().ByName("record_type"))
+	switch {
+	case field == nil:
+		return "other"
+	case field.Name() == "output_raw":
+		return "output"
+	default:
+		return string(field.Name())
+	}
+}
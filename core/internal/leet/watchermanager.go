@@ -2,6 +2,8 @@ package leet
 
 import (
 	"fmt"
+	"os"
+	"time"
 
 	tea "charm.land/bubbletea/v2"
 
@@ -9,12 +11,28 @@ import (
 	"github.com/wandb/wandb/core/internal/watcher"
 )
 
+// watcherPollInterval is how often the polling fallback checks the
+// watched file's size.
+const watcherPollInterval = 500 * time.Millisecond
+
 // WatcherManager manages file watching for live runs.
+//
+// If the file watcher fails to register the file, WatcherManager falls
+// back to polling its size, so that live updates keep working.
 type WatcherManager struct {
 	watcher watcher.Watcher
 	started bool
 	outChan chan tea.Msg
 	logger  *observability.CoreLogger
+
+	// pollInterval is how often the polling fallback stats the file.
+	pollInterval time.Duration
+
+	// stopPoll stops the polling fallback; nil unless it is running.
+	stopPoll chan struct{}
+
+	// pollDone is closed when the polling fallback exits.
+	pollDone chan struct{}
 }
 
 func NewWatcherManager(
@@ -22,13 +40,16 @@ func NewWatcherManager(
 	logger *observability.CoreLogger,
 ) *WatcherManager {
 	return &WatcherManager{
-		watcher: watcher.New(watcher.Params{Logger: logger}),
-		outChan: outChan,
-		logger:  logger,
+		watcher:      watcher.New(watcher.Params{Logger: logger}),
+		outChan:      outChan,
+		logger:       logger,
+		pollInterval: watcherPollInterval,
 	}
 }
 
 // Start starts watching the specified file.
+//
+// If the watcher cannot register the file, Start polls it instead.
 func (wm *WatcherManager) Start(runPath string) error {
 	if wm.started {
 		return nil
@@ -36,25 +57,93 @@ func (wm *WatcherManager) Start(runPath string) error {
 
 	wm.logger.Debug(fmt.Sprintf("watcher: starting for path: %s", runPath))
 
-	err := wm.watcher.Watch(runPath, func() {
-		wm.logger.Debug(fmt.Sprintf("watcher: file changed: %s", runPath))
+	err := wm.watcher.Watch(runPath, func() { wm.notifyFileChanged(runPath) })
+	if err != nil {
+		wm.logger.CaptureWarn(fmt.Sprintf(
+			"watcher: error starting, falling back to polling: %v", err))
+		wm.startPolling(runPath)
+	}
+
+	wm.started = true
+	wm.logger.Debug("watcher: started successfully")
+	return nil
+}
+
+// startPolling starts the polling fallback for runPath.
+func (wm *WatcherManager) startPolling(runPath string) {
+	wm.stopPoll = make(chan struct{})
+	wm.pollDone = make(chan struct{})
+	go wm.pollFile(runPath, fileSize(runPath), wm.pollInterval, wm.stopPoll, wm.pollDone)
+}
 
+// pollFile notifies of a file change whenever runPath's size changes
+// from size, until stop is closed.
+func (wm *WatcherManager) pollFile(
+	runPath string,
+	size int64,
+	interval time.Duration,
+	stop <-chan struct{},
+	done chan<- struct{},
+) {
+	defer close(done)
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
 		select {
-		case wm.outChan <- FileChangedMsg{}:
-			wm.logger.Debug("watcher: FileChangedMsg sent")
-		default:
-			wm.logger.CaptureWarn("watcher: outChan full, dropping FileChangedMsg")
+		case <-stop:
+			return
+		case <-ticker.C:
 		}
-	})
 
+		newSize := fileSize(runPath)
+		if newSize < 0 {
+			wm.notifyError(fmt.Errorf("watcher: cannot stat %s", runPath))
+			return
+		}
+		if newSize != size {
+			size = newSize
+			wm.notifyFileChanged(runPath)
+		}
+	}
+}
+
+// fileSize returns the size of the file at path, or -1 if it cannot be
+// statted.
+func fileSize(path string) int64 {
+	info, err := os.Stat(path)
 	if err != nil {
-		wm.logger.CaptureError(fmt.Errorf("watcher: error starting: %v", err))
-		return err
+		return -1
 	}
+	return info.Size()
+}
 
-	wm.started = true
-	wm.logger.Debug("watcher: started successfully")
-	return nil
+// notifyFileChanged sends a FileChangedMsg unless one is already pending.
+func (wm *WatcherManager) notifyFileChanged(runPath string) {
+	wm.logger.Debug(fmt.Sprintf("watcher: file changed: %s", runPath))
+
+	select {
+	case wm.outChan <- FileChangedMsg{}:
+		wm.logger.Debug("watcher: FileChangedMsg sent")
+	default:
+		wm.logger.CaptureWarn("watcher: outChan full, dropping FileChangedMsg")
+	}
+}
+
+// notifyError sends a WatcherErrMsg, replacing any pending change
+// notification: the receiver rereads the file when it recovers anyway.
+func (wm *WatcherManager) notifyError(err error) {
+	wm.logger.CaptureWarn(err.Error())
+
+	select {
+	case <-wm.outChan:
+	default:
+	}
+	select {
+	case wm.outChan <- WatcherErrMsg{Err: err}:
+	default:
+	}
 }
 
 // Finish stops the watcher.
@@ -65,6 +154,11 @@ func (wm *WatcherManager) Finish() {
 
 	wm.logger.Debug("watcher: finishing")
 	wm.watcher.Finish()
+	if wm.stopPoll != nil {
+		close(wm.stopPoll)
+		<-wm.pollDone
+		wm.stopPoll, wm.pollDone = nil, nil
+	}
 	wm.started = false
 
 	// Unblock any pending WaitForMsg calls.
@@ -1,6 +1,7 @@
 package leet_test
 
 import (
+	"errors"
 	"fmt"
 	"path/filepath"
 	"testing"
@@ -51,3 +52,53 @@ func TestWatcherManager_FileChangeDetection(t *testing.T) {
 	wm.Finish()
 	require.False(t, wm.IsStarted())
 }
+
+// failingWatcher is a watcher.Watcher that cannot register any path.
+type failingWatcher struct{}
+
+func (failingWatcher) Watch(string, func()) error {
+	return errors.New("no space left for watches")
+}
+
+func (failingWatcher) WatchDir(string, func(string)) error {
+	return errors.New("no space left for watches")
+}
+
+func (failingWatcher) Finish() {}
+
+func TestWatcherManager_FallsBackToPollingWhenWatchFails(t *testing.T) {
+	watcherChan := make(chan tea.Msg, 1)
+	wm := leet.NewWatcherManager(watcherChan, observability.NewNoOpLogger())
+	wm.TestSetFileWatcher(failingWatcher{}, 10*time.Millisecond)
+
+	path := filepath.Join(t.TempDir(), "test.wandb")
+	w, err := transactionlog.OpenWriter(path)
+	require.NoError(t, err)
+	defer func() { _ = w.Close() }()
+
+	require.NoError(t, wm.Start(path))
+	require.True(t, wm.IsStarted())
+
+	// An unchanged file produces no messages.
+	time.Sleep(50 * time.Millisecond)
+	require.Empty(t, watcherChan)
+
+	require.NoError(t, w.Write(&spb.Record{
+		RecordType: &spb.Record_History{
+			History: &spb.HistoryRecord{
+				Item: []*spb.HistoryItem{{NestedKey: []string{"_step"}, ValueJson: "0"}},
+			},
+		},
+	}))
+	require.NoError(t, w.Flush())
+
+	select {
+	case msg := <-watcherChan:
+		require.IsType(t, leet.FileChangedMsg{}, msg)
+	case <-time.After(2 * time.Second):
+		t.Fatal("polling fallback did not report the file growing")
+	}
+
+	wm.Finish()
+	require.False(t, wm.IsStarted())
+}
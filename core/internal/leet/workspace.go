@@ -2,6 +2,9 @@ package leet
 
 import (
 	"fmt"
+	"maps"
+	"path/filepath"
+	"slices"
 	"strings"
 	"sync"
 	"sync/atomic"
@@ -20,12 +23,18 @@ const (
 	PinnedRunMark   = "▶" // ✪ ◎ ▲ ▶ ◉ ▬ ◆ ▣ ■ → ○ ●
 )
 
+// runNoticeTTL is how long a run notice stays in the status bar.
+const runNoticeTTL = 5 * time.Second
+
 // Workspace is the multi‑run view.
 //
 // Implements tea.Model.
 type Workspace struct {
 	wandbDir string
 
+	// runGlob, if set, limits the directory scan to matching run names.
+	runGlob string
+
 	// focusMgr is the single source of truth for UI focus state.
 	focusMgr *FocusManager
 
@@ -41,6 +50,26 @@ type Workspace struct {
 	selectedRuns map[string]bool // runDirName -> selected
 	pinnedRun    string          // runDirName or ""
 
+	// runOverlayOrder is the user-arranged draw order of selected runs,
+	// or nil until a run is moved; the last run is drawn on top.
+	runOverlayOrder []string
+
+	// selectionUndo is the selection before the last bulk deselect, or nil.
+	selectionUndo *selectionSnapshot
+
+	// runTemplate is the parsed ConfigManager.RunListTemplate, reparsed
+	// when runTemplateSrc no longer matches the config.
+	runTemplate    runTemplate
+	runTemplateSrc string
+
+	// liveRuns holds the runs whose .wandb file was written recently at the
+	// last scan; only tracked when the runs list sorts running runs first.
+	liveRuns map[string]bool
+
+	// runStatuses caches the state of each listed run as read from the
+	// tail of its .wandb file, used to color unselected runs' marks.
+	runStatuses map[string]runStatus
+
 	// hasLiveRuns caches whether any selected run is in RunStateRunning.
 	hasLiveRuns atomic.Bool
 
@@ -51,18 +80,30 @@ type Workspace struct {
 	// Run overview preload pipeline for unselected runs.
 	overviewPreloader runOverviewPreloader
 
-	// autoSelectLatestRunOnLoad is triggered when at least one run
+	// overviewCollapsedByUser is set once the overview sidebar is
+	// collapsed by hand, which stops selections from auto-expanding it.
+	overviewCollapsedByUser bool
+
+	// restoreSelectionOnLoad is triggered when at least one run
 	// appears in the workspace.
-	autoSelectLatestRunOnLoad sync.Once
+	restoreSelectionOnLoad sync.Once
 
 	// TODO: mark live runs upon selection.
 
+	// configExport is the status bar submenu for exporting the
+	// current run's config.
+	configExport configExportMenu
+
 	// filter drives the runs sidebar search box.
 	filter *Filter
 	// runsFilterIndex caches searchable per-run metadata (name, project, config)
 	// for the runs sidebar so metadata filtering stays fast during live preview.
 	runsFilterIndex map[string]WorkspaceRunFilterData
 
+	// recentRunsOnly limits the runs list to the newest runs
+	// (see ConfigManager.RecentRunsLimit).
+	recentRunsOnly bool
+
 	// Multi‑run metrics state.
 	metricsGridAnimState *AnimatedValue
 	focus                *Focus
@@ -79,6 +120,18 @@ type Workspace struct {
 	consoleLogs     map[string]*RunConsoleLogs
 	consoleLogsPane *ConsoleLogsPane
 
+	// consoleErrorsView shows the error lines of all selected runs in the
+	// console logs pane instead of the current run's logs.
+	consoleErrorsView bool
+
+	// systemCompareTitle, when set, is the system chart whose latest and
+	// peak values across selected runs the console logs pane shows.
+	systemCompareTitle string
+
+	// consoleLogsRunKey is the run whose logs the pane last showed, so the
+	// scroll position resets when the current run changes.
+	consoleLogsRunKey string
+
 	// Run media keyed by run path.
 	media              map[string]*MediaStore
 	mediaPane          *MediaPane
@@ -88,6 +141,30 @@ type Workspace struct {
 	// Per‑run streaming state keyed by runDirName.
 	runsByKey map[string]*WorkspaceRun
 
+	// runKeyByPath indexes runsByKey by .wandb file path, which is how
+	// chart series are keyed.
+	runKeyByPath map[string]string
+
+	// runNotice is a one-off status bar message about run lifecycle events
+	// (e.g. a selected run's file being deleted), shown for runNoticeTTL.
+	runNotice   string
+	runNoticeAt time.Time
+
+	// clipboard is the text most recently copied to the clipboard.
+	clipboard string
+
+	// liveUpdate summarizes the records of the latest live read, shown
+	// for liveUpdateSummaryTTL when Config.LiveUpdateSummary is on.
+	liveUpdate   string
+	liveUpdateAt time.Time
+
+	// liveRedrawPending is set while a WorkspaceLiveRedrawMsg tick is
+	// scheduled, so heartbeats don't start a second chain.
+	liveRedrawPending bool
+
+	// now returns the current time for live run status.
+	now func() time.Time
+
 	// Heartbeat for live runs.
 	liveChan     chan tea.Msg
 	heartbeatMgr *HeartbeatManager
@@ -104,6 +181,22 @@ type WorkspaceRun struct {
 	wandbPath string
 	watcher   *WatcherManager
 	state     RunState
+
+	// lastError is the last stderr or detected error line from the run's
+	// console output.
+	lastError string
+
+	// lastRecordAt is when the run last delivered a record.
+	lastRecordAt time.Time
+
+	// tee receives the run's console output while it is teed to a file.
+	tee *consoleTee
+
+	// latest holds the last finite value the run logged for each metric.
+	latest map[string]float64
+
+	// load is the run's initial chunked load; nil once it has completed.
+	load *chunkedLoad
 }
 
 func NewWorkspace(
@@ -172,11 +265,15 @@ func NewWorkspace(
 		media:               make(map[string]*MediaStore),
 		mediaPane:           NewMediaPane(mediaPaneAnimState, cfg.WorkspaceMediaGrid),
 		runsByKey:           make(map[string]*WorkspaceRun),
+		runKeyByPath:        make(map[string]string),
 		liveChan:            ch,
 		heartbeatMgr:        NewHeartbeatManager(hbInterval, ch, logger),
+		now:                 time.Now,
 		filter:              NewFilter(),
 		runsFilterIndex:     make(map[string]WorkspaceRunFilterData),
 	}
+	metricsGrid.SetSeriesLabelProvider(w.runLabelForPath)
+	w.consoleLogsPane.SetTimestampsHidden(!cfg.ConsoleLogTimestamps())
 	w.focusMgr = w.buildWorkspaceFocusManager()
 	// The runs list starts focused by default.
 	w.focusMgr.SetTarget(FocusTargetRunsList, 1)
@@ -227,10 +324,10 @@ func (w *Workspace) Update(msg tea.Msg) tea.Cmd {
 		w.handleWindowResize(t.Width, t.Height)
 
 	case tea.KeyPressMsg:
-		return w.handleKeyPressMsg(t)
+		return batchCmds(w.metricsGrid.NoteInput(), w.handleKeyPressMsg(t))
 
 	case tea.MouseMsg:
-		return w.handleMouse(t)
+		return batchCmds(w.metricsGrid.NoteInput(), w.handleMouse(t))
 
 	case WorkspaceRunsAnimationMsg:
 		return w.handleRunsAnimation()
@@ -253,6 +350,9 @@ func (w *Workspace) Update(msg tea.Msg) tea.Cmd {
 	case WorkspaceRunInitMsg:
 		return w.handleWorkspaceRunInit(t)
 
+	case WorkspaceOutputLogMsg:
+		return w.handleWorkspaceOutputLog(t)
+
 	case WorkspaceInitErrMsg:
 		return w.handleWorkspaceInitErr(t)
 
@@ -271,9 +371,21 @@ func (w *Workspace) Update(msg tea.Msg) tea.Cmd {
 	case WorkspaceFileChangedMsg:
 		return w.handleWorkspaceFileChanged(t)
 
+	case WorkspaceRunFileRemovedMsg:
+		return w.handleWorkspaceRunFileRemoved(t)
+
+	case WorkspaceWatcherErrMsg:
+		return w.handleWorkspaceWatcherErr(t)
+
+	case WorkspaceWatcherRetryMsg:
+		return w.handleWorkspaceWatcherRetry(t)
+
 	case HeartbeatMsg:
 		return w.handleHeartbeat()
 
+	case WorkspaceLiveRedrawMsg:
+		return w.handleLiveRedraw()
+
 	case ErrorMsg:
 		// Read errors from per-run commands; the affected run simply stops
 		// streaming, so surface the error in the logs.
@@ -319,7 +431,7 @@ func (w *Workspace) View() tea.View {
 
 		if layout.consoleLogsHeight > 0 {
 			sections = append(sections,
-				w.consoleLogsPane.View(contentWidth, runLabel, logsHint))
+				w.consoleLogsPane.View(contentWidth, w.consoleLogsLabel(runLabel), logsHint))
 		}
 
 		sections = filterNonEmptySections(sections)
@@ -362,15 +474,15 @@ func (w *Workspace) Cleanup() {
 			continue
 		}
 		w.stopWatcher(run)
-		if run.Reader != nil {
-			run.Reader.Close()
-		}
+		w.closeConsoleTee(run)
+		closeRunReader(run)
 	}
 }
 
 // IsFiltering reports whether any workspace-level filter UI is active.
 func (w *Workspace) IsFiltering() bool {
 	if w.metricsGrid.IsFilterMode() ||
+		w.metricsGrid.IsJumpMode() ||
 		w.runOverviewSidebar.IsFilterMode() ||
 		w.filter.IsActive() {
 		return true
@@ -381,6 +493,11 @@ func (w *Workspace) IsFiltering() bool {
 	return false
 }
 
+// IsExportingConfig reports whether the config export submenu is open.
+func (w *Workspace) IsExportingConfig() bool {
+	return w.configExport.IsActive()
+}
+
 // SelectedRunWandbFile returns the full path to the .wandb file for the selected run.
 //
 // Returns empty string if no run is selected.
@@ -476,22 +593,44 @@ func (w *Workspace) syncCurrentRunContext() (
 		}
 	}
 
-	if currentRunKey == "" {
-		w.consoleLogsPane.SetConsoleLogs(nil)
-		return runLabel, systemGrid, systemHint, mediaHint, logsHint
+	if currentRunKey != w.consoleLogsRunKey {
+		w.consoleLogsRunKey = currentRunKey
+		w.consoleLogsPane.ResetScroll(w.config.ConsoleLogsStart() == ConsoleLogsStartTail)
 	}
 
-	if cl := w.consoleLogs[currentRunKey]; cl != nil {
+	switch cl := w.consoleLogs[currentRunKey]; {
+	case w.systemCompareTitle != "":
+		w.consoleLogsPane.SetConsoleLogs(w.systemCompareItems())
+	case w.consoleErrorsView:
+		w.consoleLogsPane.SetConsoleLogs(w.selectedRunErrorItems())
+	case cl != nil:
 		w.consoleLogsPane.SetConsoleLogs(cl.Items())
-	} else {
+	default:
 		w.consoleLogsPane.SetConsoleLogs(nil)
 	}
 
+	command := ""
+	if ro := w.runOverview[currentRunKey]; ro != nil && w.config.ConsoleLogCommand() &&
+		w.systemCompareTitle == "" && !w.consoleErrorsView {
+		command = ro.LaunchCommand()
+	}
+	w.consoleLogsPane.SetCommand(command)
+
+	if currentRunKey == "" {
+		return runLabel, systemGrid, systemHint, mediaHint, logsHint
+	}
+
 	if _, selected := w.selectedRuns[currentRunKey]; !selected {
 		systemHint = "Select this run (Space) to load system metrics."
 		mediaHint = "Select this run (Space) to load media."
 		logsHint = "Select this run (Space) to load console logs."
 	}
+	switch {
+	case w.systemCompareTitle != "":
+		logsHint = "No selected run has logged this system metric."
+	case w.consoleErrorsView:
+		logsHint = "No errors in the selected runs' console logs."
+	}
 
 	return runLabel, systemGrid, systemHint, mediaHint, logsHint
 }
@@ -606,7 +745,8 @@ func (w *Workspace) updateBottomPaneHeights(sysVisible, mediaVisible, logsVisibl
 		w.mediaPane.SetExpandedHeight(each)
 	}
 	if logsVisible {
-		w.consoleLogsPane.SetExpandedHeight(each)
+		w.consoleLogsPane.SetExpandedHeight(
+			consoleLogsHeight(w.config.ConsoleLogsHeight(), each, maxH))
 	}
 }
 
@@ -789,6 +929,23 @@ func (w *Workspace) cycleOverviewSection(direction int) bool {
 	return true
 }
 
+// jumpToOverviewQuickView moves focus to the next overview quick view
+// (Summary, Config), focusing the overview sidebar if needed.
+func (w *Workspace) jumpToOverviewQuickView() {
+	if !w.overviewFocusAvailable() {
+		return
+	}
+	focused := w.focusMgr.IsTarget(FocusTargetOverview)
+	idx := w.runOverviewSidebar.nextQuickViewSection(focused)
+	if idx == -1 {
+		return
+	}
+	if !focused {
+		w.focusMgr.SetTarget(FocusTargetOverview, 1)
+	}
+	w.runOverviewSidebar.setActiveSection(idx)
+}
+
 // handleWindowResize handles window resize messages.
 func (w *Workspace) handleWindowResize(width, height int) {
 	w.SetSize(width, height)
@@ -804,42 +961,55 @@ func (w *Workspace) handleWindowResize(width, height int) {
 	w.recalculateLayout()
 }
 
+// resumeAnimationsCmd restarts the animation loops of panes that were left
+// in flight when animations were frozen.
+func (w *Workspace) resumeAnimationsCmd() tea.Cmd {
+	var cmds []tea.Cmd
+	if w.runsAnimState.IsAnimating() {
+		cmds = append(cmds, w.runsAnimationCmd())
+	}
+	if w.runOverviewSidebar.IsAnimating() {
+		cmds = append(cmds, w.runOverviewAnimationCmd())
+	}
+	if w.consoleLogsPane.IsAnimating() {
+		cmds = append(cmds, w.consoleLogsPaneAnimationCmd())
+	}
+	if w.mediaPane.IsAnimating() {
+		cmds = append(cmds, w.mediaPaneAnimationCmd())
+	}
+	if w.metricsGridAnimState.IsAnimating() {
+		cmds = append(cmds, w.metricsGridAnimationCmd())
+	}
+	if w.systemMetricsPane.IsAnimating() {
+		cmds = append(cmds, w.systemMetricsPaneAnimationCmd())
+	}
+	return tea.Batch(cmds...)
+}
+
 // runsAnimationCmd returns a command to continue the animation on section toggle.
 func (w *Workspace) runsAnimationCmd() tea.Cmd {
-	return tea.Tick(AnimationFrame, func(t time.Time) tea.Msg {
-		return WorkspaceRunsAnimationMsg{}
-	})
+	return animationFrameCmd(WorkspaceRunsAnimationMsg{})
 }
 
 // runOverviewAnimationCmd returns a command to continue the animation on section toggle.
 func (w *Workspace) runOverviewAnimationCmd() tea.Cmd {
-	return tea.Tick(AnimationFrame, func(t time.Time) tea.Msg {
-		return WorkspaceRunOverviewAnimationMsg{}
-	})
+	return animationFrameCmd(WorkspaceRunOverviewAnimationMsg{})
 }
 
 func (w *Workspace) consoleLogsPaneAnimationCmd() tea.Cmd {
-	return tea.Tick(AnimationFrame, func(time.Time) tea.Msg {
-		return WorkspaceConsoleLogsPaneAnimationMsg{}
-	})
+	return animationFrameCmd(WorkspaceConsoleLogsPaneAnimationMsg{})
 }
 
 func (w *Workspace) mediaPaneAnimationCmd() tea.Cmd {
-	return tea.Tick(AnimationFrame, func(time.Time) tea.Msg {
-		return WorkspaceMediaPaneAnimationMsg{}
-	})
+	return animationFrameCmd(WorkspaceMediaPaneAnimationMsg{})
 }
 
 func (w *Workspace) metricsGridAnimationCmd() tea.Cmd {
-	return tea.Tick(AnimationFrame, func(time.Time) tea.Msg {
-		return WorkspaceMetricsGridAnimationMsg{}
-	})
+	return animationFrameCmd(WorkspaceMetricsGridAnimationMsg{})
 }
 
 func (w *Workspace) systemMetricsPaneAnimationCmd() tea.Cmd {
-	return tea.Tick(AnimationFrame, func(time.Time) tea.Msg {
-		return WorkspaceSystemMetricsPaneAnimationMsg{}
-	})
+	return animationFrameCmd(WorkspaceSystemMetricsPaneAnimationMsg{})
 }
 
 // ---- Run State Helpers ----
@@ -891,10 +1061,10 @@ func (w *Workspace) dropRun(runKey string) {
 			w.metricsGrid.RemoveSeries(run.wandbPath)
 		}
 		w.stopWatcher(run)
-		if run.Reader != nil {
-			run.Reader.Close()
-		}
+		w.closeConsoleTee(run)
+		closeRunReader(run)
 		delete(w.runsByKey, runKey)
+		delete(w.runKeyByPath, run.wandbPath)
 		delete(w.consoleLogs, runKey)
 		delete(w.systemMetrics, runKey)
 		delete(w.media, runKey)
@@ -927,6 +1097,9 @@ func (w *Workspace) getOrCreateConsoleLogs(runKey string) *RunConsoleLogs {
 		return cl
 	}
 	cl = NewRunConsoleLogs()
+	if w.config != nil {
+		cl.SetSampleThreshold(w.config.ConsoleLogSampleThreshold())
+	}
 	w.consoleLogs[runKey] = cl
 	return cl
 }
@@ -1031,6 +1204,75 @@ func (w *Workspace) renderRunsList() string {
 	return lipgloss.Place(totalW, totalH, lipgloss.Left, lipgloss.Top, boxed)
 }
 
+// runLabelForPath returns the legend label for the run whose .wandb file
+// is at wandbPath: its display name when known, otherwise its run ID.
+func (w *Workspace) runLabelForPath(wandbPath string) string {
+	if key, ok := w.runKeyByPath[wandbPath]; ok {
+		return w.shortRunLabel(key)
+	}
+	return filepath.Base(wandbPath)
+}
+
+// shortRunLabel resolves a human-friendly name for a run key: the display
+// name from the run record if known, else the run ID suffix, else the key.
+func (w *Workspace) shortRunLabel(runKey string) string {
+	if ro := w.runOverview[runKey]; ro != nil && ro.DisplayName() != "" {
+		return ro.DisplayName()
+	}
+	if id := extractRunID(runKey); id != "" {
+		return id
+	}
+	return runKey
+}
+
+// runListLabel returns the text shown for a run in the runs list.
+//
+// The full key is kept everywhere else; only the rendered label changes.
+func (w *Workspace) runListLabel(runKey string) string {
+	if w.config == nil {
+		return runKey
+	}
+	if src := w.config.RunListTemplate(); src != "" {
+		if src != w.runTemplateSrc {
+			w.runTemplate, w.runTemplateSrc = parseRunTemplate(src), src
+		}
+		return w.runTemplate.render(func(field string) string {
+			return w.runTemplateField(runKey, field)
+		})
+	}
+	if !w.config.CompactRunKeys() {
+		return runKey
+	}
+	return w.shortRunLabel(runKey)
+}
+
+// runListLabels returns the runs list label of each listed run.
+//
+// When enabled in the config, a label shared by several listed runs,
+// e.g. a repeated display name, gets the run ID appended.
+func (w *Workspace) runListLabels() map[string]string {
+	labels := make(map[string]string, len(w.runs.FilteredItems))
+	counts := make(map[string]int, len(w.runs.FilteredItems))
+	for _, item := range w.runs.FilteredItems {
+		label := w.runListLabel(item.Key)
+		labels[item.Key] = label
+		counts[label]++
+	}
+	if w.config == nil || !w.config.DisambiguateRunNames() {
+		return labels
+	}
+
+	for runKey, label := range labels {
+		if counts[label] < 2 {
+			continue
+		}
+		if id := extractRunID(runKey); id != "" && !strings.Contains(label, id) {
+			labels[runKey] = label + " (" + id + ")"
+		}
+	}
+	return labels
+}
+
 func (w *Workspace) renderRunOverview() string {
 	curKey := ""
 	if cur, ok := w.runs.CurrentItem(); ok {
@@ -1066,14 +1308,40 @@ func (w *Workspace) renderMetrics(layout Layout) string {
 
 	// Runs selected but no charts: show empty state.
 	if w.metricsGrid.ChartCount() == 0 {
-		return renderMetricsEmptyState(contentWidth, contentHeight, "No scalar metrics logged.")
+		return renderMetricsEmptyState(contentWidth, contentHeight,
+			noMetricsHint(w.selectedRunsHaveConsoleLogs(), w.consoleLogsPane.IsVisible()))
 	}
 
 	// When we have selected runs, render the metrics grid.
+	w.metricsGrid.SetSummaryStrip(w.summaryStrip())
 	dims := w.metricsGrid.CalculateChartDimensions(contentWidth, contentHeight)
 	return w.metricsGrid.View(dims)
 }
 
+// selectedRunsHaveConsoleLogs reports whether any selected run has
+// console output.
+func (w *Workspace) selectedRunsHaveConsoleLogs() bool {
+	for runKey := range w.selectedRuns {
+		if cl := w.consoleLogs[runKey]; cl != nil && len(cl.Items()) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// noMetricsHint is the metrics area placeholder for runs that logged no
+// metrics, e.g. data prep jobs, pointing at their console output if any.
+func noMetricsHint(hasConsoleLogs, consoleLogsVisible bool) string {
+	switch {
+	case !hasConsoleLogs:
+		return "No metrics logged."
+	case consoleLogsVisible:
+		return "No metrics logged. Console output is shown below."
+	default:
+		return "No metrics logged. Press 4 to show console output."
+	}
+}
+
 // renderMetricsEmptyState renders a styled "Metrics" header with a hint message.
 func renderMetricsEmptyState(width, height int, hint string) string {
 	if width <= 0 || height <= 0 {
@@ -1135,6 +1403,9 @@ func (w *Workspace) buildStatusText() string {
 	if w.metricsGrid.IsFilterMode() {
 		return w.buildMetricsFilterStatus()
 	}
+	if w.metricsGrid.IsJumpMode() {
+		return buildJumpToStepStatus(w.metricsGrid)
+	}
 	if g := w.activeSystemMetricsGrid(); g != nil && g.IsFilterMode() {
 		return w.buildSystemMetricsFilterStatus(g)
 	}
@@ -1147,6 +1418,10 @@ func (w *Workspace) buildStatusText() string {
 		return w.config.GridConfigStatus()
 	}
 
+	if w.configExport.IsActive() {
+		return w.configExport.Status()
+	}
+
 	return w.buildActiveStatus()
 }
 
@@ -1194,7 +1469,27 @@ func (w *Workspace) buildOverviewFilterStatus() string {
 func (w *Workspace) buildActiveStatus() string {
 	var parts []string
 
-	parts = append(parts, w.activeFilterStatus()...)
+	if w.configExport.result != "" {
+		parts = append(parts, w.configExport.result)
+	}
+	if w.runNotice != "" && time.Since(w.runNoticeAt) < runNoticeTTL {
+		parts = append(parts, w.runNotice)
+	}
+	if w.liveUpdate != "" && time.Since(w.liveUpdateAt) < liveUpdateSummaryTTL {
+		parts = append(parts, w.liveUpdate)
+	}
+	if updated := w.lastUpdateStatus(); updated != "" {
+		parts = append(parts, updated)
+	}
+	if progress := w.sweepProgressStatus(); progress != "" {
+		parts = append(parts, progress)
+	}
+	if order := w.runListOrderStatus(); order != "" {
+		parts = append(parts, order)
+	}
+	if filters := activeFiltersStatus(w.activeFilters()); filters != "" {
+		parts = append(parts, filters)
+	}
 	parts = append(parts, w.activeSelectionStatus()...)
 	parts = append(parts, w.activeFocusStatus()...)
 
@@ -1204,57 +1499,78 @@ func (w *Workspace) buildActiveStatus() string {
 	return w.wandbDir + " • " + strings.Join(parts, " • ")
 }
 
-// activeFilterStatus collects status fragments for all active filters.
-func (w *Workspace) activeFilterStatus() []string {
-	var parts []string
+// activeFilters lists the workspace's active filters.
+func (w *Workspace) activeFilters() []activeFilter {
+	var filters []activeFilter
+
+	if w.recentRunsOnly {
+		filters = append(filters, activeFilter{
+			region:   "Recent runs",
+			matches:  fmt.Sprintf("%d/%d", len(w.runs.FilteredItems), len(w.runs.Items)),
+			clearKey: "F",
+		})
+	}
 
 	if w.filter.Query() != "" && !w.filter.IsActive() {
-		parts = append(parts, fmt.Sprintf(
-			"Runs (%s): %q [%d/%d] (f to change, ctrl+f to clear)",
-			w.filter.Mode().String(),
-			w.filter.Query(),
-			len(w.runs.FilteredItems),
-			len(w.runs.Items),
-		))
+		filters = append(filters, activeFilter{
+			region:   "Runs",
+			mode:     w.filter.Mode().String(),
+			query:    w.filter.Query(),
+			matches:  fmt.Sprintf("%d/%d", len(w.runs.FilteredItems), len(w.runs.Items)),
+			editKey:  "f",
+			clearKey: "ctrl+f",
+		})
 	}
 
 	if w.metricsGrid.IsFiltering() {
-		parts = append(parts, fmt.Sprintf(
-			"Filter (%s): %q [%d/%d] (/ to change, ctrl+/ to clear)",
-			w.metricsGrid.FilterMode().String(),
-			w.metricsGrid.FilterQuery(),
-			w.metricsGrid.FilteredChartCount(),
-			w.metricsGrid.ChartCount(),
-		))
+		filters = append(filters, activeFilter{
+			region: "Metrics",
+			mode:   w.metricsGrid.FilterMode().String(),
+			query:  w.metricsGrid.FilterQuery(),
+			matches: fmt.Sprintf("%d/%d",
+				w.metricsGrid.FilteredChartCount(), w.metricsGrid.ChartCount()),
+			editKey:  "/",
+			clearKey: "ctrl+/",
+		})
 	}
 
 	if g := w.activeSystemMetricsGrid(); g != nil &&
 		g.IsFiltering() &&
 		w.systemMetricsPane.IsVisible() {
-		parts = append(parts, fmt.Sprintf(
-			"System filter (%s): %q [%d/%d] (\\ to change, ctrl+\\ to clear)",
-			g.FilterMode().String(),
-			g.FilterQuery(),
-			g.FilteredChartCount(),
-			g.ChartCount(),
-		))
+		filters = append(filters, activeFilter{
+			region:   "System",
+			mode:     g.FilterMode().String(),
+			query:    g.FilterQuery(),
+			matches:  fmt.Sprintf("%d/%d", g.FilteredChartCount(), g.ChartCount()),
+			editKey:  "\\",
+			clearKey: "ctrl+\\",
+		})
 	}
 
 	if w.runOverviewSidebar.IsVisible() && w.runOverviewSidebar.IsFiltering() {
-		parts = append(parts, fmt.Sprintf(
-			"Overview: %q [%s] (o to change, ctrl+o to clear)",
-			w.runOverviewSidebar.FilterQuery(),
-			w.runOverviewSidebar.FilterInfo(),
-		))
+		filters = append(filters, activeFilter{
+			region:   "Overview",
+			query:    w.runOverviewSidebar.FilterQuery(),
+			matches:  w.runOverviewSidebar.FilterInfo(),
+			editKey:  "o",
+			clearKey: "ctrl+o",
+		})
 	}
 
-	return parts
+	return filters
 }
 
 // activeSelectionStatus collects status fragments for sidebar selection and media.
 func (w *Workspace) activeSelectionStatus() []string {
 	var parts []string
 
+	if w.config.WarnMixedProjects() {
+		if projects := w.selectedProjects(); len(projects) > 1 {
+			parts = append(parts,
+				"⚠ mixed projects: "+strings.Join(projects, ", "))
+		}
+	}
+
 	if w.runOverviewActive() {
 		key, value := w.runOverviewSidebar.SelectedItem()
 		if key != "" {
@@ -1271,6 +1587,20 @@ func (w *Workspace) activeSelectionStatus() []string {
 	return parts
 }
 
+// selectedProjects returns the sorted distinct project names of the
+// selected runs. Runs whose metadata hasn't loaded yet are skipped.
+func (w *Workspace) selectedProjects() []string {
+	seen := make(map[string]struct{})
+	for runKey := range w.selectedRuns {
+		ro := w.runOverview[runKey]
+		if ro == nil || ro.Project() == "" {
+			continue
+		}
+		seen[ro.Project()] = struct{}{}
+	}
+	return slices.Sorted(maps.Keys(seen))
+}
+
 // activeFocusStatus collects status fragments for the focused chart.
 func (w *Workspace) activeFocusStatus() []string {
 	if w.focus.Type == FocusNone {
@@ -1404,6 +1734,7 @@ func (w *Workspace) renderRunLines(contentWidth int) []string {
 
 	lines := make([]string, 0, endIdx-startIdx)
 	selectedLine := w.runs.CurrentLine()
+	labels := w.runListLabels()
 
 	for i := startIdx; i < endIdx; i++ {
 		idxOnPage := i - startIdx
@@ -1423,7 +1754,6 @@ func (w *Workspace) renderRunLines(contentWidth int) []string {
 		}
 
 		runKey := item.Key
-		runColor := w.runColorForKey(runKey)
 
 		isSelected := w.selectedRuns[runKey]
 		isPinned := w.pinnedRun == runKey
@@ -1437,7 +1767,7 @@ func (w *Workspace) renderRunLines(contentWidth int) []string {
 		}
 
 		// Render prefix without background.
-		prefix := lipgloss.NewStyle().Foreground(runColor).Render(mark + " ")
+		prefix := lipgloss.NewStyle().Foreground(w.runListMarkColor(runKey)).Render(mark + " ")
 		prefixWidth := lipgloss.Width(prefix)
 
 		// Apply subtle muting to unselected/unpinned runs
@@ -1449,9 +1779,52 @@ func (w *Workspace) renderRunLines(contentWidth int) []string {
 			nameStyle = nameStyle.Foreground(colorText)
 		}
 
-		// Render name with background and optional muting
+		// The runs list metric value follows the name when it fits in half
+		// of the row.
 		nameWidth := max(contentWidth-prefixWidth, 1)
-		name := nameStyle.Render(truncateValue(runKey, nameWidth))
+		label := labels[runKey]
+		metricText := ""
+		if value := w.runListMetricValue(runKey); value != "" {
+			if valueWidth := lipgloss.Width(value); valueWidth+1 <= nameWidth/2 {
+				nameWidth -= valueWidth + 1
+				metricText = style.Render(" ") + style.Foreground(colorSubtle).Render(value)
+			}
+		}
+
+		// Failed runs share the row with their last error; when both don't
+		// fit, the name is cut to half of the row.
+		errText := ""
+		if snippet := w.runErrorSnippet(runKey); snippet != "" {
+			errWidth := nameWidth - min(lipgloss.Width(label), nameWidth/2) - 1
+			if errWidth >= minRunErrorWidth {
+				nameWidth -= errWidth + 1
+				errText = style.Render(" ") + style.Foreground(colorError).Render(
+					truncateValue(runErrorMark+snippet, errWidth))
+			}
+		}
+
+		// Stale runs are marked before the name.
+		stale := w.isRunStale(runKey)
+		staleText := ""
+		if stale && nameWidth > lipgloss.Width(staleRunMark) {
+			staleText = w.staleRunStyle(style).Render(staleRunMark)
+			nameWidth -= lipgloss.Width(staleRunMark)
+		}
+
+		// Selected runs show their state after the name, unless the stale
+		// mark or the error snippet already tells it.
+		badgeText := ""
+		if (isSelected || isPinned) && !stale && errText == "" {
+			badge, color := runStateBadge(w.runListState(runKey))
+			if badge != "" && nameWidth > 2*lipgloss.Width(badge) {
+				badgeText = style.Foreground(color).Render(badge)
+				nameWidth -= lipgloss.Width(badge)
+			}
+		}
+
+		// Render name with background and optional muting
+		name := staleText + nameStyle.Render(truncateValue(label, nameWidth)) +
+			badgeText + metricText + errText
 
 		// Pad the styled name to fill remaining width
 		paddingNeeded := contentWidth - prefixWidth - lipgloss.Width(name)
@@ -1462,3 +1835,9 @@ func (w *Workspace) renderRunLines(contentWidth int) []string {
 
 	return lines
 }
+
+// setRunNotice shows a one-off run notice in the status bar.
+func (w *Workspace) setRunNotice(format string, args ...any) {
+	w.runNotice = fmt.Sprintf(format, args...)
+	w.runNoticeAt = time.Now()
+}
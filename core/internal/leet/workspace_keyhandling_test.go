@@ -2,8 +2,14 @@ package leet_test
 
 import (
 	"errors"
+	"fmt"
+	"os"
 	"path/filepath"
+	"slices"
+	"strings"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	tea "charm.land/bubbletea/v2"
 
@@ -88,6 +94,41 @@ func TestWorkspace_KeyHandling_GridConfigCaptureHasPriority(t *testing.T) {
 	require.Equal(t, 2, cols, "expected metrics cols updated by captured numeric key")
 }
 
+func TestWorkspace_KeyHandling_FilterDigitsNeverReachGridConfig(t *testing.T) {
+	logger := observability.NewNoOpLogger()
+	cfg := leet.NewConfigManager(filepath.Join(t.TempDir(), "config.json"), logger)
+	require.NoError(t, cfg.SetWorkspaceMetricsRows(1))
+	require.NoError(t, cfg.SetWorkspaceMetricsCols(1))
+
+	w := leet.NewWorkspace(t.TempDir(), cfg, logger)
+	_ = w.Update(tea.WindowSizeMsg{Width: 140, Height: 45})
+
+	// Digits (and the grid-config keys themselves) typed into the metrics
+	// filter are filter text.
+	require.Nil(t, w.Update(keyRune('/')))
+	typeWorkspaceFilter(t, w, "c2r3")
+	require.True(t, w.IsFiltering())
+	require.False(t, cfg.IsAwaitingGridConfig())
+	require.Contains(t, stripANSI(w.View().Content), "c2r3")
+
+	rows, cols := cfg.WorkspaceMetricsGrid()
+	require.Equal(t, 1, rows)
+	require.Equal(t, 1, cols)
+	require.Nil(t, w.Update(tea.KeyPressMsg{Code: tea.KeyEsc}))
+
+	// Conversely, "/" during grid-config capture ends the capture without
+	// opening the filter.
+	require.Nil(t, w.Update(keyRune('c')))
+	require.True(t, cfg.IsAwaitingGridConfig())
+	require.Nil(t, w.Update(keyRune('/')))
+	require.False(t, cfg.IsAwaitingGridConfig())
+	require.False(t, w.IsFiltering())
+
+	rows, cols = cfg.WorkspaceMetricsGrid()
+	require.Equal(t, 1, rows)
+	require.Equal(t, 1, cols)
+}
+
 func TestWorkspace_HandleWorkspaceInitErr_DropsSelectionAndPinned(t *testing.T) {
 	logger := observability.NewNoOpLogger()
 	cfg := leet.NewConfigManager(filepath.Join(t.TempDir(), "config.json"), logger)
@@ -186,6 +227,40 @@ func TestWorkspace_ToggleConsoleLogsPane_FocusStaysOnRuns(t *testing.T) {
 		"runs focus should be preserved when collapsing bottom bar from runs")
 }
 
+func TestWorkspace_ResizeConsoleLogsPane_GrowsAndClampsAtMax(t *testing.T) {
+	logger := observability.NewNoOpLogger()
+	cfg := leet.NewConfigManager(filepath.Join(t.TempDir(), "config.json"), logger)
+	w := leet.NewWorkspace(t.TempDir(), cfg, logger)
+	_ = w.Update(tea.WindowSizeMsg{Width: 200, Height: 60})
+	_ = w.Update(leet.WorkspaceRunDirsMsg{RunKeys: []string{"run-20260209_010101-abcdefg"}})
+	w.TestForceExpandConsoleLogsPane(10)
+
+	for !w.TestConsoleLogsPaneActive() {
+		_ = w.Update(tea.KeyPressMsg{Code: tea.KeyTab})
+	}
+
+	_ = w.Update(keyRune('+'))
+	require.Equal(t, 12, w.TestConsoleLogsPaneExpandedHeight())
+	require.Equal(t, 12, cfg.ConsoleLogsHeight())
+
+	_ = w.Update(keyRune('-'))
+	require.Equal(t, 10, w.TestConsoleLogsPaneExpandedHeight())
+
+	// Growing stops short of the full window and persists the limit.
+	for range 30 {
+		_ = w.Update(keyRune('+'))
+	}
+	maxHeight := w.TestConsoleLogsPaneExpandedHeight()
+	require.Greater(t, maxHeight, 10)
+	require.Less(t, maxHeight, 60*2/3)
+	require.Equal(t, maxHeight, cfg.ConsoleLogsHeight())
+
+	_ = w.Update(keyRune('+'))
+	require.Equal(t, maxHeight, w.TestConsoleLogsPaneExpandedHeight())
+	_ = w.Update(keyRune('-'))
+	require.Equal(t, maxHeight-2, w.TestConsoleLogsPaneExpandedHeight())
+}
+
 // ---- Focus bug: collapsing overview with logs focused ----
 
 func TestWorkspace_CollapseOverview_FocusStaysOnLogs(t *testing.T) {
@@ -508,6 +583,116 @@ func TestWorkspace_Enter_WorksWhenRunsFocused(t *testing.T) {
 		"Enter with run selector active should trigger enterRunView")
 }
 
+func newModelWithSeededRun(t *testing.T, enter string) (*leet.Model, string) {
+	t.Helper()
+	logger := observability.NewNoOpLogger()
+	cfg := leet.NewConfigManager(filepath.Join(t.TempDir(), "config.json"), logger)
+	require.NoError(t, cfg.SetRunListEnter(enter))
+
+	wandbDir := t.TempDir()
+	runKey := "run-20260209_010101-abcdefg"
+	writeWorkspaceRunWandbFile(t, wandbDir, runKey, "abcdefg", 1.0)
+
+	m := leet.NewModel(leet.ModelParams{
+		WandbDir: wandbDir,
+		Config:   cfg,
+		Logger:   logger,
+	})
+	m.Update(tea.WindowSizeMsg{Width: 200, Height: 60})
+	m.Update(leet.WorkspaceRunDirsMsg{RunKeys: []string{runKey}})
+	return m, runKey
+}
+
+func TestWorkspace_RunListEnter_ViewOpensRun(t *testing.T) {
+	m, runKey := newModelWithSeededRun(t, leet.RunListEnterView)
+	w := m.TestWorkspace()
+	selected := w.TestIsRunSelected(runKey)
+
+	m.Update(tea.KeyPressMsg{Code: tea.KeyEnter})
+
+	require.NotEmpty(t, m.TestRunFile())
+	require.Equal(t, selected, w.TestIsRunSelected(runKey),
+		"Enter in view mode must not change the selection")
+}
+
+func TestWorkspace_RunListEnter_SelectTogglesSelection(t *testing.T) {
+	m, runKey := newModelWithSeededRun(t, leet.RunListEnterSelect)
+	w := m.TestWorkspace()
+	selected := w.TestIsRunSelected(runKey)
+
+	m.Update(tea.KeyPressMsg{Code: tea.KeyEnter})
+	require.Empty(t, m.TestRunFile(), "Enter in select mode must stay in the workspace")
+	require.Equal(t, !selected, w.TestIsRunSelected(runKey))
+
+	m.Update(tea.KeyPressMsg{Code: tea.KeyEnter})
+	require.Equal(t, selected, w.TestIsRunSelected(runKey))
+
+	// alt+enter still opens the run.
+	m.Update(tea.KeyPressMsg{Code: tea.KeyEnter, Mod: tea.ModAlt})
+	require.NotEmpty(t, m.TestRunFile())
+}
+
+func TestModel_Backspace_ReturnsToPreviouslyViewedRuns(t *testing.T) {
+	logger := observability.NewNoOpLogger()
+	cfg := leet.NewConfigManager(filepath.Join(t.TempDir(), "config.json"), logger)
+	wandbDir := t.TempDir()
+	ids := []string{"aaaaaaaa", "bbbbbbbb", "cccccccc"}
+	var runKeys []string
+	for i, id := range ids {
+		runKey := fmt.Sprintf("run-20260209_01010%d-%s", i, id)
+		writeWorkspaceRunWandbFile(t, wandbDir, runKey, id, 1.0)
+		runKeys = append(runKeys, runKey)
+	}
+
+	m := leet.NewModel(leet.ModelParams{WandbDir: wandbDir, Config: cfg, Logger: logger})
+	m.Update(tea.WindowSizeMsg{Width: 200, Height: 60})
+	m.Update(leet.WorkspaceRunDirsMsg{RunKeys: runKeys})
+	w := m.TestWorkspace()
+
+	viewedID := func() string {
+		return leet.TestExtractRunID(filepath.Base(filepath.Dir(m.TestRunFile())))
+	}
+
+	// Open each run in turn from the runs list.
+	for i, runKey := range runKeys {
+		for w.TestCurrentRunKey() != runKey {
+			m.Update(tea.KeyPressMsg{Code: tea.KeyDown})
+		}
+		m.Update(tea.KeyPressMsg{Code: tea.KeyEnter})
+		require.Equal(t, ids[i], viewedID())
+		if i < len(runKeys)-1 {
+			m.Update(tea.KeyPressMsg{Code: tea.KeyEsc})
+			require.Empty(t, m.TestRunFile())
+		}
+	}
+
+	m.Update(tea.KeyPressMsg{Code: tea.KeyBackspace})
+	require.Equal(t, ids[1], viewedID())
+	m.Update(tea.KeyPressMsg{Code: tea.KeyBackspace})
+	require.Equal(t, ids[0], viewedID())
+
+	// The oldest run stays put.
+	m.Update(tea.KeyPressMsg{Code: tea.KeyBackspace})
+	require.Equal(t, ids[0], viewedID())
+}
+
+func TestWorkspace_RunListEnter_SelectRequiresRunSelectorActive(t *testing.T) {
+	m, runKey := newModelWithSeededRun(t, leet.RunListEnterSelect)
+	w := m.TestWorkspace()
+	selected := w.TestIsRunSelected(runKey)
+
+	w.TestForceExpandConsoleLogsPane(10)
+	for !w.TestConsoleLogsPaneActive() {
+		m.Update(tea.KeyPressMsg{Code: tea.KeyTab})
+	}
+	require.False(t, w.RunSelectorActive())
+
+	m.Update(tea.KeyPressMsg{Code: tea.KeyEnter})
+	m.Update(tea.KeyPressMsg{Code: tea.KeyEnter, Mod: tea.ModAlt})
+	require.Equal(t, selected, w.TestIsRunSelected(runKey))
+	require.Empty(t, m.TestRunFile())
+}
+
 // ---- Overview filter mode ----
 
 func TestWorkspace_OverviewFilterMode_ConsumesQuit(t *testing.T) {
@@ -605,6 +790,28 @@ func TestWorkspace_OverviewFilter_StatusBarShowsFilter(t *testing.T) {
 	require.Contains(t, view, "loss")
 }
 
+func TestWorkspace_StatusBar_ListsActiveFiltersWithClearHints(t *testing.T) {
+	w := newWorkspaceWithPanels(t)
+
+	apply := func(key rune, query string) {
+		t.Helper()
+		require.Nil(t, w.Update(keyRune(key)))
+		for _, r := range query {
+			require.Nil(t, w.Update(keyRune(r)))
+		}
+		require.Nil(t, w.Update(tea.KeyPressMsg{Code: tea.KeyEnter}))
+	}
+	apply('/', "acc")
+	apply('o', "loss")
+
+	_ = w.Update(tea.WindowSizeMsg{Width: 320, Height: 60})
+	view := stripANSI(w.View().Content)
+	require.Contains(t, view, "Active filters: Metrics (regex): \"acc\" [0/0] (/ edit, ctrl+/ clear); "+
+		"Overview: \"loss\"")
+	require.Contains(t, view, "(o edit, ctrl+o clear)")
+	require.Equal(t, 1, strings.Count(view, "Active filters:"))
+}
+
 func TestWorkspace_OverviewFilter_LivePreviewDuringInput(t *testing.T) {
 	w := newWorkspaceWithPanels(t)
 
@@ -779,6 +986,44 @@ func TestWorkspace_RunsFilter_Clear(t *testing.T) {
 	require.Equal(t, []string{run1, run2}, w.TestFilteredRunKeys())
 }
 
+func TestWorkspace_RunsFilter_ClearPreservesSelectionAndPin(t *testing.T) {
+	logger := observability.NewNoOpLogger()
+	cfg := leet.NewConfigManager(filepath.Join(t.TempDir(), "config.json"), logger)
+	w := leet.NewWorkspace(t.TempDir(), cfg, logger)
+	_ = w.Update(tea.WindowSizeMsg{Width: 160, Height: 50})
+
+	run1 := "run-20260209_010102-vision01"
+	run2 := "run-20260209_010101-nlp0002"
+	_ = w.Update(leet.WorkspaceRunDirsMsg{RunKeys: []string{run1, run2}})
+	_ = w.Update(leet.WorkspaceRunOverviewPreloadedMsg{
+		RunKey: run1,
+		Run:    &leet.RunMsg{ID: "vision01", Project: "vision", Tags: []string{"baseline"}},
+	})
+	_ = w.Update(leet.WorkspaceRunOverviewPreloadedMsg{
+		RunKey: run2,
+		Run:    &leet.RunMsg{ID: "nlp0002", Project: "nlp", Tags: []string{"sweep"}},
+	})
+	w.TestSetFocusTarget(int(leet.FocusTargetRunsList))
+
+	// Narrow to the nlp run by tag, then select and pin it.
+	_ = w.Update(keyRune('f'))
+	typeWorkspaceFilter(t, w, "tag:sweep")
+	_ = w.Update(tea.KeyPressMsg{Code: tea.KeyEnter})
+	require.Equal(t, []string{run2}, w.TestFilteredRunKeys())
+	require.Contains(t, stripANSI(w.View().Content), `Runs (regex): "tag:sweep" [1/2]`)
+	require.Equal(t, run2, w.TestCurrentRunKey())
+	_ = w.Update(keyRune(' '))
+	_ = w.Update(keyRune('p'))
+	require.True(t, w.TestIsRunSelected(run2))
+	require.Equal(t, run2, w.TestPinnedRun())
+
+	_ = w.Update(tea.KeyPressMsg{Code: 'f', Mod: tea.ModCtrl})
+	require.Equal(t, []string{run1, run2}, w.TestFilteredRunKeys())
+	require.True(t, w.TestIsRunSelected(run2))
+	require.Equal(t, run2, w.TestPinnedRun())
+	require.Equal(t, run2, w.TestCurrentRunKey(), "the cursor stays on the same run")
+}
+
 func TestWorkspace_RunsFilter_TagsAndNotes(t *testing.T) {
 	logger := observability.NewNoOpLogger()
 	cfg := leet.NewConfigManager(filepath.Join(t.TempDir(), "config.json"), logger)
@@ -830,3 +1075,483 @@ func TestWorkspace_RunsFilter_TagsAndNotes(t *testing.T) {
 	require.Nil(t, w.Update(tea.KeyPressMsg{Code: tea.KeyEnter}))
 	require.Equal(t, []string{run2}, w.TestFilteredRunKeys())
 }
+
+func TestWorkspace_JumpToPinnedRun(t *testing.T) {
+	w, _ := newWorkspaceWithMultipleRuns(t, 20)
+	_ = w.Update(tea.WindowSizeMsg{Width: 200, Height: 10})
+
+	// Pin a run far down the list.
+	_ = w.Update(primaryNavMsg(t, leet.NavIntentEnd))
+	_ = w.Update(keyRune('p'))
+	pinned := w.TestCurrentRunKey()
+	require.Equal(t, pinned, w.TestPinnedRun())
+
+	_ = w.Update(primaryNavMsg(t, leet.NavIntentHome))
+	require.NotEqual(t, pinned, w.TestCurrentRunKey())
+
+	_ = w.Update(keyRune('P'))
+	require.Equal(t, pinned, w.TestCurrentRunKey())
+	require.True(t, w.TestRunsActive())
+}
+
+func TestWorkspace_RunFileDeleted_DropsRunWithNotice(t *testing.T) {
+	logger := observability.NewNoOpLogger()
+	cfg := leet.NewConfigManager(filepath.Join(t.TempDir(), "config.json"), logger)
+
+	wandbDir := t.TempDir()
+	runKey := "run-20260209_010101-abcdefg"
+	runPath := writeWorkspaceRunWandbFile(t, wandbDir, runKey, "abcdefg", 1.0)
+
+	w := leet.NewWorkspace(wandbDir, cfg, logger)
+	_ = w.Update(tea.WindowSizeMsg{Width: 200, Height: 60})
+	_ = w.Update(leet.WorkspaceRunDirsMsg{RunKeys: []string{runKey}})
+	require.True(t, w.TestIsRunSelected(runKey))
+
+	reader, err := leet.NewLevelDBHistorySource(runPath, logger)
+	require.NoError(t, err)
+	_ = w.Update(leet.WorkspaceRunInitMsg{RunKey: runKey, RunPath: runPath, Reader: reader})
+
+	require.NoError(t, os.Remove(runPath))
+	cmd := w.Update(leet.WorkspaceFileChangedMsg{RunKey: runKey})
+
+	// The removal is detected by the read scheduled for the change.
+	require.NotNil(t, cmd)
+	removed, ok := cmd().(leet.WorkspaceRunFileRemovedMsg)
+	require.True(t, ok, "expected the read to report the removal")
+	require.Nil(t, w.Update(removed), "no further reads should be scheduled for a deleted run")
+	require.False(t, w.TestIsRunSelected(runKey))
+	require.Empty(t, w.TestPinnedRun())
+	require.Contains(t, stripANSI(w.View().Content), "file deleted")
+
+	// A late read error for the same run is ignored.
+	require.Nil(t, w.Update(leet.WorkspaceRunFileRemovedMsg{RunKey: runKey, RunPath: runPath}))
+	require.Equal(t, 0, w.TestSelectedRunCount())
+}
+
+func TestWorkspace_RecentRunsToggle_ListsNewestNAndCombinesWithFilter(t *testing.T) {
+	logger := observability.NewNoOpLogger()
+	cfg := leet.NewConfigManager(filepath.Join(t.TempDir(), "config.json"), logger)
+	require.NoError(t, cfg.SetRecentRunsLimit(3))
+
+	w := leet.NewWorkspace(t.TempDir(), cfg, logger)
+	_ = w.Update(tea.WindowSizeMsg{Width: 200, Height: 60})
+
+	runKeys := []string{
+		"run-20260101_090000-old1",
+		"run-20260210_120000-new2",
+		"offline-run-20260210_080000-new3",
+		"run-20260105_090000-old2",
+		"run-20260211_070000-new1",
+		"not-a-run-dir",
+	}
+	_ = w.Update(leet.WorkspaceRunDirsMsg{RunKeys: runKeys})
+	require.Len(t, w.TestFilteredRunKeys(), len(runKeys))
+
+	require.Nil(t, w.Update(keyRune('F')))
+	require.ElementsMatch(t, []string{
+		"run-20260211_070000-new1",
+		"run-20260210_120000-new2",
+		"offline-run-20260210_080000-new3",
+	}, w.TestFilteredRunKeys())
+	require.Contains(t, stripANSI(w.View().Content), "Recent runs [3/6]")
+
+	// The text filter applies within the recent set.
+	require.Nil(t, w.Update(keyRune('f')))
+	typeWorkspaceFilter(t, w, "offline")
+	require.Nil(t, w.Update(tea.KeyPressMsg{Code: tea.KeyEnter}))
+	require.Equal(t, []string{"offline-run-20260210_080000-new3"}, w.TestFilteredRunKeys())
+
+	require.Nil(t, w.Update(keyRune('F')))
+	require.Equal(t, []string{"offline-run-20260210_080000-new3"}, w.TestFilteredRunKeys(),
+		"text filter alone still applies after lifting the limit")
+	require.Nil(t, w.Update(tea.KeyPressMsg{Code: 'f', Mod: tea.ModCtrl}))
+	require.Len(t, w.TestFilteredRunKeys(), len(runKeys))
+}
+
+func TestWorkspace_DeselectFinishedRuns_KeepsLiveRunsSelected(t *testing.T) {
+	logger := observability.NewNoOpLogger()
+	cfg := leet.NewConfigManager(filepath.Join(t.TempDir(), "config.json"), logger)
+	w := leet.NewWorkspace(t.TempDir(), cfg, logger)
+	_ = w.Update(tea.WindowSizeMsg{Width: 200, Height: 60})
+
+	newRun := func(key string) *leet.WorkspaceRun {
+		run := leet.TestNewWorkspaceRun(key)
+		run.TestSetWandbPath(key + ".wandb")
+		w.TestAttachRun(run, true)
+		w.TestHandleWorkspaceRecord(run, leet.RunMsg{ID: key})
+		w.TestHandleWorkspaceRecord(run, leet.HistoryMsg{
+			RunPath: key + ".wandb",
+			Metrics: map[string]leet.MetricData{
+				"loss": {X: []float64{1}, Y: []float64{0.5}},
+			},
+		})
+		return run
+	}
+
+	live := newRun("run-20260101_000000-live")
+	live.TestSetWatcherStarted(true)
+	finished := newRun("run-20260101_000001-done")
+	w.TestHandleWorkspaceRecord(finished, leet.FileCompleteMsg{ExitCode: 0})
+	failed := newRun("run-20260101_000002-fail")
+	w.TestHandleWorkspaceRecord(failed, leet.FileCompleteMsg{ExitCode: 1})
+
+	// A selected run whose records haven't arrived yet stays selected.
+	w.TestAttachRun(leet.TestNewWorkspaceRun("run-20260101_000003-load"), true)
+	require.Equal(t, 4, w.TestSelectedRunCount())
+
+	require.Nil(t, w.Update(keyRune('D')))
+
+	require.True(t, w.TestIsRunSelected(live.Key))
+	require.True(t, w.TestIsRunSelected("run-20260101_000003-load"))
+	require.False(t, w.TestIsRunSelected(finished.Key))
+	require.False(t, w.TestIsRunSelected(failed.Key))
+	require.Equal(t, 2, w.TestSelectedRunCount())
+	require.Contains(t, stripANSI(w.View().Content), "Deselected 2 finished run(s)")
+
+	// The live run keeps the heartbeat armed until it finishes and is dropped too.
+	w.TestHandleWorkspaceRecord(live, leet.HistoryMsg{
+		RunPath: live.Key + ".wandb",
+		Metrics: map[string]leet.MetricData{
+			"loss": {X: []float64{2}, Y: []float64{0.4}},
+		},
+	})
+	require.True(t, w.TestHeartbeatTimerArmed())
+	w.TestHandleWorkspaceRecord(live, leet.FileCompleteMsg{ExitCode: 0})
+	require.Nil(t, w.Update(keyRune('D')))
+	require.False(t, w.TestIsRunSelected(live.Key))
+	require.False(t, w.TestHeartbeatTimerArmed())
+}
+
+func TestWorkspace_UndoDeselectAll_RestoresSelectionPinAndReaders(t *testing.T) {
+	logger := observability.NewNoOpLogger()
+	cfg := leet.NewConfigManager(filepath.Join(t.TempDir(), "config.json"), logger)
+	wandbDir := t.TempDir()
+	run1 := "run-20260101_000000-aaaaaaaa"
+	run2 := "run-20260101_000001-bbbbbbbb"
+	run3 := "run-20260101_000002-cccccccc"
+	for _, key := range []string{run1, run2, run3} {
+		createRunWandbFile(t, wandbDir, key, nil)
+	}
+
+	w := leet.NewWorkspace(wandbDir, cfg, logger)
+	_ = w.Update(tea.WindowSizeMsg{Width: 200, Height: 60})
+	_ = w.Update(leet.WorkspaceRunDirsMsg{RunKeys: []string{run1, run2, run3}})
+	require.True(t, w.TestIsRunSelected(run1))
+
+	// Select run2 and pin it; run3 stays unselected.
+	w.Update(tea.KeyPressMsg{Code: tea.KeyDown})
+	require.Equal(t, run2, w.TestCurrentRunKey())
+	w.Update(tea.KeyPressMsg{Code: tea.KeySpace})
+	w.Update(keyRune('p'))
+	require.Equal(t, run2, w.TestPinnedRun())
+
+	require.Nil(t, w.Update(keyRune('X')))
+	require.Equal(t, 0, w.TestSelectedRunCount())
+	require.Empty(t, w.TestPinnedRun())
+	require.Contains(t, stripANSI(w.View().Content), "Deselected 2 run(s) (u to undo)")
+
+	cmd := w.Update(keyRune('u'))
+	require.NotNil(t, cmd)
+	require.True(t, w.TestIsRunSelected(run1))
+	require.True(t, w.TestIsRunSelected(run2))
+	require.False(t, w.TestIsRunSelected(run3))
+	require.Equal(t, run2, w.TestPinnedRun())
+
+	// Each restored run gets a fresh reader.
+	batch, ok := cmd().(tea.BatchMsg)
+	require.True(t, ok)
+	var reinit []string
+	for _, c := range batch {
+		initMsg, ok := c().(leet.WorkspaceRunInitMsg)
+		require.True(t, ok)
+		t.Cleanup(initMsg.Reader.Close)
+		reinit = append(reinit, initMsg.RunKey)
+	}
+	require.ElementsMatch(t, []string{run1, run2}, reinit)
+
+	// The snapshot is used up.
+	require.Nil(t, w.Update(keyRune('u')))
+	require.Contains(t, stripANSI(w.View().Content), "Nothing to undo")
+}
+
+// gatedHistorySource serves chunks that always have more to read; reads
+// after the first block until released.
+type gatedHistorySource struct {
+	reads   atomic.Int32
+	closed  atomic.Bool
+	started chan struct{}
+	release chan struct{}
+}
+
+func (s *gatedHistorySource) Read(int, time.Duration) (tea.Msg, error) {
+	if s.reads.Add(1) > 1 {
+		s.started <- struct{}{}
+		<-s.release
+	}
+	return leet.ChunkedBatchMsg{
+		Msgs:    []tea.Msg{leet.RunMsg{ID: "aaaaaaaa"}},
+		HasMore: true,
+	}, nil
+}
+
+func (s *gatedHistorySource) Close() { s.closed.Store(true) }
+
+func TestWorkspace_DeselectDuringInitialLoad_CancelsLoadAndClosesReader(t *testing.T) {
+	logger := observability.NewNoOpLogger()
+	cfg := leet.NewConfigManager(filepath.Join(t.TempDir(), "config.json"), logger)
+	wandbDir := t.TempDir()
+	runKey := "run-20260101_000000-aaaaaaaa"
+	createRunWandbFile(t, wandbDir, runKey, nil)
+	runFile := filepath.Join(wandbDir, runKey, "run-aaaaaaaa.wandb")
+
+	w := leet.NewWorkspace(wandbDir, cfg, logger)
+	_ = w.Update(tea.WindowSizeMsg{Width: 200, Height: 60})
+	_ = w.Update(leet.WorkspaceRunDirsMsg{RunKeys: []string{runKey}})
+	require.True(t, w.TestIsRunSelected(runKey))
+
+	src := &gatedHistorySource{
+		started: make(chan struct{}),
+		release: make(chan struct{}),
+	}
+	cmd := w.Update(leet.WorkspaceRunInitMsg{RunKey: runKey, RunPath: runFile, Reader: src})
+	require.NotNil(t, cmd)
+	cmd = w.Update(cmd())
+	require.NotNil(t, cmd, "the load should continue with the next chunk")
+
+	// Deselect while the second chunk is being read.
+	result := make(chan tea.Msg, 1)
+	go func() { result <- cmd() }()
+	<-src.started
+	_ = w.Update(keyRune('X'))
+	require.False(t, src.closed.Load(), "the reader must not be closed mid-read")
+
+	close(src.release)
+	select {
+	case msg := <-result:
+		require.Nil(t, msg, "a canceled chunk should be dropped")
+	case <-time.After(5 * time.Second):
+		t.Fatal("chunk read did not return")
+	}
+	require.True(t, src.closed.Load())
+	require.Equal(t, int32(2), src.reads.Load())
+}
+
+func TestWorkspace_CopySelectedRunIDs_InListOrder(t *testing.T) {
+	logger := observability.NewNoOpLogger()
+	cfg := leet.NewConfigManager(filepath.Join(t.TempDir(), "config.json"), logger)
+	w := leet.NewWorkspace(t.TempDir(), cfg, logger)
+	_ = w.Update(tea.WindowSizeMsg{Width: 200, Height: 60})
+
+	newest := "run-20260103_000000-ccccccc"
+	middle := "run-20260102_000000-bbbbbbb"
+	oldest := "run-20260101_000000-aaaaaaa"
+	w.TestApplyRunKeys([]string{newest, middle, oldest})
+
+	// Select the oldest run first; the copied list still follows the run list.
+	w.TestAttachRun(leet.TestNewWorkspaceRun(oldest), true)
+	w.TestAttachRun(leet.TestNewWorkspaceRun(newest), true)
+	w.TestAttachRun(leet.TestNewWorkspaceRun(middle), false)
+
+	cmd := w.Update(keyRune('I'))
+	require.NotNil(t, cmd)
+	require.Equal(t, "ccccccc\naaaaaaa", w.TestClipboard())
+	require.Contains(t, stripANSI(w.View().Content), "Copied 2 run ID(s) to clipboard")
+}
+
+func TestWorkspace_RunListOrderLiveFirst_SortsRunningRunsFirst(t *testing.T) {
+	logger := observability.NewNoOpLogger()
+	cfg := leet.NewConfigManager(filepath.Join(t.TempDir(), "config.json"), logger)
+	require.NoError(t, cfg.SetRunListOrder(leet.RunListOrderLiveFirst))
+	w := leet.NewWorkspace(t.TempDir(), cfg, logger)
+	_ = w.Update(tea.WindowSizeMsg{Width: 200, Height: 60})
+
+	// Newest first, as scanned.
+	keys := []string{
+		"run-20260209_040404-d",
+		"run-20260209_030303-c",
+		"run-20260209_020202-b",
+		"run-20260209_010101-a",
+	}
+	_ = w.Update(leet.WorkspaceRunDirsMsg{
+		RunKeys:  keys,
+		LiveRuns: map[string]bool{keys[1]: true, keys[3]: true},
+	})
+	require.Equal(t,
+		[]string{keys[1], keys[3], keys[0], keys[2]},
+		w.TestFilteredRunKeys(),
+		"running runs come first, each group newest first")
+
+	// Back to recent order.
+	require.NoError(t, cfg.SetRunListOrder(leet.RunListOrderRecent))
+	_ = w.Update(leet.WorkspaceRunDirsMsg{RunKeys: keys})
+	require.Equal(t, keys, w.TestFilteredRunKeys())
+}
+
+func TestWorkspace_CycleRunListOrder_SortsAndPersists(t *testing.T) {
+	logger := observability.NewNoOpLogger()
+	cfgPath := filepath.Join(t.TempDir(), "config.json")
+	cfg := leet.NewConfigManager(cfgPath, logger)
+	w := leet.NewWorkspace(t.TempDir(), cfg, logger)
+	_ = w.Update(tea.WindowSizeMsg{Width: 200, Height: 60})
+
+	// Newest first, as scanned.
+	keys := []string{
+		"run-20260209_040404-d",
+		"offline-run-20260209_030303-c",
+		"run-20260209_020202-b",
+		"run-20260209_010101-a",
+	}
+	live := map[string]bool{keys[2]: true}
+	_ = w.Update(leet.WorkspaceRunDirsMsg{RunKeys: keys, LiveRuns: live})
+	require.Equal(t, keys, w.TestFilteredRunKeys())
+	status := func() string {
+		lines := strings.Split(stripANSI(w.View().Content), "\n")
+		return lines[len(lines)-1]
+	}
+	sortRuns := func() { _ = w.Update(tea.KeyPressMsg{Code: 's', Mod: tea.ModAlt}) }
+
+	sortRuns()
+	byName := []string{keys[1], keys[3], keys[2], keys[0]}
+	require.Equal(t, byName, w.TestFilteredRunKeys())
+	require.Contains(t, status(), "Runs sorted by name")
+	require.Equal(t, leet.RunListOrderName,
+		leet.NewConfigManager(cfgPath, logger).RunListOrder())
+
+	// The order holds across rescans.
+	_ = w.Update(leet.WorkspaceRunDirsMsg{RunKeys: keys, LiveRuns: live})
+	require.Equal(t, byName, w.TestFilteredRunKeys())
+
+	sortRuns()
+	require.Equal(t,
+		[]string{keys[2], keys[0], keys[1], keys[3]},
+		w.TestFilteredRunKeys())
+	require.Contains(t, status(), "Runs sorted running first")
+
+	sortRuns()
+	require.Equal(t, keys, w.TestFilteredRunKeys())
+	require.Equal(t, leet.RunListOrderRecent, cfg.RunListOrder())
+}
+
+func TestWorkspace_MoveRunInOverlay_ReordersSeriesDrawOrder(t *testing.T) {
+	logger := observability.NewNoOpLogger()
+	cfg := leet.NewConfigManager(filepath.Join(t.TempDir(), "config.json"), logger)
+	w := leet.NewWorkspace(t.TempDir(), cfg, logger)
+	_ = w.Update(tea.WindowSizeMsg{Width: 200, Height: 60})
+
+	w.TestApplyRunKeys([]string{
+		"run-20260101_000000-aaaa",
+		"run-20260101_000001-bbbb",
+		"run-20260101_000002-cccc",
+	})
+	listed := w.TestFilteredRunKeys()
+	require.Len(t, listed, 3)
+
+	// Log in reverse list order so the default draw order differs.
+	for _, key := range slices.Backward(listed) {
+		run := leet.TestNewWorkspaceRun(key)
+		run.TestSetWandbPath(key + ".wandb")
+		w.TestAttachRun(run, true)
+		w.TestHandleWorkspaceRecord(run, leet.HistoryMsg{
+			RunPath: key + ".wandb",
+			Metrics: map[string]leet.MetricData{
+				"loss": {X: []float64{1}, Y: []float64{0.5}},
+			},
+		})
+	}
+	chart := w.TestMetricsGrid().TestChartAt(0, 0)
+	require.NotNil(t, chart)
+
+	w.TestSetFocusTarget(int(leet.FocusTargetRunsList))
+	_ = w.Update(primaryNavMsg(t, leet.NavIntentEnd))
+	require.Equal(t, listed[2], w.TestCurrentRunKey())
+
+	_ = w.Update(tea.KeyPressMsg{Code: tea.KeyUp, Mod: tea.ModShift})
+	require.Equal(t,
+		[]string{listed[0] + ".wandb", listed[2] + ".wandb", listed[1] + ".wandb"},
+		chart.DrawOrder())
+	require.Equal(t, listed[2], w.TestCurrentRunKey(), "the cursor stays put")
+
+	// Moving past the start is a no-op.
+	_ = w.Update(tea.KeyPressMsg{Code: tea.KeyUp, Mod: tea.ModShift})
+	_ = w.Update(tea.KeyPressMsg{Code: tea.KeyUp, Mod: tea.ModShift})
+	require.Equal(t,
+		[]string{listed[2] + ".wandb", listed[0] + ".wandb", listed[1] + ".wandb"},
+		chart.DrawOrder())
+
+	// The arrangement survives new data.
+	_ = w.Update(tea.KeyPressMsg{Code: tea.KeyDown, Mod: tea.ModShift})
+	run := leet.TestNewWorkspaceRun(listed[0])
+	w.TestHandleWorkspaceRecord(run, leet.HistoryMsg{
+		RunPath: listed[0] + ".wandb",
+		Metrics: map[string]leet.MetricData{
+			"loss": {X: []float64{2}, Y: []float64{0.4}},
+		},
+	})
+	require.Equal(t,
+		[]string{listed[0] + ".wandb", listed[2] + ".wandb", listed[1] + ".wandb"},
+		chart.DrawOrder())
+}
+
+func TestWorkspace_MetricsFilterPresets_SaveAndCycle(t *testing.T) {
+	logger := observability.NewNoOpLogger()
+	cfgPath := filepath.Join(t.TempDir(), "config.json")
+	cfg := leet.NewConfigManager(cfgPath, logger)
+	w := leet.NewWorkspace(t.TempDir(), cfg, logger)
+	_ = w.Update(tea.WindowSizeMsg{Width: 200, Height: 60})
+
+	runKey := "run-20260101_000000-aaaa"
+	w.TestApplyRunKeys([]string{runKey})
+	run := leet.TestNewWorkspaceRun(runKey)
+	run.TestSetWandbPath(runKey + ".wandb")
+	w.TestAttachRun(run, true)
+	w.TestHandleWorkspaceRecord(run, leet.HistoryMsg{
+		RunPath: runKey + ".wandb",
+		Metrics: map[string]leet.MetricData{
+			"train/loss": {X: []float64{1}, Y: []float64{0.5}},
+			"val/loss":   {X: []float64{1}, Y: []float64{0.6}},
+			"val/acc":    {X: []float64{1}, Y: []float64{0.7}},
+			"grad_norm":  {X: []float64{1}, Y: []float64{1.2}},
+		},
+	})
+	grid := w.TestMetricsGrid()
+	require.Equal(t, 4, grid.FilteredChartCount())
+
+	clearFilter := func() { _ = w.Update(tea.KeyPressMsg{Code: 'l', Mod: tea.ModCtrl}) }
+	filter := func(query string) {
+		clearFilter()
+		_ = w.Update(keyRune('/'))
+		for _, r := range query {
+			_ = w.Update(keyRune(r))
+		}
+		_ = w.Update(tea.KeyPressMsg{Code: tea.KeyEnter})
+		require.Equal(t, query, grid.FilterQuery())
+	}
+
+	// Nothing to save or cycle yet.
+	_ = w.Update(keyRune('W'))
+	_ = w.Update(keyRune('N'))
+	require.Empty(t, cfg.MetricsFilterPresets())
+	require.Empty(t, grid.FilterQuery())
+
+	filter("loss")
+	_ = w.Update(keyRune('W'))
+	filter("val")
+	_ = w.Update(keyRune('W'))
+	_ = w.Update(keyRune('W')) // saving again does not duplicate
+	require.Equal(t, []string{"loss", "val"},
+		leet.NewConfigManager(cfgPath, logger).MetricsFilterPresets())
+
+	clearFilter()
+	require.Empty(t, grid.FilterQuery())
+
+	_ = w.Update(keyRune('N'))
+	require.Equal(t, "loss", grid.FilterQuery())
+	require.Equal(t, 2, grid.FilteredChartCount())
+
+	_ = w.Update(keyRune('N'))
+	require.Equal(t, "val", grid.FilterQuery())
+	require.Equal(t, 2, grid.FilteredChartCount())
+
+	_ = w.Update(keyRune('N'))
+	require.Equal(t, "loss", grid.FilterQuery())
+}
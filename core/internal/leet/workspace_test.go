@@ -1,16 +1,23 @@
 package leet_test
 
 import (
+	"errors"
+	"math"
 	"os"
 	"path/filepath"
+	"regexp"
+	"strings"
 	"testing"
+	"time"
 
 	tea "charm.land/bubbletea/v2"
+	"charm.land/lipgloss/v2"
 
 	"github.com/stretchr/testify/require"
 
 	"github.com/wandb/wandb/core/internal/leet"
 	"github.com/wandb/wandb/core/internal/observability"
+	spb "github.com/wandb/wandb/core/pkg/service_go_proto"
 )
 
 func TestModel_WorkspaceFilterDoesNotLeakIntoRunView(t *testing.T) {
@@ -252,3 +259,700 @@ func TestWorkspace_Cleanup_ReleasesRunResources(t *testing.T) {
 	// Cleanup is idempotent.
 	w.Cleanup()
 }
+
+func TestWorkspace_WatcherErr_RestartsWatcherWithBackoffAndResumes(t *testing.T) {
+	logger := observability.NewNoOpLogger()
+	cfg := leet.NewConfigManager(filepath.Join(t.TempDir(), "config.json"), logger)
+	w := leet.NewWorkspace(t.TempDir(), cfg, logger)
+	defer w.Cleanup()
+
+	runFile := filepath.Join(t.TempDir(), "run-1.wandb")
+	require.NoError(t, os.WriteFile(runFile, []byte("records"), 0o644))
+
+	src := &stubHistorySource{}
+	run := &leet.WorkspaceRun{Key: "run-1", Reader: src}
+	run.TestSetWandbPath(runFile)
+	run.TestSetWatcherStarted(true)
+	w.TestAttachRun(run, true)
+	w.TestHandleWorkspaceRecord(run, leet.RunMsg{ID: "run-1", DisplayName: "test"})
+
+	cmd := w.Update(leet.WorkspaceWatcherErrMsg{RunKey: "run-1", Err: errors.New("stat failed")})
+	require.NotNil(t, cmd, "a restart should be scheduled")
+	require.False(t, run.TestWatcherActive())
+	require.True(t, w.TestHeartbeatTimerArmed(), "heartbeat should cover the gap")
+
+	// While the file is unavailable, restarts back off until they give up.
+	hidden := runFile + ".moved"
+	require.NoError(t, os.Rename(runFile, hidden))
+	require.NotNil(t, w.Update(leet.WorkspaceWatcherRetryMsg{RunKey: "run-1", Attempt: 1}))
+	require.False(t, run.TestWatcherActive())
+	require.Nil(t, w.Update(leet.WorkspaceWatcherRetryMsg{RunKey: "run-1", Attempt: 5}))
+
+	// Once it is back, the watcher restarts and new records are read.
+	require.NoError(t, os.Rename(hidden, runFile))
+	src.msg = leet.ChunkedBatchMsg{Msgs: []tea.Msg{leet.HistoryMsg{
+		Metrics: map[string]leet.MetricData{
+			"loss": {X: []float64{2}, Y: []float64{0.25}},
+		},
+	}}}
+	cmd = w.Update(leet.WorkspaceWatcherRetryMsg{RunKey: "run-1", Attempt: 2})
+	require.True(t, run.TestWatcherActive())
+	require.NotNil(t, cmd)
+
+	batch, ok := cmd().(tea.BatchMsg)
+	require.True(t, ok)
+	records, ok := batch[0]().(leet.WorkspaceBatchedRecordsMsg)
+	require.True(t, ok)
+	require.Equal(t, "run-1", records.RunKey)
+	require.Len(t, records.Batch.Msgs, 1)
+}
+
+func TestWorkspace_StatusBar_WarnsWhenSelectedRunsSpanProjects(t *testing.T) {
+	logger := observability.NewNoOpLogger()
+	cfg := leet.NewConfigManager(filepath.Join(t.TempDir(), "config.json"), logger)
+	w := leet.NewWorkspace(t.TempDir(), cfg, logger)
+	_ = w.Update(tea.WindowSizeMsg{Width: 240, Height: 40})
+
+	runA := &leet.WorkspaceRun{Key: "run-20260101_000000-aaaa"}
+	runB := &leet.WorkspaceRun{Key: "run-20260101_000001-bbbb"}
+	w.TestAttachRun(runA, true)
+	w.TestAttachRun(runB, true)
+
+	w.TestHandleWorkspaceRecord(runA, leet.RunMsg{ID: "aaaa", Project: "vision"})
+	w.TestHandleWorkspaceRecord(runB, leet.RunMsg{ID: "bbbb", Project: "nlp"})
+
+	view := stripANSI(w.View().Content)
+	require.Contains(t, view, "mixed projects: nlp, vision")
+
+	require.NoError(t, cfg.SetWarnMixedProjects(false))
+	view = stripANSI(w.View().Content)
+	require.NotContains(t, view, "mixed projects")
+	require.NoError(t, cfg.SetWarnMixedProjects(true))
+
+	w.TestHandleWorkspaceRecord(runB, leet.RunMsg{ID: "bbbb", Project: "vision"})
+	view = stripANSI(w.View().Content)
+	require.NotContains(t, view, "mixed projects")
+}
+
+func TestWorkspace_SummaryStrip_ShowsLatestValuesOfConfiguredKeys(t *testing.T) {
+	logger := observability.NewNoOpLogger()
+	cfg := leet.NewConfigManager(filepath.Join(t.TempDir(), "config.json"), logger)
+	require.NoError(t, cfg.SetSummaryStripKeys([]string{"loss", "lr"}))
+	w := leet.NewWorkspace(t.TempDir(), cfg, logger)
+	_ = w.Update(tea.WindowSizeMsg{Width: 240, Height: 40})
+
+	run := &leet.WorkspaceRun{Key: "run-20260101_000000-aaaa"}
+	w.TestAttachRun(run, true)
+	w.TestHandleWorkspaceRecord(run, leet.RunMsg{ID: "aaaa"})
+	w.TestHandleWorkspaceRecord(run, leet.HistoryMsg{
+		Metrics: map[string]leet.MetricData{
+			"loss": {X: []float64{1, 2}, Y: []float64{0.9, 0.5}},
+			"lr":   {X: []float64{1, 2}, Y: []float64{0.01, 0.001}},
+		},
+	})
+
+	view := stripANSI(w.View().Content)
+	require.Contains(t, view, "loss 0.5 · lr 0.001")
+
+	w.TestHandleWorkspaceRecord(run, leet.HistoryMsg{
+		Metrics: map[string]leet.MetricData{
+			"loss": {X: []float64{3}, Y: []float64{0.25}},
+		},
+	})
+
+	view = stripANSI(w.View().Content)
+	require.Contains(t, view, "loss 0.25 · lr 0.001")
+}
+
+func TestWorkspace_ChartLegend_LabelsOverlaidRunsWithTheirColors(t *testing.T) {
+	logger := observability.NewNoOpLogger()
+	cfg := leet.NewConfigManager(filepath.Join(t.TempDir(), "config.json"), logger)
+	require.NoError(t, cfg.SetWorkspaceMetricsRows(1))
+	require.NoError(t, cfg.SetWorkspaceMetricsCols(1))
+	wandbDir := t.TempDir()
+	w := leet.NewWorkspace(wandbDir, cfg, logger)
+	_ = w.Update(tea.WindowSizeMsg{Width: 240, Height: 40})
+
+	keyA, keyB := "run-20260101_000000-aaaa", "run-20260101_000001-bbbb"
+	pathA := filepath.Join(wandbDir, keyA, "run-aaaa.wandb")
+	pathB := filepath.Join(wandbDir, keyB, "run-bbbb.wandb")
+	runA := leet.TestNewWorkspaceRun(keyA)
+	runB := leet.TestNewWorkspaceRun(keyB)
+	runA.TestSetWandbPath(pathA)
+	runB.TestSetWandbPath(pathB)
+	w.TestAttachRun(runA, true)
+	w.TestAttachRun(runB, true)
+	w.TestHandleWorkspaceRecord(runA, leet.RunMsg{ID: "aaaa", DisplayName: "brave-fox-1"})
+
+	for run, path := range map[*leet.WorkspaceRun]string{runA: pathA, runB: pathB} {
+		w.TestHandleWorkspaceRecord(run, leet.HistoryMsg{
+			RunPath: path,
+			Metrics: map[string]leet.MetricData{"loss": seedXY(10)},
+		})
+	}
+
+	require.NotContains(t, stripANSI(w.View().Content), "▬ brave-fox-1")
+
+	w.Update(keyPressMsg('g'))
+	require.True(t, cfg.ShowChartLegend())
+
+	raw := w.View().Content
+	view := stripANSI(raw)
+	require.Contains(t, view, "▬ brave-fox-1")
+	require.Contains(t, view, "▬ bbbb")
+
+	for _, key := range []string{keyA, keyB} {
+		swatch := lipgloss.NewStyle().
+			Foreground(w.TestRunColorForKey(key)).
+			Render("▬")
+		require.Contains(t, raw, swatch)
+	}
+}
+
+func TestWorkspace_ExportChartsSVG_WritesPolylinePerRun(t *testing.T) {
+	logger := observability.NewNoOpLogger()
+	cfg := leet.NewConfigManager(filepath.Join(t.TempDir(), "config.json"), logger)
+	require.NoError(t, cfg.SetWorkspaceMetricsRows(1))
+	require.NoError(t, cfg.SetWorkspaceMetricsCols(1))
+	wandbDir := t.TempDir()
+	w := leet.NewWorkspace(wandbDir, cfg, logger)
+	_ = w.Update(tea.WindowSizeMsg{Width: 240, Height: 40})
+
+	keyA, keyB := "run-20260101_000000-aaaa", "run-20260101_000001-bbbb"
+	pathA := filepath.Join(wandbDir, keyA, "run-aaaa.wandb")
+	pathB := filepath.Join(wandbDir, keyB, "run-bbbb.wandb")
+	runA := leet.TestNewWorkspaceRun(keyA)
+	runB := leet.TestNewWorkspaceRun(keyB)
+	runA.TestSetWandbPath(pathA)
+	runB.TestSetWandbPath(pathB)
+	w.TestAttachRun(runA, true)
+	w.TestAttachRun(runB, true)
+	w.TestHandleWorkspaceRecord(runA, leet.RunMsg{ID: "aaaa", DisplayName: "brave-fox-1"})
+	w.TestHandleWorkspaceRecord(runA, leet.HistoryMsg{
+		RunPath: pathA,
+		Metrics: map[string]leet.MetricData{"loss": seedXY(10)},
+	})
+	w.TestHandleWorkspaceRecord(runB, leet.HistoryMsg{
+		RunPath: pathB,
+		Metrics: map[string]leet.MetricData{"loss": seedXY(6)},
+	})
+
+	w.Update(keyPressMsg('V'))
+	require.Contains(t, stripANSI(w.View().Content), "Wrote chart to")
+
+	data, err := os.ReadFile(filepath.Join(wandbDir, "chart-loss.svg"))
+	require.NoError(t, err)
+	svg := string(data)
+	require.True(t, strings.HasPrefix(svg, "<svg "))
+	require.Contains(t, svg, ">brave-fox-1</text>")
+
+	var counts []int
+	for _, m := range regexp.MustCompile(`<polyline [^>]*points="([^"]*)"`).FindAllStringSubmatch(svg, -1) {
+		counts = append(counts, len(strings.Fields(m[1])))
+	}
+	require.ElementsMatch(t, []int{10, 6}, counts)
+}
+
+func TestWorkspace_CompactRunKeys_ShowsShortNamesButSelectsFullKey(t *testing.T) {
+	logger := observability.NewNoOpLogger()
+	cfg := leet.NewConfigManager(filepath.Join(t.TempDir(), "config.json"), logger)
+	require.NoError(t, cfg.SetCompactRunKeys(true))
+
+	wandbDir := t.TempDir()
+	run1 := "run-20250731_170606-iazb7i1k"
+	run2 := "run-20250731_170607-zzzzzzzz"
+	run2Path := writeWorkspaceRunWandbFile(t, wandbDir, run2, "zzzzzzzz", 1.0)
+
+	w := leet.NewWorkspace(wandbDir, cfg, logger)
+	_ = w.Update(tea.WindowSizeMsg{Width: 160, Height: 40})
+	_ = w.Update(leet.WorkspaceRunDirsMsg{RunKeys: []string{run1, run2}})
+	_ = w.Update(leet.WorkspaceRunOverviewPreloadedMsg{
+		RunKey: run1,
+		Run:    &leet.RunMsg{ID: "iazb7i1k", DisplayName: "bright-sky-7"},
+	})
+
+	view := stripANSI(w.View().Content)
+	require.Contains(t, view, "bright-sky-7", "display name from the run record")
+	require.Contains(t, view, "zzzzzzzz", "run ID suffix when no display name is known")
+	require.NotContains(t, view, "20250731_1706")
+
+	// Selection still resolves the full key and its .wandb file.
+	w.Update(tea.KeyPressMsg{Code: tea.KeyDown})
+	require.Equal(t, run2, w.TestCurrentRunKey())
+	cmd := w.Update(tea.KeyPressMsg{Code: tea.KeySpace})
+	require.NotNil(t, cmd)
+	require.True(t, w.TestIsRunSelected(run2))
+
+	initMsg, ok := cmd().(leet.WorkspaceRunInitMsg)
+	require.True(t, ok)
+	t.Cleanup(initMsg.Reader.Close)
+	require.Equal(t, run2, initMsg.RunKey)
+	require.Equal(t, run2Path, initMsg.RunPath)
+
+	require.NoError(t, cfg.SetCompactRunKeys(false))
+	require.Contains(t, stripANSI(w.View().Content), run1)
+}
+
+func TestWorkspace_CompactRunKeys_DisambiguatesSharedDisplayNames(t *testing.T) {
+	logger := observability.NewNoOpLogger()
+	cfg := leet.NewConfigManager(filepath.Join(t.TempDir(), "config.json"), logger)
+	require.NoError(t, cfg.SetCompactRunKeys(true))
+
+	w := leet.NewWorkspace(t.TempDir(), cfg, logger)
+	_ = w.Update(tea.WindowSizeMsg{Width: 160, Height: 40})
+	runKeys := []string{
+		"run-20250731_170606-aaaaaaaa",
+		"run-20250731_170607-bbbbbbbb",
+		"run-20250731_170608-cccccccc",
+	}
+	names := []string{"baseline", "baseline", "sweep-3"}
+	_ = w.Update(leet.WorkspaceRunDirsMsg{RunKeys: runKeys})
+	for i, runKey := range runKeys {
+		_ = w.Update(leet.WorkspaceRunOverviewPreloadedMsg{
+			RunKey: runKey,
+			Run:    &leet.RunMsg{ID: leet.TestExtractRunID(runKey), DisplayName: names[i]},
+		})
+	}
+
+	// Off by default: shared names are listed as they are.
+	view := stripANSI(w.View().Content)
+	require.NotContains(t, view, "(aaaaaaaa)")
+	require.NotContains(t, view, "(bbbbbbbb)")
+
+	require.NoError(t, cfg.SetDisambiguateRunNames(true))
+	view = stripANSI(w.View().Content)
+	require.Contains(t, view, "baseline (aaaaaaaa)")
+	require.Contains(t, view, "baseline (bbbbbbbb)")
+	require.Contains(t, view, "sweep-3")
+	require.NotContains(t, view, "sweep-3 (")
+}
+
+func TestWorkspace_LiveUpdateSummary_CountsRecordKindsInBatch(t *testing.T) {
+	logger := observability.NewNoOpLogger()
+	cfg := leet.NewConfigManager(filepath.Join(t.TempDir(), "config.json"), logger)
+	w := leet.NewWorkspace(t.TempDir(), cfg, logger)
+	_ = w.Update(tea.WindowSizeMsg{Width: 240, Height: 40})
+
+	run := &leet.WorkspaceRun{Key: "run-20260101_000000-live"}
+	w.TestAttachRun(run, true)
+	w.TestHandleWorkspaceRecord(run, leet.RunMsg{ID: "live", DisplayName: "live-run"})
+
+	history := func(step float64) leet.HistoryMsg {
+		return leet.HistoryMsg{Metrics: map[string]leet.MetricData{
+			"loss": {X: []float64{step}, Y: []float64{1 / step}},
+		}}
+	}
+	batch := leet.WorkspaceBatchedRecordsMsg{
+		RunKey: run.Key,
+		Batch: leet.BatchedRecordsMsg{Msgs: []tea.Msg{
+			history(1), history(2),
+			leet.ConsoleLogMsg{Text: "a"},
+			history(3),
+			leet.ConsoleLogMsg{Text: "b"},
+			leet.StatsMsg{},
+		}},
+	}
+
+	// Off by default.
+	_ = w.Update(batch)
+	require.NotContains(t, stripANSI(w.View().Content), "+3 history")
+
+	require.NoError(t, cfg.SetLiveUpdateSummary(true))
+	_ = w.Update(batch)
+	require.Contains(t, stripANSI(w.View().Content),
+		"live-run: +3 history, +2 logs, +1 stats")
+}
+
+func TestWorkspace_RunList_ShowsLastErrorForFailedRun(t *testing.T) {
+	logger := observability.NewNoOpLogger()
+	cfg := leet.NewConfigManager(filepath.Join(t.TempDir(), "config.json"), logger)
+	w := leet.NewWorkspace(t.TempDir(), cfg, logger)
+	_ = w.Update(tea.WindowSizeMsg{Width: 240, Height: 60})
+
+	runKey := "run-20260101_000002-fail"
+	w.TestApplyRunKeys([]string{runKey})
+	run := leet.TestNewWorkspaceRun(runKey)
+	w.TestAttachRun(run, true)
+
+	w.TestHandleWorkspaceRecord(run, leet.ConsoleLogMsg{
+		Text:     "Traceback (most recent call last):\nRuntimeError: CUDA OOM\n",
+		IsStderr: true,
+	})
+	w.TestHandleWorkspaceRecord(run, leet.ConsoleLogMsg{Text: "epoch 3 done\n"})
+
+	// The error only shows once the run has failed.
+	require.NotContains(t, stripANSI(w.View().Content), "✗ RuntimeError")
+
+	w.TestHandleWorkspaceRecord(run, leet.FileCompleteMsg{ExitCode: 1})
+	require.Contains(t, stripANSI(w.View().Content), "✗ RuntimeError: CUDA OOM")
+}
+
+func TestWorkspace_RunList_MarksStaleRun(t *testing.T) {
+	logger := observability.NewNoOpLogger()
+	cfg := leet.NewConfigManager(filepath.Join(t.TempDir(), "config.json"), logger)
+	w := leet.NewWorkspace(t.TempDir(), cfg, logger)
+	_ = w.Update(tea.WindowSizeMsg{Width: 240, Height: 60})
+
+	runKey := "run-20260101_000003-hung"
+	w.TestApplyRunKeys([]string{runKey})
+	run := leet.TestNewWorkspaceRun(runKey)
+	run.TestSetWatcherStarted(true)
+	w.TestAttachRun(run, true)
+	w.TestHandleWorkspaceRecord(run, leet.RunMsg{ID: "hung"})
+
+	// A run that just wrote a record is not stale.
+	w.TestSyncStaleRuns(0)
+	require.NotContains(t, stripANSI(w.View().Content), "⧗")
+
+	w.TestSyncStaleRuns(time.Hour)
+	view := stripANSI(w.View().Content)
+	require.Contains(t, view, "⧗ ")
+	require.Contains(t, view, "State: Stale")
+
+	// A new record brings the run back.
+	w.TestHandleWorkspaceRecord(run, leet.HistoryMsg{})
+	w.TestSyncStaleRuns(0)
+	view = stripANSI(w.View().Content)
+	require.NotContains(t, view, "⧗")
+	require.Contains(t, view, "State: Running")
+}
+
+func TestWorkspace_SystemMetricCompare_ShowsPeakPerSelectedRun(t *testing.T) {
+	logger := observability.NewNoOpLogger()
+	cfg := leet.NewConfigManager(filepath.Join(t.TempDir(), "config.json"), logger)
+	w := leet.NewWorkspace(t.TempDir(), cfg, logger)
+	_ = w.Update(tea.WindowSizeMsg{Width: 240, Height: 60})
+
+	const gib = 1 << 30
+	runs := []struct {
+		key  string
+		used []float64
+	}{
+		{"run-20260101_000001-small", []float64{2 * gib, 3 * gib, 1 * gib}},
+		{"run-20260101_000002-large", []float64{10 * gib, 12 * gib, 11 * gib}},
+	}
+	w.TestApplyRunKeys([]string{runs[0].key, runs[1].key})
+	w.TestForceExpandConsoleLogsPane(10)
+	for _, r := range runs {
+		run := leet.TestNewWorkspaceRun(r.key)
+		w.TestAttachRun(run, true)
+		for i, v := range r.used {
+			w.TestHandleWorkspaceRecord(run, leet.StatsMsg{
+				Timestamp: int64(1000 + i),
+				Metrics:   map[string]float64{"gpu.0.memoryUsed": v},
+			})
+		}
+	}
+
+	// Without a focused system chart there is nothing to compare.
+	_ = w.Update(keyRune('C'))
+	require.NotContains(t, stripANSI(w.View().Content), "across 2 selected runs")
+
+	w.TestFocusSystemChart("GPU Memory Used (B)")
+	_ = w.Update(keyRune('C'))
+	view := stripANSI(w.View().Content)
+	require.Contains(t, view, "GPU Memory Used (B) across 2 selected runs")
+	require.Regexp(t, `small\s+latest 1(\.0)? ?GiB\s+peak 3(\.0)? ?GiB`, view)
+	require.Regexp(t, `large\s+latest 11(\.0)? ?GiB\s+peak 12(\.0)? ?GiB`, view)
+
+	_ = w.Update(keyRune('C'))
+	require.NotContains(t, stripANSI(w.View().Content), "across 2 selected runs")
+}
+
+func TestWorkspace_ConsoleErrorsView_CollectsErrorsFromSelectedRuns(t *testing.T) {
+	logger := observability.NewNoOpLogger()
+	cfg := leet.NewConfigManager(filepath.Join(t.TempDir(), "config.json"), logger)
+	w := leet.NewWorkspace(t.TempDir(), cfg, logger)
+	_ = w.Update(tea.WindowSizeMsg{Width: 240, Height: 60})
+
+	ts := time.Date(2026, time.January, 1, 0, 0, 0, 0, time.UTC)
+	runs := []struct{ key, name, line string }{
+		{"run-20260101_000001-alpha", "alpha", "ValueError: bad lr"},
+		{"run-20260101_000002-beta", "beta", "RuntimeError: CUDA OOM"},
+	}
+	var keys []string
+	for _, r := range runs {
+		keys = append(keys, r.key)
+	}
+	w.TestApplyRunKeys(keys)
+	for i, r := range runs {
+		run := leet.TestNewWorkspaceRun(r.key)
+		w.TestAttachRun(run, true)
+		w.TestHandleWorkspaceRecord(run, leet.RunMsg{ID: r.name, DisplayName: r.name})
+		w.TestHandleWorkspaceRecord(run, leet.ConsoleLogMsg{
+			Text: "epoch 3 done\n",
+			Time: ts,
+		})
+		w.TestHandleWorkspaceRecord(run, leet.ConsoleLogMsg{
+			Text:     r.line + "\n",
+			IsStderr: true,
+			Time:     ts.Add(time.Duration(i+1) * time.Second),
+		})
+	}
+	w.TestForceExpandConsoleLogsPane(10)
+
+	_ = w.Update(keyRune('e'))
+	view := stripANSI(w.View().Content)
+	require.Contains(t, view, "errors in 2 selected runs")
+	require.Contains(t, view, "[alpha] ValueError: bad lr")
+	require.Contains(t, view, "[beta] RuntimeError: CUDA OOM")
+	require.NotContains(t, view, "epoch 3 done")
+	require.Less(t,
+		strings.Index(view, "[alpha]"), strings.Index(view, "[beta]"),
+		"expected errors in time order")
+
+	// Toggling back shows the current run's logs again.
+	_ = w.Update(keyRune('e'))
+	require.NotContains(t, stripANSI(w.View().Content), "[alpha]")
+}
+
+func TestWorkspace_SystemMetricsMouse_InspectionReportsHoveredPoint(t *testing.T) {
+	logger := observability.NewNoOpLogger()
+	cfg := leet.NewConfigManager(filepath.Join(t.TempDir(), "config.json"), logger)
+	_ = cfg.SetSystemRows(1)
+	_ = cfg.SetSystemCols(1)
+
+	w := leet.NewWorkspace(t.TempDir(), cfg, logger)
+	_ = w.Update(tea.WindowSizeMsg{Width: 200, Height: 60})
+
+	runKey := "run-20260209_010101-abcdefg"
+	w.TestApplyRunKeys([]string{runKey})
+	run := leet.TestNewWorkspaceRun(runKey)
+	w.TestAttachRun(run, true)
+
+	base := time.Unix(1_700_000_000, 0)
+	for i := range 6 {
+		w.TestHandleWorkspaceRecord(run, leet.StatsMsg{
+			Timestamp: base.Add(time.Duration(i) * time.Minute).Unix(),
+			Metrics:   map[string]float64{"cpu": 10 + float64(i)},
+		})
+	}
+	w.TestForceExpandSystemMetricsPane(20)
+	_ = w.Update(tea.WindowSizeMsg{Width: 200, Height: 60})
+
+	grid := w.TestSystemMetrics()[runKey]
+	require.NotNil(t, grid)
+	chart := grid.TestChartAt(0, 0)
+	require.NotNil(t, chart)
+	dims := grid.TestGridDims()
+
+	screenX := func(ts time.Time) int {
+		relPX := int(math.Round(
+			(float64(ts.Unix()) - chart.ViewMinX()) / (chart.ViewMaxX() - chart.ViewMinX()) *
+				float64(chart.GraphWidth()),
+		))
+		originX, _ := w.TestSystemMetricsOrigin()
+		return originX + computeSystemAdjustedX(t, chart, dims.CellWWithPadding, 0, relPX)
+	}
+	_, originY := w.TestSystemMetricsOrigin()
+	y := originY + 2
+
+	_ = w.Update(tea.MouseClickMsg{X: screenX(base.Add(time.Minute)), Y: y, Button: tea.MouseRight})
+	require.True(t, chart.IsInspecting())
+
+	hovered := base.Add(3 * time.Minute)
+	_ = w.Update(tea.MouseMotionMsg{X: screenX(hovered), Y: y, Button: tea.MouseRight})
+	x, value, active := chart.InspectionData()
+	require.True(t, active)
+	require.InDelta(t, float64(hovered.Unix()), x, 1e-9)
+	require.InDelta(t, 13.0, value, 1e-9)
+
+	// Releasing reports the point without touching the clipboard.
+	_ = w.Update(tea.MouseReleaseMsg{X: screenX(hovered), Y: y, Button: tea.MouseRight})
+	require.False(t, chart.IsInspecting())
+	want := hovered.UTC().Format(time.RFC3339) + ", 13"
+	require.Empty(t, w.TestClipboard())
+	require.Contains(t, stripANSI(w.View().Content), "Process CPU (%): "+want+" (Y to copy)")
+
+	// Copying is an explicit action.
+	cmd := w.Update(keyRune('Y'))
+	require.NotNil(t, cmd)
+	require.Equal(t, want, w.TestClipboard())
+	require.Contains(t, stripANSI(w.View().Content), "("+want+") to clipboard")
+}
+
+func TestWorkspace_RunListTemplate_RendersIDAndSummaryMetric(t *testing.T) {
+	logger := observability.NewNoOpLogger()
+	cfg := leet.NewConfigManager(filepath.Join(t.TempDir(), "config.json"), logger)
+	require.NoError(t, cfg.SetRunListTemplate("{id} — loss={summary.loss} {summary.missing}|{{x}}"))
+
+	w := leet.NewWorkspace(t.TempDir(), cfg, logger)
+	_ = w.Update(tea.WindowSizeMsg{Width: 240, Height: 40})
+
+	runKey := "run-20260101_000001-abc123"
+	w.TestApplyRunKeys([]string{runKey})
+	run := leet.TestNewWorkspaceRun(runKey)
+	w.TestAttachRun(run, true)
+	w.TestHandleWorkspaceRecord(run, leet.RunMsg{ID: "abc123"})
+	w.TestHandleWorkspaceRecord(run, leet.SummaryMsg{Summary: []*spb.SummaryRecord{{
+		Update: []*spb.SummaryItem{{NestedKey: []string{"loss"}, ValueJson: "0.25"}},
+	}}})
+
+	view := stripANSI(w.View().Content)
+	require.Contains(t, view, "abc123 — loss=0.25 |{x}")
+	require.NotContains(t, view, runKey)
+}
+
+func TestWorkspace_CycleRunListMetric_ChangesDisplayedValue(t *testing.T) {
+	logger := observability.NewNoOpLogger()
+	cfgPath := filepath.Join(t.TempDir(), "config.json")
+	cfg := leet.NewConfigManager(cfgPath, logger)
+
+	w := leet.NewWorkspace(t.TempDir(), cfg, logger)
+	_ = w.Update(tea.WindowSizeMsg{Width: 240, Height: 40})
+
+	runKey := "run-20260101_000001-abc123"
+	w.TestApplyRunKeys([]string{runKey})
+	run := leet.TestNewWorkspaceRun(runKey)
+	w.TestAttachRun(run, true)
+	w.TestHandleWorkspaceRecord(run, leet.SummaryMsg{Summary: []*spb.SummaryRecord{{
+		Update: []*spb.SummaryItem{
+			{NestedKey: []string{"acc"}, ValueJson: "0.875"},
+			{NestedKey: []string{"loss"}, ValueJson: "0.25"},
+			{NestedKey: []string{"_runtime"}, ValueJson: "12"},
+		},
+	}}})
+
+	runRow := func() string {
+		for line := range strings.SplitSeq(stripANSI(w.View().Content), "\n") {
+			if strings.Contains(line, runKey) {
+				return line
+			}
+		}
+		t.Fatalf("run %q not in the view", runKey)
+		return ""
+	}
+	press := func() {
+		_ = w.Update(tea.KeyPressMsg{Code: 'S', Text: "S"})
+	}
+
+	require.NotContains(t, runRow(), "0.875")
+
+	press()
+	require.Contains(t, runRow(), runKey+" 0.875")
+	require.Equal(t, "acc", cfg.RunListMetric())
+
+	press()
+	require.Contains(t, runRow(), runKey+" 0.25")
+	require.NotContains(t, runRow(), "0.875")
+
+	// The internal _runtime key is skipped, so the cycle wraps to off.
+	press()
+	require.NotContains(t, runRow(), "0.25")
+	require.Empty(t, cfg.RunListMetric())
+
+	// The chosen metric is persisted.
+	press()
+	reloaded := leet.NewConfigManager(cfgPath, logger)
+	require.Equal(t, "acc", reloaded.RunListMetric())
+}
+
+func TestWorkspace_LogsOnlyRun_ShowsNoMetricsPlaceholderAndLogs(t *testing.T) {
+	logger := observability.NewNoOpLogger()
+	cfg := leet.NewConfigManager(filepath.Join(t.TempDir(), "config.json"), logger)
+	w := leet.NewWorkspace(t.TempDir(), cfg, logger)
+	_ = w.Update(tea.WindowSizeMsg{Width: 200, Height: 50})
+
+	runKey := "run-20260101_000000-dataprep"
+	w.TestApplyRunKeys([]string{runKey})
+	run := leet.TestNewWorkspaceRun(runKey)
+	w.TestAttachRun(run, true)
+	w.TestHandleWorkspaceRecord(run, leet.RunMsg{ID: "dataprep"})
+	w.TestHandleWorkspaceRecord(run, leet.ConsoleLogMsg{Text: "shard 1/2 tokenized\n"})
+	w.TestHandleWorkspaceRecord(run, leet.ConsoleLogMsg{Text: "shard 2/2 tokenized\n"})
+
+	view := stripANSI(w.View().Content)
+	require.Contains(t, view, "No metrics logged. Press 4 to show console output.")
+	require.NotContains(t, view, "Select a run")
+
+	w.TestForceExpandConsoleLogsPane(10)
+	view = stripANSI(w.View().Content)
+	require.Contains(t, view, "No metrics logged. Console output is shown below.")
+	require.Contains(t, view, "shard 1/2 tokenized")
+	require.Contains(t, view, "shard 2/2 tokenized")
+}
+
+func TestWorkspace_SweepProgress_ShowsFractionOfRunsDone(t *testing.T) {
+	logger := observability.NewNoOpLogger()
+	cfg := leet.NewConfigManager(filepath.Join(t.TempDir(), "config.json"), logger)
+	w := leet.NewWorkspace(t.TempDir(), cfg, logger)
+	_ = w.Update(tea.WindowSizeMsg{Width: 240, Height: 40})
+
+	runKeys := []string{
+		"run-20260101_000000-done1",
+		"run-20260101_000001-done2",
+		"run-20260101_000002-fail",
+		"run-20260101_000003-live",
+		"run-20260101_000004-new",
+	}
+	w.TestApplyRunKeys(runKeys)
+	exitCodes := map[string]int32{runKeys[0]: 0, runKeys[1]: 0, runKeys[2]: 1}
+	for _, key := range runKeys[:4] {
+		run := leet.TestNewWorkspaceRun(key)
+		w.TestAttachRun(run, true)
+		w.TestHandleWorkspaceRecord(run, leet.RunMsg{ID: key})
+		if code, ok := exitCodes[key]; ok {
+			w.TestHandleWorkspaceRecord(run, leet.FileCompleteMsg{ExitCode: code})
+		}
+	}
+
+	require.NotContains(t, stripANSI(w.View().Content), "/5 done", "progress is off by default")
+
+	require.NoError(t, cfg.SetSweepProgress(true))
+	require.Contains(t, stripANSI(w.View().Content), "██████░░░░ 3/5 done, 1 running")
+}
+
+func TestWorkspace_ConsoleLogsVisibleOnStartup_ExpandsPaneInInitialView(t *testing.T) {
+	logger := observability.NewNoOpLogger()
+	cfg := leet.NewConfigManager(filepath.Join(t.TempDir(), "config.json"), logger)
+	require.NoError(t, cfg.SetWorkspaceConsoleLogsVisible(true))
+
+	w := leet.NewWorkspace(t.TempDir(), cfg, logger)
+	_ = w.Update(tea.WindowSizeMsg{Width: 120, Height: 40})
+	require.True(t, w.TestConsoleLogsPaneExpanded())
+
+	runKey := "run-20260101_000000-dataprep"
+	w.TestApplyRunKeys([]string{runKey})
+	run := leet.TestNewWorkspaceRun(runKey)
+	w.TestAttachRun(run, true)
+	w.TestHandleWorkspaceRecord(run, leet.ConsoleLogMsg{Text: "shard 1/2 tokenized\n"})
+
+	view := stripANSI(w.View().Content)
+	require.Contains(t, view, "Console Logs")
+	require.Contains(t, view, "shard 1/2 tokenized")
+
+	// The pre-expanded pane takes part in focus cycling like a toggled one.
+	for range 8 {
+		if w.TestConsoleLogsPaneActive() {
+			break
+		}
+		w.Update(tea.KeyPressMsg{Code: tea.KeyTab})
+	}
+	require.True(t, w.TestConsoleLogsPaneActive())
+}
+
+func TestWorkspace_OverviewAutoExpand_ExpandsOnSelectUntilCollapsedByHand(t *testing.T) {
+	logger := observability.NewNoOpLogger()
+	cfg := leet.NewConfigManager(filepath.Join(t.TempDir(), "config.json"), logger)
+	require.NoError(t, cfg.SetWorkspaceOverviewVisible(false))
+	require.NoError(t, cfg.SetWorkspaceOverviewAutoExpand(true))
+
+	w := leet.NewWorkspace(t.TempDir(), cfg, logger)
+	_ = w.Update(tea.WindowSizeMsg{Width: 200, Height: 60})
+	require.False(t, w.TestOverviewSidebarTargetVisible())
+
+	run1 := "run-20250731_170606-iazb7i1k"
+	run2 := "run-20250731_170607-zzzzzzzz"
+	_ = w.Update(leet.WorkspaceRunDirsMsg{RunKeys: []string{run1, run2}})
+	require.True(t, w.TestIsRunSelected(run1))
+	require.True(t, w.TestOverviewSidebarTargetVisible(),
+		"selecting a run should expand the collapsed overview")
+
+	// Once collapsed by hand, later selections leave it collapsed.
+	_ = w.Update(keyRune(']'))
+	require.False(t, w.TestOverviewSidebarTargetVisible())
+	_ = w.Update(tea.KeyPressMsg{Code: tea.KeyDown})
+	_ = w.Update(tea.KeyPressMsg{Code: tea.KeySpace})
+	require.True(t, w.TestIsRunSelected(run2))
+	require.False(t, w.TestOverviewSidebarTargetVisible())
+}
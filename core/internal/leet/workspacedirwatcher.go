@@ -4,7 +4,9 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"maps"
 	"os"
+	"path/filepath"
 	"slices"
 	"strings"
 	"time"
@@ -26,6 +28,11 @@ const (
 
 	// maxConcurrentPreloads limits the number of concurrent run record preloads.
 	maxConcurrentPreloads = 4
+
+	// runLiveWindow is how recently an unloaded run's .wandb file must have
+	// been written for the run to count as running. Live runs write system
+	// metrics well within this window even when not logging.
+	runLiveWindow = time.Minute
 )
 
 var errRunRecordNotFound = errors.New("run record not found")
@@ -98,17 +105,48 @@ func (p *runOverviewPreloader) MarkDone(runKey string) {
 }
 
 func (w *Workspace) pollWandbDirCmd(delay time.Duration) tea.Cmd {
-	wandbDir := w.wandbDir
+	wandbDir, runGlob := w.wandbDir, w.runGlob
+	liveFirst := w.config.RunListOrder() == RunListOrderLiveFirst
+	statuses := maps.Clone(w.runStatuses)
 	if delay < 0 {
 		delay = 0
 	}
-	return tea.Tick(delay, func(time.Time) tea.Msg {
-		runKeys, err := scanWandbRunDirs(wandbDir)
-		return WorkspaceRunDirsMsg{RunKeys: runKeys, Err: err}
+	return tea.Tick(delay, func(now time.Time) tea.Msg {
+		runKeys, err := scanWandbRunDirs(wandbDir, runGlob)
+		msg := WorkspaceRunDirsMsg{RunKeys: runKeys, Err: err}
+		if liveFirst && err == nil {
+			msg.LiveRuns = scanLiveRuns(wandbDir, runKeys, now)
+		}
+		if err == nil {
+			msg.runStatuses = scanRunStatuses(wandbDir, runKeys, statuses, now)
+		}
+		return msg
 	})
 }
 
-func scanWandbRunDirs(wandbDir string) ([]string, error) {
+// scanLiveRuns returns the runs whose .wandb file was modified within
+// runLiveWindow of now.
+//
+// This is a cheap stand-in for the run state of runs that are not loaded.
+func scanLiveRuns(wandbDir string, runKeys []string, now time.Time) map[string]bool {
+	live := make(map[string]bool)
+	for _, runKey := range runKeys {
+		path := runWandbFile(wandbDir, runKey)
+		if path == "" {
+			continue
+		}
+		info, err := os.Stat(path)
+		if err == nil && now.Sub(info.ModTime()) < runLiveWindow {
+			live[runKey] = true
+		}
+	}
+	return live
+}
+
+// scanWandbRunDirs lists the run directories in wandbDir, most recent first.
+//
+// If runGlob is non-empty, only directories whose names match it are listed.
+func scanWandbRunDirs(wandbDir, runGlob string) ([]string, error) {
 	if wandbDir == "" {
 		return nil, nil
 	}
@@ -124,21 +162,28 @@ func scanWandbRunDirs(wandbDir string) ([]string, error) {
 		if !strings.HasPrefix(name, "run-") && !strings.HasPrefix(name, "offline-run-") {
 			continue
 		}
+		if runGlob != "" {
+			if ok, _ := filepath.Match(runGlob, name); !ok {
+				continue
+			}
+		}
 		runKeys = append(runKeys, name)
 	}
 
-	// Sort by timestamp in descending order (most recent first).
-	slices.SortFunc(runKeys, func(a, b string) int {
-		ta, tb := parseRunDirTimestamp(a), parseRunDirTimestamp(b)
-		if c := tb.Compare(ta); c != 0 {
-			return c
-		}
-		return strings.Compare(a, b)
-	})
-
+	slices.SortFunc(runKeys, compareRunDirsNewestFirst)
 	return runKeys, nil
 }
 
+// compareRunDirsNewestFirst orders run folder names by the timestamp in
+// their names, most recent first, breaking ties by name.
+func compareRunDirsNewestFirst(a, b string) int {
+	ta, tb := parseRunDirTimestamp(a), parseRunDirTimestamp(b)
+	if c := tb.Compare(ta); c != 0 {
+		return c
+	}
+	return strings.Compare(a, b)
+}
+
 // parseRunDirTimestamp extracts the timestamp from a run folder name.
 //
 // Expected formats: "run-YYYYMMDD_HHMMSS-runid" or "offline-run-YYYYMMDD_HHMMSS-runid"
@@ -173,12 +218,17 @@ func (w *Workspace) handleWorkspaceRunDirs(msg WorkspaceRunDirsMsg) tea.Cmd {
 		return pollCmd
 	}
 
-	var selectLatestCmd tea.Cmd
-	if !w.runKeysEqual(msg.RunKeys) {
-		w.applyRunKeys(msg.RunKeys)
-		// Auto-select the latest run on initial workspace load.
-		w.autoSelectLatestRunOnLoad.Do(
-			func() { selectLatestCmd = w.toggleRunSelected(msg.RunKeys[0]) })
+	w.liveRuns = msg.LiveRuns
+	w.mergeRunStatuses(msg.RunKeys, msg.runStatuses)
+	runKeys := w.orderRunKeys(msg.RunKeys)
+
+	var selectCmd tea.Cmd
+	if !w.runKeysEqual(runKeys) {
+		w.applyRunKeys(runKeys)
+		// Restore the saved selection, or select the latest run, on
+		// initial workspace load.
+		w.restoreSelectionOnLoad.Do(
+			func() { selectCmd = w.restoreSelection(msg.RunKeys) })
 	}
 	// Enqueue missing run overviews (even if the run list is unchanged).
 	// This makes new run overviews eventually consistent even if the .wandb file
@@ -189,7 +239,7 @@ func (w *Workspace) handleWorkspaceRunDirs(msg WorkspaceRunDirsMsg) tea.Cmd {
 	if startCmd == nil {
 		return pollCmd
 	}
-	return tea.Batch(pollCmd, startCmd, selectLatestCmd)
+	return tea.Batch(pollCmd, startCmd, selectCmd)
 }
 
 // enqueueMissingRunOverviews queues runs that don't yet have overview state and
@@ -301,6 +351,40 @@ func (w *Workspace) handleWorkspaceRunOverviewPreloaded(
 	return w.startRunOverviewPreloadsCmd()
 }
 
+// orderRunKeys sorts runKeys, given newest first, by the configured run
+// list order.
+func (w *Workspace) orderRunKeys(runKeys []string) []string {
+	ordered := slices.Clone(runKeys)
+	switch w.config.RunListOrder() {
+	case RunListOrderName:
+		slices.Sort(ordered)
+	case RunListOrderLiveFirst:
+		slices.SortStableFunc(ordered, func(a, b string) int {
+			la, lb := w.isRunLive(a), w.isRunLive(b)
+			switch {
+			case la && !lb:
+				return -1
+			case lb && !la:
+				return 1
+			default:
+				return 0
+			}
+		})
+	}
+	return ordered
+}
+
+// isRunLive reports whether a run is running.
+//
+// Loaded runs use their known state; others fall back to whether their
+// .wandb file was written recently at the last scan.
+func (w *Workspace) isRunLive(runKey string) bool {
+	if run := w.runsByKey[runKey]; run != nil && run.state != RunStateUnknown {
+		return run.state == RunStateRunning
+	}
+	return w.liveRuns[runKey]
+}
+
 func (w *Workspace) runKeysEqual(runKeys []string) bool {
 	if len(runKeys) != len(w.runs.Items) {
 		return false
@@ -1,8 +1,10 @@
 package leet_test
 
 import (
+	"fmt"
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 
 	tea "charm.land/bubbletea/v2"
@@ -209,3 +211,190 @@ func TestWorkspace_PreloadRunOverview_AllRunsPopulated(t *testing.T) {
 			"overview project mismatch for %s", r.key)
 	}
 }
+
+func TestModel_RunGlob_LimitsWorkspaceScan(t *testing.T) {
+	logger := observability.NewNoOpLogger()
+	cfg := leet.NewConfigManager(filepath.Join(t.TempDir(), "config.json"), logger)
+
+	wandbDir := t.TempDir()
+	for _, runKey := range []string{
+		"run-20260209_010101-aaaaaaa",
+		"run-20260209_020202-bbbbbbb",
+		"run-20260210_010101-ccccccc",
+		"offline-run-20260209_030303-ddddddd",
+	} {
+		require.NoError(t, os.MkdirAll(filepath.Join(wandbDir, runKey), 0o755))
+	}
+
+	m := leet.NewModel(leet.ModelParams{
+		WandbDir: wandbDir,
+		RunGlob:  "run-20260209_*",
+		Config:   cfg,
+		Logger:   logger,
+	})
+	w := m.TestWorkspace()
+	w.Update(w.TestPollWandbDir())
+
+	require.Equal(t, []string{
+		"run-20260209_020202-bbbbbbb",
+		"run-20260209_010101-aaaaaaa",
+	}, w.TestFilteredRunKeys())
+}
+
+func TestModel_SingleRunLatest_OpensNewestRunWithoutLatestLink(t *testing.T) {
+	logger := observability.NewNoOpLogger()
+	cfg := leet.NewConfigManager(filepath.Join(t.TempDir(), "config.json"), logger)
+	require.NoError(t, cfg.SetStartupMode(leet.StartupModeSingleRunLatest))
+
+	emptyDir := t.TempDir()
+	m := leet.NewModel(leet.ModelParams{WandbDir: emptyDir, Config: cfg, Logger: logger})
+	require.Empty(t, m.TestRunFile(), "empty dir should stay in workspace view")
+
+	wandbDir := t.TempDir()
+	for _, runKey := range []string{
+		"run-20260209_010101-aaaaaaa",
+		"run-20260210_010101-ccccccc",
+		"run-20260209_020202-bbbbbbb",
+	} {
+		createRunWandbFile(t, wandbDir, runKey, nil)
+	}
+	// Newer directory without a .wandb file is skipped.
+	require.NoError(t, os.MkdirAll(filepath.Join(wandbDir, "run-20260211_010101-ddddddd"), 0o755))
+
+	m = leet.NewModel(leet.ModelParams{WandbDir: wandbDir, Config: cfg, Logger: logger})
+	require.Equal(t,
+		filepath.Join(wandbDir, "run-20260210_010101-ccccccc", "run-ccccccc.wandb"),
+		m.TestRunFile())
+
+	m = leet.NewModel(leet.ModelParams{
+		WandbDir: wandbDir,
+		RunGlob:  "run-20260209_*",
+		Config:   cfg,
+		Logger:   logger,
+	})
+	require.Equal(t,
+		filepath.Join(wandbDir, "run-20260209_020202-bbbbbbb", "run-bbbbbbb.wandb"),
+		m.TestRunFile())
+}
+
+func TestWorkspace_RunStatusScan_ColorsUnselectedRunsByState(t *testing.T) {
+	logger := observability.NewNoOpLogger()
+	cfg := leet.NewConfigManager(filepath.Join(t.TempDir(), "config.json"), logger)
+	wandbDir := t.TempDir()
+
+	exit := func(code int32) *spb.Record {
+		return &spb.Record{RecordType: &spb.Record_Exit{
+			Exit: &spb.RunExitRecord{ExitCode: code}}}
+	}
+	// Enough history that the exit record is past the scanned tail's start.
+	var failedRecords []*spb.Record
+	for i := range 200 {
+		failedRecords = append(failedRecords, &spb.Record{RecordType: &spb.Record_History{
+			History: &spb.HistoryRecord{Item: []*spb.HistoryItem{
+				{Key: "_step", ValueJson: fmt.Sprint(i)},
+				{Key: "note", ValueJson: `"` + strings.Repeat("x", 1000) + `"`},
+			}},
+		}})
+	}
+	failedRecords = append(failedRecords, exit(1))
+
+	latest := "run-20250731_170608-cccccccc"
+	finished := "run-20250731_170607-bbbbbbbb"
+	failed := "run-20250731_170606-aaaaaaaa"
+	createRunWandbFile(t, wandbDir, latest, nil)
+	createRunWandbFile(t, wandbDir, finished, []*spb.Record{exit(0)})
+	createRunWandbFile(t, wandbDir, failed, failedRecords)
+
+	w := leet.NewWorkspace(wandbDir, cfg, logger)
+	w.Update(w.TestPollWandbDir())
+
+	require.True(t, w.TestIsRunSelected(latest))
+	require.False(t, w.TestIsRunSelected(finished))
+	require.False(t, w.TestIsRunSelected(failed))
+
+	require.Equal(t, leet.RunStateRunning, w.TestRunListState(latest))
+	require.Equal(t, leet.RunStateFinished, w.TestRunListState(finished))
+	require.Equal(t, leet.RunStateFailed, w.TestRunListState(failed))
+	require.Equal(t,
+		leet.TestRunStateMarkColor(leet.RunStateFinished),
+		w.TestRunListMarkColor(finished))
+	require.Equal(t,
+		leet.TestRunStateMarkColor(leet.RunStateFailed),
+		w.TestRunListMarkColor(failed))
+	require.NotEqual(t,
+		w.TestRunListMarkColor(finished),
+		w.TestRunListMarkColor(failed))
+}
+
+func TestWorkspace_RunList_SelectedRunsShowStateBadge(t *testing.T) {
+	logger := observability.NewNoOpLogger()
+	cfg := leet.NewConfigManager(filepath.Join(t.TempDir(), "config.json"), logger)
+	wandbDir := t.TempDir()
+
+	live := "run-20250731_170608-cccccccc"
+	finished := "run-20250731_170607-bbbbbbbb"
+	createRunWandbFile(t, wandbDir, live, nil)
+	createRunWandbFile(t, wandbDir, finished, []*spb.Record{
+		{RecordType: &spb.Record_Exit{Exit: &spb.RunExitRecord{ExitCode: 0}}},
+	})
+
+	w := leet.NewWorkspace(wandbDir, cfg, logger)
+	w.Update(tea.WindowSizeMsg{Width: 200, Height: 40})
+	w.Update(w.TestPollWandbDir())
+	require.True(t, w.TestIsRunSelected(live))
+
+	// Select the finished run too.
+	w.Update(tea.KeyPressMsg{Code: tea.KeyDown})
+	w.Update(tea.KeyPressMsg{Code: tea.KeySpace})
+	require.True(t, w.TestIsRunSelected(finished))
+
+	view := stripANSI(w.View().Content)
+	require.Contains(t, view, live+" •")
+	require.Contains(t, view, finished)
+	require.NotContains(t, view, finished+" •")
+	require.NotContains(t, view, finished+" ✗")
+}
+
+func TestWorkspace_SelectionPersistsAcrossRestarts(t *testing.T) {
+	logger := observability.NewNoOpLogger()
+	cfgPath := filepath.Join(t.TempDir(), "config.json")
+	cfg := leet.NewConfigManager(cfgPath, logger)
+
+	wandbDir := t.TempDir()
+	run1 := "run-20250731_170606-iazb7i1k"
+	run2 := "run-20250731_170607-zzzzzzzz"
+	run3 := "run-20250731_170608-yyyyyyyy"
+	for _, runKey := range []string{run1, run2, run3} {
+		createRunWandbFile(t, wandbDir, runKey, nil)
+	}
+	runKeys := []string{run1, run2, run3}
+
+	w := leet.NewWorkspace(wandbDir, cfg, logger)
+	w.Update(leet.WorkspaceRunDirsMsg{RunKeys: runKeys})
+	require.True(t, w.TestIsRunSelected(run1))
+
+	// Select run2 and run3, then pin run3.
+	w.Update(tea.KeyPressMsg{Code: tea.KeyDown})
+	w.Update(tea.KeyPressMsg{Code: tea.KeySpace})
+	w.Update(tea.KeyPressMsg{Code: tea.KeyDown})
+	w.Update(tea.KeyPressMsg{Code: tea.KeySpace})
+	w.Update(tea.KeyPressMsg{Code: 'p'})
+	require.Equal(t, run3, w.TestPinnedRun())
+	w.Update(tea.KeyPressMsg{Code: 'q'})
+
+	saved, pinned := leet.NewConfigManager(cfgPath, logger).WorkspaceSelection(wandbDir)
+	require.Equal(t, runKeys, saved)
+	require.Equal(t, run3, pinned)
+
+	// A run whose .wandb file is gone is dropped on restore.
+	require.NoError(t, os.RemoveAll(filepath.Join(wandbDir, run2)))
+
+	w2 := leet.NewWorkspace(wandbDir, leet.NewConfigManager(cfgPath, logger), logger)
+	w2.Update(leet.WorkspaceRunDirsMsg{RunKeys: runKeys})
+
+	require.Equal(t, 2, w2.TestSelectedRunCount())
+	require.True(t, w2.TestIsRunSelected(run1))
+	require.False(t, w2.TestIsRunSelected(run2))
+	require.True(t, w2.TestIsRunSelected(run3))
+	require.Equal(t, run3, w2.TestPinnedRun())
+}
@@ -5,6 +5,8 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"path/filepath"
+	"strings"
 	"time"
 
 	tea "charm.land/bubbletea/v2"
@@ -60,7 +62,8 @@ func (w *Workspace) handleMediaMouse(msg tea.MouseMsg, layout Layout) tea.Cmd {
 }
 
 func (w *Workspace) handleKeyPressMsg(msg tea.KeyPressMsg) tea.Cmd {
-	// Filter mode takes priority.
+	// Filter modes take priority, even over grid config capture: digits
+	// typed into a filter are query text, never a grid size.
 	if w.filter.IsActive() {
 		w.handleRunFilterKey(msg)
 		return nil
@@ -73,6 +76,12 @@ func (w *Workspace) handleKeyPressMsg(msg tea.KeyPressMsg) tea.Cmd {
 		w.metricsGrid.handleFilterKey(msg)
 		return nil
 	}
+	if w.metricsGrid.IsJumpMode() {
+		if err := w.metricsGrid.handleJumpKey(msg); err != nil {
+			w.setRunNotice("Jump failed: %v", err)
+		}
+		return nil
+	}
 	if g := w.activeSystemMetricsGrid(); g != nil && g.IsFilterMode() {
 		g.handleFilterKey(msg)
 		return nil
@@ -84,9 +93,17 @@ func (w *Workspace) handleKeyPressMsg(msg tea.KeyPressMsg) tea.Cmd {
 		return nil
 	}
 
+	if w.configExport.IsActive() {
+		return w.handleConfigExportKey(msg)
+	}
+	w.configExport.result = ""
+
 	// Focus-aware key dispatch.
 	switch w.focusMgr.Current() {
 	case FocusTargetMetricsGrid, FocusTargetSystemMetrics:
+		if w.focusMgr.Current() == FocusTargetMetricsGrid && w.metricsGrid.handleScrubKey(msg) {
+			return nil
+		}
 		if cmd := w.handleGridNav(msg); cmd != nil {
 			return cmd
 		}
@@ -94,6 +111,18 @@ func (w *Workspace) handleKeyPressMsg(msg tea.KeyPressMsg) tea.Cmd {
 		if handled, cmd := w.mediaPane.HandleKey(msg); handled {
 			return cmd
 		}
+	case FocusTargetConsoleLogs:
+		if delta, ok := consoleLogsResizeDelta(msg); ok {
+			w.resizeConsoleLogsPane(delta)
+			return nil
+		}
+	case FocusTargetOverview:
+		if isSectionToggleKey(msg) && w.runOverviewSidebar.IsVisible() {
+			if err := w.runOverviewSidebar.toggleActiveSectionCollapsed(); err != nil {
+				w.logger.Error(fmt.Sprintf("workspace: failed to save overview section state: %v", err))
+			}
+			return nil
+		}
 	}
 
 	// Dispatch via key map.
@@ -247,7 +276,11 @@ func (w *Workspace) handleSystemMetricsMouse(msg tea.MouseMsg, layout Layout) te
 		}
 	case tea.MouseReleaseMsg:
 		if m.Button == tea.MouseRight {
+			p, ok := grid.InspectedPoint()
 			grid.EndInspection()
+			if ok {
+				w.setRunNotice("%s: %s (Y to copy)", p.Title, p)
+			}
 		}
 	case tea.MouseWheelMsg:
 		w.metricsGrid.clearFocus()
@@ -360,6 +393,9 @@ func (w *Workspace) handleToggleOverviewSidebar(msg tea.KeyPressMsg) tea.Cmd {
 	if err := w.config.SetWorkspaceOverviewVisible(rightWillBeVisible); err != nil {
 		w.logger.Error(fmt.Sprintf("workspace: failed to save overview state: %v", err))
 	}
+	if !rightWillBeVisible {
+		w.overviewCollapsedByUser = true
+	}
 
 	w.updateSidebarDimensions(leftIsVisible, rightWillBeVisible)
 	w.runOverviewSidebar.Toggle()
@@ -399,6 +435,29 @@ func (w *Workspace) handleToggleMediaPane(msg tea.KeyPressMsg) tea.Cmd {
 	return w.mediaPaneAnimationCmd()
 }
 
+// resizeConsoleLogsPane grows or shrinks the expanded console logs pane
+// by delta rows and persists its new height.
+func (w *Workspace) resizeConsoleLogsPane(delta int) {
+	h := max(w.consoleLogsPane.ExpandedHeight()+delta, ConsoleLogsPaneMinHeight)
+	if err := w.config.SetConsoleLogsHeight(h); err != nil {
+		w.logger.Error(fmt.Sprintf("workspace: failed to save console logs height: %v", err))
+	}
+	w.updateBottomPaneHeights(
+		w.systemMetricsPane.animState.TargetVisible(),
+		w.mediaPane.animState.TargetVisible(),
+		w.consoleLogsPane.animState.TargetVisible(),
+	)
+
+	// Persist the height actually applied, so growing past the limit
+	// doesn't take extra presses to undo.
+	if applied := w.consoleLogsPane.ExpandedHeight(); applied != h {
+		if err := w.config.SetConsoleLogsHeight(applied); err != nil {
+			w.logger.Error(fmt.Sprintf("workspace: failed to save console logs height: %v", err))
+		}
+	}
+	w.recalculateLayout()
+}
+
 func (w *Workspace) handleToggleConsoleLogsPane(msg tea.KeyPressMsg) tea.Cmd {
 	bottomWillBeVisible := !w.consoleLogsPane.animState.TargetVisible()
 
@@ -418,6 +477,30 @@ func (w *Workspace) handleToggleConsoleLogsPane(msg tea.KeyPressMsg) tea.Cmd {
 	return w.consoleLogsPaneAnimationCmd()
 }
 
+// handleToggleConsoleLogTimestamps shows or hides the console logs
+// timestamp column and persists the choice.
+func (w *Workspace) handleToggleConsoleLogTimestamps(msg tea.KeyPressMsg) tea.Cmd {
+	show := w.consoleLogsPane.TimestampsHidden()
+	w.consoleLogsPane.SetTimestampsHidden(!show)
+	if err := w.config.SetConsoleLogTimestamps(show); err != nil {
+		w.logger.Error(fmt.Sprintf("workspace: failed to save console log timestamps: %v", err))
+	}
+	return nil
+}
+
+// handleToggleConsoleErrorsView switches the console logs pane between the
+// current run's logs and the errors of all selected runs, opening the pane
+// if needed.
+func (w *Workspace) handleToggleConsoleErrorsView(msg tea.KeyPressMsg) tea.Cmd {
+	w.consoleErrorsView = !w.consoleErrorsView
+	w.systemCompareTitle = ""
+	w.consoleLogsPane.ScrollToEnd()
+	if w.consoleErrorsView && !w.consoleLogsPane.animState.TargetVisible() {
+		return w.handleToggleConsoleLogsPane(msg)
+	}
+	return nil
+}
+
 func (w *Workspace) handleToggleSystemMetricsPane(tea.KeyPressMsg) tea.Cmd {
 	sysWillBeVisible := !w.systemMetricsPane.animState.TargetVisible()
 	mediaVisible := w.mediaPane.animState.TargetVisible()
@@ -461,13 +544,25 @@ func (w *Workspace) readAllChunkCmd(run *WorkspaceRun) tea.Cmd {
 		return nil
 	}
 
-	reader := run.Reader
-	runKey := run.Key
+	if run.load == nil {
+		run.load = &chunkedLoad{}
+	}
+
+	reader, load := run.Reader, run.load
+	runKey, runPath := run.Key, run.wandbPath
 
 	return func() tea.Msg {
+		if !load.begin() {
+			return nil
+		}
 		msg, err := reader.Read(BootLoadChunkSize, BootLoadMaxTime)
+		if !load.end() {
+			// The run was deselected mid-read; drop the chunk.
+			reader.Close()
+			return nil
+		}
 		if err != nil && !errors.Is(err, io.EOF) {
-			return ErrorMsg{Err: err}
+			return readErrMsg(runKey, runPath, err)
 		}
 		if msg == nil {
 			return nil
@@ -476,6 +571,7 @@ func (w *Workspace) readAllChunkCmd(run *WorkspaceRun) tea.Cmd {
 			return WorkspaceChunkedBatchMsg{
 				RunKey: runKey,
 				Batch:  batch,
+				load:   load,
 			}
 		}
 		return msg
@@ -489,12 +585,17 @@ func (w *Workspace) ReadAvailableCmd(run *WorkspaceRun) tea.Cmd {
 	}
 
 	reader := run.Reader
-	runKey := run.Key
+	runKey, runPath := run.Key, run.wandbPath
 
 	return func() tea.Msg {
+		// A deleted file reads as EOF through the open handle, so check
+		// for removal here, off the UI goroutine.
+		if runFileRemoved(runPath) {
+			return WorkspaceRunFileRemovedMsg{RunKey: runKey, RunPath: runPath}
+		}
 		msg, err := reader.Read(LiveMonitorChunkSize, LiveMonitorMaxTime)
 		if err != nil && !errors.Is(err, io.EOF) {
-			return ErrorMsg{Err: err}
+			return readErrMsg(runKey, runPath, err)
 		}
 		if msg == nil {
 			return nil
@@ -516,6 +617,26 @@ func (w *Workspace) ReadAvailableCmd(run *WorkspaceRun) tea.Cmd {
 	}
 }
 
+// readErrMsg maps a read error for a workspace run to a message.
+//
+// A missing run file is reported as WorkspaceRunFileRemovedMsg so the run
+// can be dropped instead of failing on every subsequent read.
+func readErrMsg(runKey, runPath string, err error) tea.Msg {
+	if runFileRemoved(runPath) {
+		return WorkspaceRunFileRemovedMsg{RunKey: runKey, RunPath: runPath}
+	}
+	return ErrorMsg{Err: err}
+}
+
+// runFileRemoved reports whether the run file at path no longer exists.
+func runFileRemoved(path string) bool {
+	if path == "" {
+		return false
+	}
+	_, err := os.Stat(path)
+	return os.IsNotExist(err)
+}
+
 // waitForLiveMsg blocks until the next heartbeat message is available.
 func (w *Workspace) waitForLiveMsg() tea.Msg {
 	if w.liveChan == nil {
@@ -545,6 +666,7 @@ func (w *Workspace) ensureLiveStreaming(run *WorkspaceRun) tea.Cmd {
 			w.logger.CaptureError(fmt.Errorf(
 				"workspace: failed to start watcher for %s: %v", run.Key, err))
 			run.watcher = nil
+			watcherCmd = w.scheduleWatcherRetry(run.Key, 1)
 		} else {
 			watcherCmd = w.waitForWatcher(run.Key)
 		}
@@ -555,7 +677,7 @@ func (w *Workspace) ensureLiveStreaming(run *WorkspaceRun) tea.Cmd {
 		w.heartbeatMgr.Start(w.hasLiveRuns.Load)
 	}
 
-	return watcherCmd
+	return batchCmds(watcherCmd, w.scheduleLiveRedraw())
 }
 
 // waitForWatcher blocks until the watcher for the given run emits a change
@@ -574,10 +696,11 @@ func (w *Workspace) waitForWatcher(runKey string) tea.Cmd {
 	watcher := run.watcher
 
 	return func() tea.Msg {
-		if msg := watcher.WaitForMsg(); msg != nil {
-			if _, ok := msg.(FileChangedMsg); ok {
-				return WorkspaceFileChangedMsg{RunKey: runKey}
-			}
+		switch msg := watcher.WaitForMsg().(type) {
+		case FileChangedMsg:
+			return WorkspaceFileChangedMsg{RunKey: runKey}
+		case WatcherErrMsg:
+			return WorkspaceWatcherErrMsg{RunKey: runKey, Err: msg.Err}
 		}
 		return nil
 	}
@@ -609,6 +732,22 @@ func (w *Workspace) handleWorkspaceInitErr(msg WorkspaceInitErrMsg) tea.Cmd {
 	return nil
 }
 
+// handleWorkspaceRunFileRemoved drops a run whose .wandb file was deleted
+// while it was selected, and notes it in the status bar.
+func (w *Workspace) handleWorkspaceRunFileRemoved(msg WorkspaceRunFileRemovedMsg) tea.Cmd {
+	// Pending reads may report the same removal more than once.
+	if _, ok := w.runsByKey[msg.RunKey]; !ok && !w.selectedRuns[msg.RunKey] {
+		return nil
+	}
+
+	w.dropRun(msg.RunKey)
+	w.metricsGrid.drawVisible()
+	w.setRunNotice("Run %s removed: file deleted", msg.RunKey)
+	w.logger.Info(fmt.Sprintf(
+		"workspace: run file %s deleted; dropped run %s", msg.RunPath, msg.RunKey))
+	return nil
+}
+
 // handleWorkspaceRunInit stores the reader and starts the initial load for the run.
 func (w *Workspace) handleWorkspaceRunInit(msg WorkspaceRunInitMsg) tea.Cmd {
 	if msg.Reader == nil || msg.RunKey == "" {
@@ -627,14 +766,40 @@ func (w *Workspace) handleWorkspaceRunInit(msg WorkspaceRunInitMsg) tea.Cmd {
 		Reader:    msg.Reader,
 	}
 	w.runsByKey[msg.RunKey] = run
+	w.runKeyByPath[msg.RunPath] = msg.RunKey
 
 	return w.readAllChunkCmd(run)
 }
 
+// readOutputLogCmd reads the run's output.log file, if any.
+func (w *Workspace) readOutputLogCmd(run *WorkspaceRun) tea.Cmd {
+	cmd := readOutputLogCmd(run.wandbPath, w.logger)
+	if cmd == nil {
+		return nil
+	}
+	runKey := run.Key
+	return func() tea.Msg {
+		msg, ok := cmd().(OutputLogMsg)
+		if !ok {
+			return nil
+		}
+		return WorkspaceOutputLogMsg{RunKey: runKey, Lines: msg.Lines}
+	}
+}
+
+// handleWorkspaceOutputLog merges a run's output.log into its console logs.
+func (w *Workspace) handleWorkspaceOutputLog(msg WorkspaceOutputLogMsg) tea.Cmd {
+	if w.runsByKey[msg.RunKey] == nil {
+		return nil
+	}
+	w.getOrCreateConsoleLogs(msg.RunKey).AddFileLines(msg.Lines)
+	return nil
+}
+
 // handleWorkspaceChunkedBatch processes an initial chunk of data for a run.
 func (w *Workspace) handleWorkspaceChunkedBatch(msg WorkspaceChunkedBatchMsg) tea.Cmd {
 	run := w.runsByKey[msg.RunKey]
-	if run == nil {
+	if run == nil || (msg.load != nil && msg.load != run.load) {
 		return nil
 	}
 
@@ -646,9 +811,10 @@ func (w *Workspace) handleWorkspaceChunkedBatch(msg WorkspaceChunkedBatchMsg) te
 	if msg.Batch.HasMore {
 		return w.readAllChunkCmd(run)
 	}
+	run.load = nil
 
 	// Initial load complete; if this run is live, wire up watcher + heartbeat.
-	return w.ensureLiveStreaming(run)
+	return batchCmds(w.ensureLiveStreaming(run), w.readOutputLogCmd(run))
 }
 
 // handleWorkspaceBatchedRecords processes incremental updates for a run.
@@ -662,6 +828,8 @@ func (w *Workspace) handleWorkspaceBatchedRecords(msg WorkspaceBatchedRecordsMsg
 		w.handleWorkspaceRecord(run, sub)
 	}
 	w.metricsGrid.drawVisible()
+	w.noteLiveUpdate(run.Key, msg.Batch.Msgs)
+	w.syncStaleRuns(time.Now())
 
 	// Continue draining while the run is still live.
 	if run.state == RunStateRunning {
@@ -677,6 +845,8 @@ func (w *Workspace) handleWorkspaceBatchedRecords(msg WorkspaceBatchedRecordsMsg
 
 // handleWorkspaceRecord updates per‑run and metrics state for an individual record.
 func (w *Workspace) handleWorkspaceRecord(run *WorkspaceRun, msg tea.Msg) {
+	run.lastRecordAt = w.now()
+
 	switch m := msg.(type) {
 	case RunMsg:
 		w.getOrCreateRunOverview(run.Key).ProcessRunMsg(m)
@@ -689,6 +859,11 @@ func (w *Workspace) handleWorkspaceRecord(run *WorkspaceRun, msg tea.Msg) {
 
 	case HistoryMsg:
 		w.metricsGrid.ProcessHistory(m)
+		w.applyRunOverlayOrder()
+		run.recordLatest(m)
+		if len(m.Histograms) > 0 {
+			w.getOrCreateRunOverview(run.Key).ProcessHistograms(m.Histograms)
+		}
 		w.getOrCreateMediaStore(run.Key).ProcessHistory(m)
 		if w.pinnedRun != "" {
 			w.refreshPinnedRun()
@@ -709,6 +884,8 @@ func (w *Workspace) handleWorkspaceRecord(run *WorkspaceRun, msg tea.Msg) {
 
 	case ConsoleLogMsg:
 		w.getOrCreateConsoleLogs(run.Key).ProcessRaw(m.Text, m.IsStderr, m.Time)
+		run.noteConsoleError(m.Text, m.IsStderr)
+		w.teeConsoleLog(run, m.Text)
 
 	case FileCompleteMsg:
 		switch m.ExitCode {
@@ -717,7 +894,9 @@ func (w *Workspace) handleWorkspaceRecord(run *WorkspaceRun, msg tea.Msg) {
 		default:
 			run.state = RunStateFailed
 		}
-		w.getOrCreateRunOverview(run.Key).SetRunState(run.state)
+		ro := w.getOrCreateRunOverview(run.Key)
+		ro.SetRunState(run.state)
+		ro.SetExitCode(m.ExitCode)
 		w.syncLiveRunState()
 
 		// No more updates expected for this run; stop its watcher.
@@ -736,9 +915,10 @@ func (w *Workspace) handleHeartbeat() tea.Cmd {
 	}
 
 	w.syncLiveRunState()
+	w.syncStaleRuns(time.Now())
 	w.heartbeatMgr.Reset(w.hasLiveRuns.Load)
 
-	cmds := []tea.Cmd{w.waitForLiveMsg}
+	cmds := []tea.Cmd{w.waitForLiveMsg, w.scheduleLiveRedraw()}
 	for key, run := range w.runsByKey {
 		if run == nil || run.state != RunStateRunning || !w.selectedRuns[key] {
 			continue
@@ -772,6 +952,7 @@ func (w *Workspace) handleWorkspaceFileChanged(msg WorkspaceFileChangedMsg) tea.
 
 func (w *Workspace) handleQuit(msg tea.KeyPressMsg) tea.Cmd {
 	w.logger.Debug("workspace: quit requested")
+	w.saveSelection()
 	w.Cleanup()
 
 	return tea.Quit
@@ -871,6 +1052,58 @@ func (w *Workspace) handleCycleFocusedChartMode(tea.KeyPressMsg) tea.Cmd {
 	return nil
 }
 
+func (w *Workspace) handleToggleSystemMetricsAggregation(tea.KeyPressMsg) tea.Cmd {
+	if g := w.activeSystemMetricsGrid(); g != nil {
+		g.ToggleAggregation()
+	}
+	return nil
+}
+
+func (w *Workspace) handleToggleRawXTicks(msg tea.KeyPressMsg) tea.Cmd {
+	w.metricsGrid.toggleRawXTicks()
+	return nil
+}
+
+func (w *Workspace) handleToggleChartLegend(msg tea.KeyPressMsg) tea.Cmd {
+	w.metricsGrid.toggleLegend()
+	return nil
+}
+
+func (w *Workspace) handleCycleChartObjective(msg tea.KeyPressMsg) tea.Cmd {
+	w.metricsGrid.cycleFocusedChartObjective()
+	return nil
+}
+
+func (w *Workspace) handleToggleChartInvertY(msg tea.KeyPressMsg) tea.Cmd {
+	w.metricsGrid.toggleFocusedChartInvertY()
+	return nil
+}
+
+func (w *Workspace) handleCycleChartSecondary(msg tea.KeyPressMsg) tea.Cmd {
+	w.metricsGrid.cycleFocusedChartSecondary()
+	return nil
+}
+
+func (w *Workspace) handleExportChartsSVG(msg tea.KeyPressMsg) tea.Cmd {
+	w.exportChartsSVG()
+	return nil
+}
+
+func (w *Workspace) handleToggleChartHero(msg tea.KeyPressMsg) tea.Cmd {
+	w.metricsGrid.toggleFocusedChartHero()
+	return nil
+}
+
+func (w *Workspace) handleToggleMinMaxBand(msg tea.KeyPressMsg) tea.Cmd {
+	w.metricsGrid.toggleMinMaxBand()
+	return nil
+}
+
+func (w *Workspace) handleToggleMetricsSortOrder(msg tea.KeyPressMsg) tea.Cmd {
+	w.metricsGrid.toggleSortOrder()
+	return nil
+}
+
 func (w *Workspace) handleEnterMetricsFilter(msg tea.KeyPressMsg) tea.Cmd {
 	w.metricsGrid.EnterFilterMode()
 	return nil
@@ -906,6 +1139,29 @@ func (w *Workspace) handleClearMetricsFilter(msg tea.KeyPressMsg) tea.Cmd {
 	return nil
 }
 
+func (w *Workspace) handleSaveMetricsFilterPreset(msg tea.KeyPressMsg) tea.Cmd {
+	pos, total, ok := w.metricsGrid.saveFilterPreset()
+	if !ok {
+		w.setRunNotice("Apply a metrics filter to save it as a preset")
+		return nil
+	}
+	w.setRunNotice("Saved filter preset %d of %d", pos, total)
+	return nil
+}
+
+func (w *Workspace) handleCycleMetricsFilterPreset(msg tea.KeyPressMsg) tea.Cmd {
+	query, pos, total, ok := w.metricsGrid.cycleFilterPreset()
+	if !ok {
+		w.setRunNotice("No filter presets; press W to save the applied filter")
+		return nil
+	}
+	if w.focusMgr.Current() == FocusTargetMetricsGrid {
+		w.metricsGrid.NavigateFocus(0, 0)
+	}
+	w.setRunNotice("Filter preset %d of %d: %s", pos, total, query)
+	return nil
+}
+
 func (w *Workspace) handleClearSystemMetricsFilter(tea.KeyPressMsg) tea.Cmd {
 	if g := w.activeSystemMetricsGrid(); g != nil && g.FilterQuery() != "" {
 		g.ClearFilter()
@@ -923,6 +1179,11 @@ func (w *Workspace) handleEnterOverviewFilter(tea.KeyPressMsg) tea.Cmd {
 	return nil
 }
 
+func (w *Workspace) handleJumpOverviewQuickView(tea.KeyPressMsg) tea.Cmd {
+	w.jumpToOverviewQuickView()
+	return nil
+}
+
 func (w *Workspace) handleClearOverviewFilter(tea.KeyPressMsg) tea.Cmd {
 	if w.runOverviewSidebar.IsFiltering() {
 		w.runOverviewSidebar.ClearFilter()
@@ -1074,12 +1335,29 @@ func (w *Workspace) toggleRunSelected(runKey string) tea.Cmd {
 		return nil
 	}
 
+	w.runNotice = ""
 	w.selectedRuns[runKey] = true
 	if w.pinnedRun == "" {
 		w.pinnedRun = runKey
 	}
 
-	return w.initReaderCmd(runKey, wandbFile)
+	return tea.Batch(w.initReaderCmd(runKey, wandbFile), w.autoExpandOverview())
+}
+
+// autoExpandOverview expands the collapsed overview sidebar after a run
+// is selected, if configured and the user hasn't collapsed it by hand.
+func (w *Workspace) autoExpandOverview() tea.Cmd {
+	if !w.config.WorkspaceOverviewAutoExpand() || w.overviewCollapsedByUser ||
+		w.runOverviewSidebar.animState.TargetVisible() {
+		return nil
+	}
+
+	w.updateSidebarDimensions(w.runsAnimState.TargetVisible(), true)
+	w.runOverviewSidebar.Toggle()
+	w.focusMgr.ResolveAfterVisibilityChange()
+	w.recalculateLayout()
+
+	return w.runOverviewAnimationCmd()
 }
 
 func (w *Workspace) handleToggleRunSelectedKey(msg tea.KeyPressMsg) tea.Cmd {
@@ -1093,6 +1371,15 @@ func (w *Workspace) handleToggleRunSelectedKey(msg tea.KeyPressMsg) tea.Cmd {
 	return w.toggleRunSelected(cur.Key)
 }
 
+// handleRunListEnter toggles the highlighted run's selection when Enter is
+// configured to select; otherwise the model switches to the run view.
+func (w *Workspace) handleRunListEnter(msg tea.KeyPressMsg) tea.Cmd {
+	if w.config.RunListEnter() != RunListEnterSelect {
+		return nil
+	}
+	return w.handleToggleRunSelectedKey(msg)
+}
+
 func (w *Workspace) togglePin(runKey string) {
 	if runKey == "" {
 		return
@@ -1110,6 +1397,89 @@ func (w *Workspace) togglePin(runKey string) {
 	w.metricsGrid.drawVisible()
 }
 
+// handleDeselectFinishedRuns deselects every loaded run that is no longer
+// live, leaving running runs (and runs still loading) selected.
+func (w *Workspace) handleDeselectFinishedRuns(tea.KeyPressMsg) tea.Cmd {
+	var finished []string
+	for key := range w.selectedRuns {
+		if run := w.runsByKey[key]; run != nil &&
+			run.state != RunStateRunning && run.state != RunStateUnknown {
+			finished = append(finished, key)
+		}
+	}
+	if len(finished) == 0 {
+		return nil
+	}
+
+	w.snapshotSelection()
+	for _, key := range finished {
+		w.dropRun(key)
+	}
+	w.setRunNotice("Deselected %d finished run(s)%s", len(finished), w.undoHint())
+	return nil
+}
+
+// handleCopySelectedRunIDs copies the selected runs' IDs to the clipboard,
+// one per line, in run list order.
+func (w *Workspace) handleCopySelectedRunIDs(tea.KeyPressMsg) tea.Cmd {
+	var ids []string
+	for _, item := range w.runs.Items {
+		if !w.selectedRuns[item.Key] {
+			continue
+		}
+		if id := extractRunID(item.Key); id != "" {
+			ids = append(ids, id)
+		}
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+
+	w.setRunNotice("Copied %d run ID(s) to clipboard", len(ids))
+	return w.copyToClipboard(strings.Join(ids, "\n"))
+}
+
+// handleCopyInspectedPoint copies the system metrics point last read off
+// with right-click inspection.
+func (w *Workspace) handleCopyInspectedPoint(msg tea.KeyPressMsg) tea.Cmd {
+	cur, ok := w.runs.CurrentItem()
+	if !ok {
+		return nil
+	}
+	grid := w.systemMetrics[cur.Key]
+	if grid == nil {
+		return nil
+	}
+	p, ok := grid.LastInspectedPoint()
+	if !ok {
+		return nil
+	}
+	w.setRunNotice("Copied %s (%s) to clipboard", p.Title, p)
+	return w.copyToClipboard(p.String())
+}
+
+// copyToClipboard returns a command that copies text to the system
+// clipboard and remembers it as the last copied text.
+func (w *Workspace) copyToClipboard(text string) tea.Cmd {
+	w.clipboard = text
+	return tea.SetClipboard(text)
+}
+
+func (w *Workspace) handleToggleScrub(msg tea.KeyPressMsg) tea.Cmd {
+	w.metricsGrid.ToggleScrub()
+	return nil
+}
+
+func (w *Workspace) handleEnterJumpToStep(msg tea.KeyPressMsg) tea.Cmd {
+	w.metricsGrid.EnterJumpMode()
+	return nil
+}
+
+func (w *Workspace) handleCycleRunListMetric(msg tea.KeyPressMsg) tea.Cmd {
+	w.cycleRunListMetric()
+	return nil
+}
+
 func (w *Workspace) handlePinRunKey(msg tea.KeyPressMsg) tea.Cmd {
 	if !w.runSelectorActive() {
 		return nil
@@ -1227,3 +1597,50 @@ func (w *Workspace) handleFocusRuns(tea.KeyPressMsg) tea.Cmd {
 	}
 	return nil
 }
+
+// handleJumpToPinnedRun moves the runs list cursor to the pinned run
+// and focuses the list, so the pinned run is highlighted.
+//
+// It's a no-op when nothing is pinned or the pinned run is filtered out.
+func (w *Workspace) handleJumpToPinnedRun(tea.KeyPressMsg) tea.Cmd {
+	if w.pinnedRun == "" {
+		return nil
+	}
+	w.restoreRunCursor(w.pinnedRun)
+	if cur, ok := w.runs.CurrentItem(); ok && cur.Key == w.pinnedRun &&
+		w.runsAnimState.TargetVisible() {
+		w.focusMgr.SetTarget(FocusTargetRunsList, 1)
+	}
+	return nil
+}
+
+func (w *Workspace) handleOpenConfigExport(msg tea.KeyPressMsg) tea.Cmd {
+	w.configExport.Open()
+	return nil
+}
+
+func (w *Workspace) handleExportOverview(msg tea.KeyPressMsg) tea.Cmd {
+	w.exportOverview()
+	return nil
+}
+
+func (w *Workspace) handleToggleConsoleTee(msg tea.KeyPressMsg) tea.Cmd {
+	w.toggleConsoleTee()
+	return nil
+}
+
+// handleConfigExportKey routes a key to the export submenu for the run
+// under the runs list cursor.
+func (w *Workspace) handleConfigExportKey(msg tea.KeyPressMsg) tea.Cmd {
+	cur, ok := w.runs.CurrentItem()
+	if !ok {
+		return w.configExport.HandleKey(msg, nil, "", "")
+	}
+	ro := w.runOverview[cur.Key]
+	runID := extractRunID(cur.Key)
+	if ro != nil && ro.ID() != "" {
+		runID = ro.ID()
+	}
+	return w.configExport.HandleKey(
+		msg, ro, runID, filepath.Join(w.wandbDir, cur.Key))
+}
@@ -0,0 +1,56 @@
+package leet
+
+import "sync"
+
+// chunkedLoad tracks a run's initial chunked load so that deselecting the
+// run can cancel it.
+//
+// The reader must not be closed while a chunk read is in flight, so a
+// load canceled mid-read leaves closing the reader to the read itself.
+type chunkedLoad struct {
+	mu       sync.Mutex
+	reading  bool
+	canceled bool
+}
+
+// begin marks a chunk read as started; it reports false if the load was
+// canceled.
+func (l *chunkedLoad) begin() bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.canceled {
+		return false
+	}
+	l.reading = true
+	return true
+}
+
+// end marks a chunk read as finished; it reports false if the load was
+// canceled during the read, in which case the caller closes the reader.
+func (l *chunkedLoad) end() bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.reading = false
+	return !l.canceled
+}
+
+// cancel cancels the load and reports whether the caller may close the
+// reader, i.e. no chunk read is in flight.
+func (l *chunkedLoad) cancel() bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.canceled = true
+	return !l.reading
+}
+
+// closeRunReader cancels the run's initial load, if any, and closes its
+// reader unless a canceled chunk read will close it.
+func closeRunReader(run *WorkspaceRun) {
+	if run.Reader == nil {
+		return
+	}
+	if run.load != nil && !run.load.cancel() {
+		return
+	}
+	run.Reader.Close()
+}
@@ -0,0 +1,115 @@
+package leet
+
+import (
+	"slices"
+
+	tea "charm.land/bubbletea/v2"
+)
+
+// SetSeriesOrder reorders the series named in keys to be drawn in that
+// order, after any other series; the last key is drawn on top.
+func (c *EpochLineChart) SetSeriesOrder(keys []string) {
+	order := make([]string, 0, len(c.order))
+	for _, key := range c.order {
+		if !slices.Contains(keys, key) {
+			order = append(order, key)
+		}
+	}
+	for _, key := range keys {
+		if _, ok := c.data[key]; ok {
+			order = append(order, key)
+		}
+	}
+	if !slices.Equal(order, c.order) {
+		c.order = order
+		c.dirty = true
+	}
+}
+
+// SetSeriesOrder reorders the series of every chart; see
+// EpochLineChart.SetSeriesOrder.
+func (mg *MetricsGrid) SetSeriesOrder(keys []string) {
+	mg.mu.Lock()
+	defer mg.mu.Unlock()
+	for _, ch := range mg.all {
+		ch.SetSeriesOrder(keys)
+	}
+}
+
+// syncRunOverlayOrder drops deselected runs from the overlay order and
+// appends newly selected ones in runs list order.
+func (w *Workspace) syncRunOverlayOrder() {
+	w.runOverlayOrder = slices.DeleteFunc(w.runOverlayOrder, func(runKey string) bool {
+		return !w.selectedRuns[runKey]
+	})
+	for _, item := range w.runs.Items {
+		if w.selectedRuns[item.Key] && !slices.Contains(w.runOverlayOrder, item.Key) {
+			w.runOverlayOrder = append(w.runOverlayOrder, item.Key)
+		}
+	}
+}
+
+// applyRunOverlayOrder draws the selected runs' series in the overlay
+// order set with moveRunInOverlay, keeping the pinned run on top.
+//
+// It is a no-op until a run has been moved.
+func (w *Workspace) applyRunOverlayOrder() {
+	if w.runOverlayOrder == nil {
+		return
+	}
+	w.syncRunOverlayOrder()
+
+	paths := make([]string, 0, len(w.runOverlayOrder))
+	for _, runKey := range w.runOverlayOrder {
+		if run := w.runsByKey[runKey]; run != nil && run.wandbPath != "" {
+			paths = append(paths, run.wandbPath)
+		}
+	}
+	w.metricsGrid.SetSeriesOrder(paths)
+	w.refreshPinnedRun()
+}
+
+// moveRunInOverlay moves a selected run by delta places in the overlay
+// order, which sets the order runs are drawn and listed in chart legends.
+func (w *Workspace) moveRunInOverlay(runKey string, delta int) {
+	if !w.selectedRuns[runKey] {
+		w.setRunNotice("Select a run to reorder it")
+		return
+	}
+	if w.runOverlayOrder == nil {
+		w.runOverlayOrder = []string{}
+	}
+	w.syncRunOverlayOrder()
+
+	i := slices.Index(w.runOverlayOrder, runKey)
+	j := i + delta
+	if i < 0 || j < 0 || j >= len(w.runOverlayOrder) {
+		return
+	}
+	w.runOverlayOrder[i], w.runOverlayOrder[j] = w.runOverlayOrder[j], w.runOverlayOrder[i]
+
+	w.applyRunOverlayOrder()
+	w.metricsGrid.drawVisible()
+	w.setRunNotice("Overlay position %d of %d", j+1, len(w.runOverlayOrder))
+}
+
+func (w *Workspace) handleMoveRunUp(msg tea.KeyPressMsg) tea.Cmd {
+	return w.handleMoveRun(-1)
+}
+
+func (w *Workspace) handleMoveRunDown(msg tea.KeyPressMsg) tea.Cmd {
+	return w.handleMoveRun(1)
+}
+
+// handleMoveRun moves the highlighted run in the overlay order.
+func (w *Workspace) handleMoveRun(delta int) tea.Cmd {
+	if !w.runSelectorActive() {
+		return nil
+	}
+	cur, ok := w.runs.CurrentItem()
+	if !ok {
+		return nil
+	}
+	w.moveRunInOverlay(cur.Key, delta)
+	return nil
+}
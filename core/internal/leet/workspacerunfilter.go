@@ -3,6 +3,7 @@ package leet
 import (
 	"encoding/json"
 	"fmt"
+	"slices"
 	"sort"
 	"strings"
 
@@ -66,13 +67,18 @@ func (w *Workspace) applyRunFilter() {
 		prevCursorKey = cur.Key
 	}
 
+	candidates := w.runs.Items
+	if w.recentRunsOnly {
+		candidates = w.recentRunItems(candidates)
+	}
+
 	query := w.filter.Query()
 	if query == "" {
-		w.runs.FilteredItems = w.runs.Items
+		w.runs.FilteredItems = candidates
 	} else {
 		compiled := CompileRunFilterQuery(query, w.filter.Mode())
-		filtered := make([]KeyValuePair, 0, len(w.runs.Items))
-		for _, item := range w.runs.Items {
+		filtered := make([]KeyValuePair, 0, len(candidates))
+		for _, item := range candidates {
 			if compiled.Match(w.runFilterData(item.Key)) {
 				filtered = append(filtered, item)
 			}
@@ -86,6 +92,86 @@ func (w *Workspace) applyRunFilter() {
 	w.syncRunsPage()
 }
 
+// recentRunItems keeps the configured number of most recently started runs,
+// judged by the timestamp in their keys, in their original list order.
+//
+// Runs whose key has no parseable timestamp count as oldest.
+func (w *Workspace) recentRunItems(items []KeyValuePair) []KeyValuePair {
+	limit := DefaultRecentRunsLimit
+	if w.config != nil {
+		limit = w.config.RecentRunsLimit()
+	}
+	if len(items) <= limit {
+		return items
+	}
+
+	byStart := slices.Clone(items)
+	sort.SliceStable(byStart, func(i, j int) bool {
+		return parseRunDirTimestamp(byStart[i].Key).After(parseRunDirTimestamp(byStart[j].Key))
+	})
+	keep := make(map[string]struct{}, limit)
+	for _, item := range byStart[:limit] {
+		keep[item.Key] = struct{}{}
+	}
+
+	recent := make([]KeyValuePair, 0, limit)
+	for _, item := range items {
+		if _, ok := keep[item.Key]; ok {
+			recent = append(recent, item)
+		}
+	}
+	return recent
+}
+
+// handleToggleRecentRuns limits the runs list to the newest runs, or
+// lifts the limit. It combines with the text filter.
+func (w *Workspace) handleToggleRecentRuns(tea.KeyPressMsg) tea.Cmd {
+	w.recentRunsOnly = !w.recentRunsOnly
+	w.applyRunFilter()
+	return nil
+}
+
+// handleCycleRunListOrder sorts the runs list by the next run list order
+// and persists it.
+func (w *Workspace) handleCycleRunListOrder(tea.KeyPressMsg) tea.Cmd {
+	orders := runListOrders()
+	next := orders[(slices.Index(orders, w.config.RunListOrder())+1)%len(orders)]
+	if err := w.config.SetRunListOrder(next); err != nil {
+		w.logger.Error(fmt.Sprintf("workspace: failed to save run list order: %v", err))
+	}
+
+	runKeys := make([]string, 0, len(w.runs.Items))
+	for _, item := range w.runs.Items {
+		runKeys = append(runKeys, item.Key)
+	}
+	slices.SortFunc(runKeys, compareRunDirsNewestFirst)
+	w.applyRunKeys(w.orderRunKeys(runKeys))
+	w.setRunNotice("Runs sorted %s", runListOrderLabel(next))
+	return nil
+}
+
+// runListOrderStatus names the runs list order for the status bar when
+// it is not the default newest-first order.
+func (w *Workspace) runListOrderStatus() string {
+	order := w.config.RunListOrder()
+	if order == DefaultRunListOrder {
+		return ""
+	}
+	return "Runs sorted " + runListOrderLabel(order)
+}
+
+// runListOrderLabel describes a run list order.
+func runListOrderLabel(order string) string {
+	switch order {
+	case RunListOrderName:
+		return "by name"
+	case RunListOrderLiveFirst:
+		return "running first"
+	default:
+		return "newest first"
+	}
+}
+
 // runFilterData returns indexed filter metadata for runKey.
 //
 // If the run has not been preloaded yet, it falls back to the run key so
@@ -0,0 +1,64 @@
+package leet
+
+import (
+	"fmt"
+	"maps"
+	"os"
+	"slices"
+
+	tea "charm.land/bubbletea/v2"
+)
+
+// saveSelection persists the selected and pinned runs so that the next
+// workspace opened on the same directory restores them.
+func (w *Workspace) saveSelection() {
+	if w.wandbDir == "" {
+		return
+	}
+
+	runKeys := slices.Sorted(maps.Keys(w.selectedRuns))
+	pinned := w.pinnedRun
+	if !w.selectedRuns[pinned] {
+		pinned = ""
+	}
+	if err := w.config.SetWorkspaceSelection(w.wandbDir, runKeys, pinned); err != nil {
+		w.logger.CaptureError(fmt.Errorf("workspace: saving selection: %v", err))
+	}
+}
+
+// restoreSelection selects the runs saved by saveSelection that are still
+// listed and still have a .wandb file, and restores the pin.
+//
+// Stale entries are dropped silently. If none of the saved runs can be
+// restored, the latest run is selected instead.
+func (w *Workspace) restoreSelection(runKeys []string) tea.Cmd {
+	saved, pinned := w.config.WorkspaceSelection(w.wandbDir)
+
+	var cmds []tea.Cmd
+	for _, runKey := range saved {
+		if !slices.Contains(runKeys, runKey) || !runWandbFileExists(w.wandbDir, runKey) {
+			continue
+		}
+		cmds = append(cmds, w.toggleRunSelected(runKey))
+	}
+	if len(w.selectedRuns) == 0 {
+		return w.toggleRunSelected(runKeys[0])
+	}
+
+	if pinned != "" && w.selectedRuns[pinned] {
+		w.pinnedRun = pinned
+		w.refreshPinnedRun()
+	}
+	return tea.Batch(cmds...)
+}
+
+// runWandbFileExists reports whether runDir's .wandb file resolves to an
+// existing file.
+func runWandbFileExists(wandbDir, runDir string) bool {
+	wandbFile := runWandbFile(wandbDir, runDir)
+	if wandbFile == "" {
+		return false
+	}
+	_, err := os.Stat(wandbFile)
+	return err == nil
+}
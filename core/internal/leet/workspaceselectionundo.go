@@ -0,0 +1,87 @@
+package leet
+
+import (
+	"maps"
+	"slices"
+	"time"
+
+	tea "charm.land/bubbletea/v2"
+)
+
+// selectionSnapshot is the workspace's run selection before a bulk
+// deselect, kept so that the deselect can be undone.
+type selectionSnapshot struct {
+	selected []string
+	pinned   string
+	at       time.Time
+}
+
+// snapshotSelection records the current selection for undo.
+func (w *Workspace) snapshotSelection() {
+	if len(w.selectedRuns) == 0 {
+		return
+	}
+	w.selectionUndo = &selectionSnapshot{
+		selected: slices.Sorted(maps.Keys(w.selectedRuns)),
+		pinned:   w.pinnedRun,
+		at:       time.Now(),
+	}
+}
+
+// undoHint returns the notice suffix telling how to undo a bulk deselect,
+// or "" if undo is disabled.
+func (w *Workspace) undoHint() string {
+	if w.config.SelectionUndoWindow() <= 0 {
+		return ""
+	}
+	return " (u to undo)"
+}
+
+// handleDeselectAllRuns deselects every run, closing their readers.
+func (w *Workspace) handleDeselectAllRuns(tea.KeyPressMsg) tea.Cmd {
+	n := len(w.selectedRuns)
+	if n == 0 {
+		return nil
+	}
+
+	w.snapshotSelection()
+	for _, key := range slices.Collect(maps.Keys(w.selectedRuns)) {
+		w.dropRun(key)
+	}
+	w.setRunNotice("Deselected %d run(s)%s", n, w.undoHint())
+	return nil
+}
+
+// handleUndoDeselect reselects the runs dropped by the last bulk deselect
+// and restores the pin, if done within the configured undo window.
+//
+// Runs selected since the bulk deselect stay selected.
+func (w *Workspace) handleUndoDeselect(tea.KeyPressMsg) tea.Cmd {
+	snap := w.selectionUndo
+	w.selectionUndo = nil
+
+	window := w.config.SelectionUndoWindow()
+	if snap == nil || window <= 0 || time.Since(snap.at) > window {
+		w.setRunNotice("Nothing to undo")
+		return nil
+	}
+
+	var cmds []tea.Cmd
+	restored := 0
+	for _, key := range snap.selected {
+		if w.selectedRuns[key] {
+			continue
+		}
+		cmds = append(cmds, w.toggleRunSelected(key))
+		if w.selectedRuns[key] {
+			restored++
+		}
+	}
+	if snap.pinned != "" && w.selectedRuns[snap.pinned] {
+		w.pinnedRun = snap.pinned
+		w.refreshPinnedRun()
+	}
+
+	w.setRunNotice("Restored %d run(s)", restored)
+	return tea.Batch(cmds...)
+}
@@ -0,0 +1,91 @@
+package leet
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	tea "charm.land/bubbletea/v2"
+)
+
+const (
+	// watcherRetryBaseDelay is the delay before the first attempt to
+	// restart a failed run file watcher; each further attempt doubles it.
+	watcherRetryBaseDelay = time.Second
+
+	// watcherRetryMaxDelay caps the delay between watcher restarts.
+	watcherRetryMaxDelay = 30 * time.Second
+
+	// maxWatcherRetries bounds the attempts to restart a failed watcher.
+	maxWatcherRetries = 5
+)
+
+// watcherRetryDelay returns the delay before the given restart attempt.
+func watcherRetryDelay(attempt int) time.Duration {
+	delay := watcherRetryBaseDelay
+	for range attempt - 1 {
+		if delay >= watcherRetryMaxDelay {
+			break
+		}
+		delay *= 2
+	}
+	return min(delay, watcherRetryMaxDelay)
+}
+
+// scheduleWatcherRetry returns a command that asks to restart runKey's
+// watcher after the attempt's backoff delay, or nil once the attempts
+// are used up.
+func (w *Workspace) scheduleWatcherRetry(runKey string, attempt int) tea.Cmd {
+	if attempt > maxWatcherRetries {
+		w.logger.CaptureError(fmt.Errorf(
+			"workspace: giving up restarting watcher for %s after %d attempts",
+			runKey, maxWatcherRetries))
+		return nil
+	}
+	return tea.Tick(watcherRetryDelay(attempt), func(time.Time) tea.Msg {
+		return WorkspaceWatcherRetryMsg{RunKey: runKey, Attempt: attempt}
+	})
+}
+
+// handleWorkspaceWatcherErr stops a run's failed watcher and schedules
+// its restart.
+//
+// The heartbeat keeps polling the run in the meantime.
+func (w *Workspace) handleWorkspaceWatcherErr(msg WorkspaceWatcherErrMsg) tea.Cmd {
+	run := w.runsByKey[msg.RunKey]
+	if run == nil {
+		return nil
+	}
+
+	w.logger.CaptureError(fmt.Errorf(
+		"workspace: watcher for %s failed: %v", msg.RunKey, msg.Err))
+	w.stopWatcher(run)
+
+	w.syncLiveRunState()
+	if w.heartbeatMgr != nil && w.hasLiveRuns.Load() {
+		w.heartbeatMgr.Start(w.hasLiveRuns.Load)
+	}
+
+	return w.scheduleWatcherRetry(msg.RunKey, 1)
+}
+
+// handleWorkspaceWatcherRetry restarts a run's watcher if the run is
+// still selected and live, and catches up on records written meanwhile.
+//
+// While the run file cannot be statted the restart is retried with
+// backoff.
+func (w *Workspace) handleWorkspaceWatcherRetry(msg WorkspaceWatcherRetryMsg) tea.Cmd {
+	run := w.runsByKey[msg.RunKey]
+	if run == nil || !w.selectedRuns[msg.RunKey] ||
+		run.state != RunStateRunning || run.watcher != nil {
+		return nil
+	}
+
+	if _, err := os.Stat(run.wandbPath); err != nil {
+		return w.scheduleWatcherRetry(msg.RunKey, msg.Attempt+1)
+	}
+
+	w.logger.Info(fmt.Sprintf(
+		"workspace: restarting watcher for %s (attempt %d)", msg.RunKey, msg.Attempt))
+	return batchCmds(w.ReadAvailableCmd(run), w.ensureLiveStreaming(run))
+}
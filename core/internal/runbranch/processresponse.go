@@ -126,16 +126,6 @@ func processHistory(history *string) (map[string]any, error) {
 	return historyTail, nil
 }
 
-func extractRuntime(runtime any) float64 {
-	switch x := runtime.(type) {
-	case int64:
-		return float64(x)
-	case float64:
-		return x
-	}
-	return 0
-}
-
 func processAllOffsets(history, events, logs *int) (filestream.FileStreamOffsetMap, error) {
 	filestreamOffset := make(filestream.FileStreamOffsetMap)
 
@@ -6,7 +6,6 @@ import (
 	"errors"
 	"fmt"
 	"maps"
-	"math"
 
 	"github.com/Khan/genqlient/graphql"
 
@@ -14,6 +13,7 @@ import (
 	"github.com/wandb/wandb/core/internal/gql"
 	"github.com/wandb/wandb/core/internal/nullify"
 	"github.com/wandb/wandb/core/internal/runconfig"
+	"github.com/wandb/wandb/core/internal/runsummary"
 	spb "github.com/wandb/wandb/core/pkg/service_go_proto"
 )
 
@@ -179,12 +179,8 @@ func processResponse(
 	if events, err := processEventsTail(data.GetEventsTail()); err != nil {
 		return err
 	} else if events != nil {
-		if runtime, ok := events["_runtime"]; ok {
-			params.Runtime = int32(
-				math.Max(
-					extractRuntime(runtime),
-					float64(params.Runtime),
-				))
+		if runtime, ok := runsummary.ParseRuntimeValue(events["_runtime"]); ok {
+			params.Runtime = max(runtime, params.Runtime)
 		}
 	}
 
@@ -208,23 +204,8 @@ func processResponse(
 
 		// if summary["_wandb"]["runtime"] exists it takes precedence over
 		// summary["_runtime"] for the runtime value
-		switch x := params.Summary["_wandb"].(type) {
-		case map[string]any:
-			if runtime, ok := x["runtime"]; ok {
-				params.Runtime = int32(
-					math.Max(
-						extractRuntime(runtime),
-						float64(params.Runtime),
-					))
-			}
-		default:
-			if runtime, ok := params.Summary["_runtime"]; ok {
-				params.Runtime = int32(
-					math.Max(
-						extractRuntime(runtime),
-						float64(params.Runtime),
-					))
-			}
+		if runtime, ok := runsummary.ParseRuntime(params.Summary); ok {
+			params.Runtime = max(runtime, params.Runtime)
 		}
 	}
 
@@ -241,12 +222,8 @@ func processResponse(
 			}
 		}
 
-		if runtime, ok := history["_runtime"]; ok {
-			params.Runtime = int32(
-				math.Max(
-					extractRuntime(runtime),
-					float64(params.Runtime),
-				))
+		if runtime, ok := runsummary.ParseRuntimeValue(history["_runtime"]); ok {
+			params.Runtime = max(runtime, params.Runtime)
 		}
 	}
 
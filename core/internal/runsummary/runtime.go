@@ -0,0 +1,49 @@
+package runsummary
+
+import (
+	"math"
+	"strconv"
+	"strings"
+)
+
+// ParseRuntime extracts the run's runtime in seconds from a summary.
+//
+// summary["_wandb"]["runtime"] takes precedence over summary["_runtime"].
+// Values may be encoded as integers, floats or numeric strings; fractional
+// seconds are truncated. Returns false if neither key holds a usable value.
+func ParseRuntime(summary map[string]any) (int32, bool) {
+	if wandb, ok := summary["_wandb"].(map[string]any); ok {
+		if runtime, ok := ParseRuntimeValue(wandb["runtime"]); ok {
+			return runtime, true
+		}
+	}
+	return ParseRuntimeValue(summary["_runtime"])
+}
+
+// ParseRuntimeValue converts a single decoded runtime value to seconds.
+//
+// Negative, non-finite and non-numeric values are rejected.
+func ParseRuntimeValue(v any) (int32, bool) {
+	var seconds float64
+	switch x := v.(type) {
+	case int64:
+		seconds = float64(x)
+	case int:
+		seconds = float64(x)
+	case float64:
+		seconds = x
+	case string:
+		f, err := strconv.ParseFloat(strings.TrimSpace(x), 64)
+		if err != nil {
+			return 0, false
+		}
+		seconds = f
+	default:
+		return 0, false
+	}
+
+	if math.IsNaN(seconds) || seconds < 0 || seconds > math.MaxInt32 {
+		return 0, false
+	}
+	return int32(seconds), true
+}
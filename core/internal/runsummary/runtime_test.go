@@ -0,0 +1,44 @@
+package runsummary_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/wandb/wandb/core/internal/runsummary"
+)
+
+func TestParseRuntime(t *testing.T) {
+	testCases := []struct {
+		name    string
+		summary map[string]any
+		want    int32
+		wantOK  bool
+	}{
+		{"int64", map[string]any{"_runtime": int64(50)}, 50, true},
+		{"float64", map[string]any{"_runtime": 40.7}, 40, true},
+		{"string", map[string]any{"_runtime": " 12.5 "}, 12, true},
+		{"missing", map[string]any{"loss": 0.5}, 0, false},
+		{"nil summary", nil, 0, false},
+		{"non-numeric string", map[string]any{"_runtime": "soon"}, 0, false},
+		{"negative", map[string]any{"_runtime": int64(-1)}, 0, false},
+		{
+			"_wandb.runtime takes precedence",
+			map[string]any{"_runtime": 40.2, "_wandb": map[string]any{"runtime": int64(20)}},
+			20, true,
+		},
+		{
+			"falls back to _runtime without _wandb.runtime",
+			map[string]any{"_runtime": "30", "_wandb": map[string]any{}},
+			30, true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, ok := runsummary.ParseRuntime(tc.summary)
+			assert.Equal(t, tc.wantOK, ok)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}